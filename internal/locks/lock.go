@@ -1,10 +1,18 @@
 package locks
 
+import (
+	"sort"
+	"strings"
+)
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = NewMutexKV()
 
+// ByID locks on the full Resource ID, which (unlike the name) is unique across Subscriptions and Resource Groups.
+// Resource IDs are case-insensitive, so the key is normalised to ensure IDs returned by the API and IDs from the
+// user's configuration lock on the same key
 func ByID(id string) {
-	armMutexKV.Lock(id)
+	armMutexKV.Lock(idKey(id))
 }
 
 // handle the case of using the same name for different kinds of resources
@@ -13,6 +21,14 @@ func ByName(name string, resourceType string) {
 	armMutexKV.Lock(updatedName)
 }
 
+// MultipleByID locks on each of the unique Resource IDs, in a consistent order to avoid deadlocks
+// between callers locking on overlapping sets of IDs
+func MultipleByID(ids *[]string) {
+	for _, id := range uniqueSortedIDKeys(*ids) {
+		armMutexKV.Lock(id)
+	}
+}
+
 func MultipleByName(names *[]string, resourceType string) {
 	newSlice := removeDuplicatesFromStringArray(*names)
 
@@ -22,7 +38,7 @@ func MultipleByName(names *[]string, resourceType string) {
 }
 
 func UnlockByID(id string) {
-	armMutexKV.Unlock(id)
+	armMutexKV.Unlock(idKey(id))
 }
 
 func UnlockByName(name string, resourceType string) {
@@ -30,6 +46,13 @@ func UnlockByName(name string, resourceType string) {
 	armMutexKV.Unlock(updatedName)
 }
 
+func UnlockMultipleByID(ids *[]string) {
+	keys := uniqueSortedIDKeys(*ids)
+	for i := len(keys) - 1; i >= 0; i-- {
+		armMutexKV.Unlock(keys[i])
+	}
+}
+
 func UnlockMultipleByName(names *[]string, resourceType string) {
 	newSlice := removeDuplicatesFromStringArray(*names)
 
@@ -37,3 +60,17 @@ func UnlockMultipleByName(names *[]string, resourceType string) {
 		UnlockByName(name, resourceType)
 	}
 }
+
+func idKey(id string) string {
+	return strings.ToLower(id)
+}
+
+func uniqueSortedIDKeys(ids []string) []string {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, idKey(id))
+	}
+	keys = removeDuplicatesFromStringArray(keys)
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,108 @@
+package locks
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+const lockTestTimeout = 5 * time.Second
+
+func TestByIDIndependentResourcesProceedInParallel(t *testing.T) {
+	// identically named Virtual Networks in different Resource Groups/Subscriptions
+	ids := []string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/spoke1/providers/Microsoft.Network/virtualNetworks/vnet-hub",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/spoke2/providers/Microsoft.Network/virtualNetworks/vnet-hub",
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/spoke1/providers/Microsoft.Network/virtualNetworks/vnet-hub",
+	}
+
+	// every lock is held until all of them have been acquired, so this only completes if none of them block each other
+	var acquired sync.WaitGroup
+	acquired.Add(len(ids))
+	release := make(chan struct{})
+	done := make(chan struct{})
+
+	for _, id := range ids {
+		go func(id string) {
+			ByID(id)
+			defer UnlockByID(id)
+			acquired.Done()
+			<-release
+		}(id)
+	}
+
+	go func() {
+		acquired.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		close(release)
+	case <-time.After(lockTestTimeout):
+		t.Fatalf("expected locks on independent Virtual Networks to be held concurrently")
+	}
+}
+
+func TestByIDSameResourceIsSerialised(t *testing.T) {
+	id := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/serialised/providers/Microsoft.Network/virtualNetworks/vnet-hub"
+	// the API frequently returns a differently cased Resource Group name to the one in the configuration
+	differentlyCased := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/SERIALISED/providers/Microsoft.Network/virtualNetworks/vnet-hub"
+
+	ByID(id)
+
+	acquired := make(chan struct{})
+	go func() {
+		ByID(differentlyCased)
+		close(acquired)
+		UnlockByID(differentlyCased)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatalf("expected the lock on %q to block whilst %q is locked", differentlyCased, id)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	UnlockByID(id)
+
+	select {
+	case <-acquired:
+	case <-time.After(lockTestTimeout):
+		t.Fatalf("expected the lock on %q to be acquired once %q was unlocked", differentlyCased, id)
+	}
+}
+
+func TestMultipleByIDOverlappingSetsDoNotDeadlock(t *testing.T) {
+	first := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/overlapping/providers/Microsoft.Network/virtualNetworks/first"
+	second := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/overlapping/providers/Microsoft.Network/virtualNetworks/second"
+
+	inputs := [][]string{
+		{first, second},
+		{second, first, first},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		for _, input := range inputs {
+			wg.Add(1)
+			go func(ids []string) {
+				defer wg.Done()
+				MultipleByID(&ids)
+				UnlockMultipleByID(&ids)
+			}(input)
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(lockTestTimeout):
+		t.Fatalf("expected locking overlapping sets of IDs not to deadlock")
+	}
+}
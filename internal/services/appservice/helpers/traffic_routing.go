@@ -0,0 +1,196 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type TrafficRouting struct {
+	SlotName   string            `tfschema:"slot_name"`
+	Percentage float64           `tfschema:"percentage"`
+	AutoRamp   []TrafficAutoRamp `tfschema:"auto_ramp"`
+}
+
+type TrafficAutoRamp struct {
+	ChangeStep                float64 `tfschema:"change_step"`
+	ChangeIntervalInMinutes   int     `tfschema:"change_interval_in_minutes"`
+	MinPercentage             float64 `tfschema:"min_percentage"`
+	MaxPercentage             float64 `tfschema:"max_percentage"`
+	ChangeDecisionCallbackUrl string  `tfschema:"change_decision_callback_url"`
+}
+
+func TrafficRoutingSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"slot_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Slot which should receive the routed traffic.",
+				},
+
+				"percentage": {
+					Type:         pluginsdk.TypeFloat,
+					Required:     true,
+					ValidateFunc: validation.FloatBetween(0, 100),
+					Description:  "The percentage of traffic which should be routed to the Slot.",
+				},
+
+				"auto_ramp": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"change_step": {
+								Type:         pluginsdk.TypeFloat,
+								Required:     true,
+								ValidateFunc: validation.FloatBetween(0, 100),
+								Description:  "The percentage to add to or remove from `percentage` each interval, until it reaches `min_percentage` or `max_percentage`.",
+							},
+
+							"change_interval_in_minutes": {
+								Type:         pluginsdk.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The interval in minutes at which `percentage` is re-evaluated.",
+							},
+
+							"min_percentage": {
+								Type:         pluginsdk.TypeFloat,
+								Optional:     true,
+								Default:      0,
+								ValidateFunc: validation.FloatBetween(0, 100),
+								Description:  "The lower boundary for `percentage`.",
+							},
+
+							"max_percentage": {
+								Type:         pluginsdk.TypeFloat,
+								Optional:     true,
+								Default:      100,
+								ValidateFunc: validation.FloatBetween(0, 100),
+								Description:  "The upper boundary for `percentage`.",
+							},
+
+							"change_decision_callback_url": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+								Description:  "The URL of a custom decision algorithm, such as the TiPCallback Site Extension, used to decide how `percentage` changes.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// UpdateTrafficRouting replaces the Ramp Up Rules of the App with the specified Traffic Routing rules. This is sent as a patch so the
+// remainder of the Site Config is left untouched.
+func UpdateTrafficRouting(ctx context.Context, client *web.AppsClient, resourceGroup, siteName string, input []TrafficRouting) error {
+	site, err := client.Get(ctx, resourceGroup, siteName)
+	if err != nil {
+		return fmt.Errorf("retrieving default host name: %+v", err)
+	}
+	if site.SiteProperties == nil || site.SiteProperties.DefaultHostName == nil {
+		return fmt.Errorf("retrieving default host name: `defaultHostName` was nil")
+	}
+
+	experiments, err := ExpandTrafficRouting(input, siteName, *site.SiteProperties.DefaultHostName)
+	if err != nil {
+		return err
+	}
+
+	siteConfig := web.SiteConfigResource{
+		SiteConfig: &web.SiteConfig{
+			Experiments: experiments,
+		},
+	}
+	if _, err := client.UpdateConfiguration(ctx, resourceGroup, siteName, siteConfig); err != nil {
+		return fmt.Errorf("updating Ramp Up Rules: %+v", err)
+	}
+
+	return nil
+}
+
+// ExpandTrafficRouting builds the Ramp Up Rules for the App, deriving the host name of each Slot from the default host name of the App
+// (e.g. `example.azurewebsites.net` becomes `example-staging.azurewebsites.net`) so that this works regardless of the cloud or ASE in use
+func ExpandTrafficRouting(input []TrafficRouting, siteName, defaultHostName string) (*web.Experiments, error) {
+	rules := make([]web.RampUpRule, 0)
+	total := 0.0
+	hostNameSuffix := strings.TrimPrefix(strings.ToLower(defaultHostName), strings.ToLower(siteName))
+
+	for _, v := range input {
+		total += v.Percentage
+		rule := web.RampUpRule{
+			Name:              utils.String(v.SlotName),
+			ActionHostName:    utils.String(fmt.Sprintf("%s-%s%s", strings.ToLower(siteName), strings.ToLower(v.SlotName), hostNameSuffix)),
+			ReroutePercentage: utils.Float(v.Percentage),
+		}
+
+		if len(v.AutoRamp) > 0 {
+			autoRamp := v.AutoRamp[0]
+			if autoRamp.MinPercentage > autoRamp.MaxPercentage {
+				return nil, fmt.Errorf("the `min_percentage` for the Slot %q must not be greater than the `max_percentage`", v.SlotName)
+			}
+			rule.ChangeStep = utils.Float(autoRamp.ChangeStep)
+			rule.ChangeIntervalInMinutes = utils.Int32(int32(autoRamp.ChangeIntervalInMinutes))
+			rule.MinReroutePercentage = utils.Float(autoRamp.MinPercentage)
+			rule.MaxReroutePercentage = utils.Float(autoRamp.MaxPercentage)
+			if autoRamp.ChangeDecisionCallbackUrl != "" {
+				rule.ChangeDecisionCallbackURL = utils.String(autoRamp.ChangeDecisionCallbackUrl)
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if total > 100 {
+		return nil, fmt.Errorf("the total `percentage` routed to Slots must not exceed 100, got %v", total)
+	}
+
+	return &web.Experiments{
+		RampUpRules: &rules,
+	}, nil
+}
+
+func FlattenTrafficRouting(input *web.Experiments) []TrafficRouting {
+	result := make([]TrafficRouting, 0)
+	if input == nil || input.RampUpRules == nil {
+		return result
+	}
+
+	for _, v := range *input.RampUpRules {
+		rule := TrafficRouting{
+			SlotName:   utils.NormalizeNilableString(v.Name),
+			Percentage: utils.NormaliseNilableFloat64(v.ReroutePercentage),
+		}
+
+		if v.ChangeStep != nil || v.ChangeIntervalInMinutes != nil {
+			autoRamp := TrafficAutoRamp{
+				ChangeStep:                utils.NormaliseNilableFloat64(v.ChangeStep),
+				MinPercentage:             utils.NormaliseNilableFloat64(v.MinReroutePercentage),
+				MaxPercentage:             utils.NormaliseNilableFloat64(v.MaxReroutePercentage),
+				ChangeDecisionCallbackUrl: utils.NormalizeNilableString(v.ChangeDecisionCallbackURL),
+			}
+			if v.ChangeIntervalInMinutes != nil {
+				autoRamp.ChangeIntervalInMinutes = int(*v.ChangeIntervalInMinutes)
+			}
+			rule.AutoRamp = []TrafficAutoRamp{autoRamp}
+		}
+
+		result = append(result, rule)
+	}
+
+	return result
+}
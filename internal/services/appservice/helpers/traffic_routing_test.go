@@ -0,0 +1,82 @@
+package helpers
+
+import (
+	"testing"
+)
+
+func TestExpandTrafficRouting(t *testing.T) {
+	input := []TrafficRouting{
+		{
+			SlotName:   "Staging",
+			Percentage: 20,
+		},
+		{
+			SlotName:   "canary",
+			Percentage: 5,
+			AutoRamp: []TrafficAutoRamp{
+				{
+					ChangeStep:              5,
+					ChangeIntervalInMinutes: 10,
+					MinPercentage:           0,
+					MaxPercentage:           50,
+				},
+			},
+		},
+	}
+
+	result, err := ExpandTrafficRouting(input, "Example", "example.azurewebsites.us")
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+	if result.RampUpRules == nil || len(*result.RampUpRules) != 2 {
+		t.Fatalf("expected 2 rules, got %+v", result.RampUpRules)
+	}
+
+	rules := *result.RampUpRules
+	if *rules[0].ActionHostName != "example-staging.azurewebsites.us" {
+		t.Fatalf("expected host name `example-staging.azurewebsites.us`, got %q", *rules[0].ActionHostName)
+	}
+	if rules[0].ChangeStep != nil {
+		t.Fatalf("expected no auto ramp for the first rule")
+	}
+	if *rules[1].ActionHostName != "example-canary.azurewebsites.us" {
+		t.Fatalf("expected host name `example-canary.azurewebsites.us`, got %q", *rules[1].ActionHostName)
+	}
+	if *rules[1].ChangeIntervalInMinutes != 10 || *rules[1].MaxReroutePercentage != 50 {
+		t.Fatalf("auto ramp was not expanded: %+v", rules[1])
+	}
+
+	flattened := FlattenTrafficRouting(result)
+	if len(flattened) != 2 || flattened[0].SlotName != "Staging" || len(flattened[0].AutoRamp) != 0 || len(flattened[1].AutoRamp) != 1 {
+		t.Fatalf("unexpected flattened result: %+v", flattened)
+	}
+}
+
+func TestExpandTrafficRoutingEmpty(t *testing.T) {
+	result, err := ExpandTrafficRouting(nil, "example", "example.azurewebsites.net")
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+	// an empty list must still be sent so that any existing rules are removed
+	if result.RampUpRules == nil || len(*result.RampUpRules) != 0 {
+		t.Fatalf("expected an empty list of rules, got %+v", result.RampUpRules)
+	}
+}
+
+func TestExpandTrafficRoutingInvalid(t *testing.T) {
+	cases := map[string][]TrafficRouting{
+		"total over 100": {
+			{SlotName: "a", Percentage: 60},
+			{SlotName: "b", Percentage: 50},
+		},
+		"min over max": {
+			{SlotName: "a", Percentage: 10, AutoRamp: []TrafficAutoRamp{{ChangeStep: 1, ChangeIntervalInMinutes: 1, MinPercentage: 40, MaxPercentage: 20}}},
+		},
+	}
+
+	for name, input := range cases {
+		if _, err := ExpandTrafficRouting(input, "example", "example.azurewebsites.net"); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+}
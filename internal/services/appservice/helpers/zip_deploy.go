@@ -0,0 +1,297 @@
+package helpers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Kudu Deployment Status values, see https://github.com/projectkudu/kudu/wiki/REST-API#deployment
+const (
+	kuduDeploymentStatusPending   = 0
+	kuduDeploymentStatusBuilding  = 1
+	kuduDeploymentStatusDeploying = 2
+	kuduDeploymentStatusFailed    = 3
+	kuduDeploymentStatusSuccess   = 4
+)
+
+// ZipDeploy pushes a Zip Package to an App Service via the `zipdeploy` endpoint of the Kudu (SCM) site
+type ZipDeploy struct {
+	// ScmUri is the base URI of the SCM site, e.g. `https://example.scm.azurewebsites.net`
+	ScmUri   string
+	Username string
+	Password string
+
+	HTTPClient   *http.Client
+	PollInterval time.Duration
+}
+
+type kuduDeploymentStatus struct {
+	Id         string `json:"id"`
+	Status     int    `json:"status"`
+	StatusText string `json:"status_text"`
+	Complete   bool   `json:"complete"`
+}
+
+// ZipDeployFileHash returns the hex-encoded SHA256 of the local Zip Package, which is used to detect changes to the package contents
+func ZipDeployFileHash(zipFile string) (string, error) {
+	file, err := os.Open(zipFile)
+	if err != nil {
+		return "", fmt.Errorf("opening %q: %+v", zipFile, err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("hashing %q: %+v", zipFile, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// GetCredentialsAndPublish retrieves the Publishing Credentials for the App (or Slot, when slotName is specified) and pushes the Zip Package to its SCM site
+func GetCredentialsAndPublish(ctx context.Context, client *web.AppsClient, resourceGroup, siteName, slotName, zipFile string) error {
+	var user web.User
+	if slotName == "" {
+		future, err := client.ListPublishingCredentials(ctx, resourceGroup, siteName)
+		if err != nil {
+			return fmt.Errorf("listing Site Publishing Credentials: %+v", err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for Site Publishing Credentials: %+v", err)
+		}
+		if user, err = future.Result(*client); err != nil {
+			return fmt.Errorf("reading Site Publishing Credentials: %+v", err)
+		}
+	} else {
+		future, err := client.ListPublishingCredentialsSlot(ctx, resourceGroup, siteName, slotName)
+		if err != nil {
+			return fmt.Errorf("listing Site Publishing Credentials: %+v", err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for Site Publishing Credentials: %+v", err)
+		}
+		if user, err = future.Result(*client); err != nil {
+			return fmt.Errorf("reading Site Publishing Credentials: %+v", err)
+		}
+	}
+
+	props := user.UserProperties
+	if props == nil || props.ScmURI == nil || props.PublishingUserName == nil || props.PublishingPassword == nil {
+		return fmt.Errorf("the Site Publishing Credentials were missing from the API response")
+	}
+
+	scmUri, err := url.Parse(*props.ScmURI)
+	if err != nil {
+		return fmt.Errorf("parsing SCM URI %q: %+v", *props.ScmURI, err)
+	}
+	// the SCM URI returned by the API embeds the credentials, which we send as a header instead
+	scmUri.User = nil
+
+	deployer := ZipDeploy{
+		ScmUri:   scmUri.String(),
+		Username: *props.PublishingUserName,
+		Password: *props.PublishingPassword,
+	}
+
+	return deployer.Publish(ctx, zipFile)
+}
+
+// ZipDeployFileHashCustomizeDiff sets `zip_deploy_file_hash` to the hash of the local Zip Package when it has changed, so that a change to the
+// package contents triggers a new deployment even when the path is unchanged
+func ZipDeployFileHashCustomizeDiff(rd *pluginsdk.ResourceDiff) error {
+	if !rd.NewValueKnown("zip_deploy_file") {
+		return rd.SetNewComputed("zip_deploy_file_hash")
+	}
+
+	zipFile := rd.Get("zip_deploy_file").(string)
+	if zipFile == "" {
+		return nil
+	}
+
+	if _, err := os.Stat(zipFile); err != nil {
+		if os.IsNotExist(err) {
+			// the package may be built during the apply, in which case it's hashed once it's been deployed
+			return rd.SetNewComputed("zip_deploy_file_hash")
+		}
+		return fmt.Errorf("reading %q: %+v", zipFile, err)
+	}
+
+	hash, err := ZipDeployFileHash(zipFile)
+	if err != nil {
+		return err
+	}
+
+	if hash != rd.Get("zip_deploy_file_hash").(string) {
+		return rd.SetNew("zip_deploy_file_hash", hash)
+	}
+
+	return nil
+}
+
+// PublishZipDeployFile deploys the Zip Package to the App (or Slot, when slotName is specified) and records its hash in `zip_deploy_file_hash`
+func PublishZipDeployFile(ctx context.Context, client *web.AppsClient, d *pluginsdk.ResourceData, resourceGroup, siteName, slotName, zipFile string) error {
+	if err := GetCredentialsAndPublish(ctx, client, resourceGroup, siteName, slotName, zipFile); err != nil {
+		return err
+	}
+
+	hash, err := ZipDeployFileHash(zipFile)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("zip_deploy_file_hash", hash)
+}
+
+// Publish uploads the Zip Package and then polls the resulting deployment until it completes
+func (z ZipDeploy) Publish(ctx context.Context, zipFile string) error {
+	statusUri, err := z.upload(ctx, zipFile)
+	if err != nil {
+		return err
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context is missing a timeout")
+	}
+
+	pollInterval := z.PollInterval
+	if pollInterval == 0 {
+		pollInterval = 10 * time.Second
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			kuduDeploymentStatusString(kuduDeploymentStatusPending),
+			kuduDeploymentStatusString(kuduDeploymentStatusBuilding),
+			kuduDeploymentStatusString(kuduDeploymentStatusDeploying),
+		},
+		Target:       []string{kuduDeploymentStatusString(kuduDeploymentStatusSuccess)},
+		Refresh:      z.deploymentStatusRefreshFunc(ctx, statusUri),
+		PollInterval: pollInterval,
+		Timeout:      time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for deployment of %q to complete: %+v", zipFile, err)
+	}
+
+	return nil
+}
+
+func (z ZipDeploy) upload(ctx context.Context, zipFile string) (string, error) {
+	file, err := os.Open(zipFile)
+	if err != nil {
+		return "", fmt.Errorf("opening %q: %+v", zipFile, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", fmt.Errorf("reading %q: %+v", zipFile, err)
+	}
+
+	baseUri := strings.TrimSuffix(z.ScmUri, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/zipdeploy?isAsync=true", baseUri), file)
+	if err != nil {
+		return "", fmt.Errorf("building zipdeploy request: %+v", err)
+	}
+	req.ContentLength = info.Size()
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.SetBasicAuth(z.Username, z.Password)
+
+	resp, err := z.httpClient().Do(req)
+	if err != nil {
+		return "", fmt.Errorf("uploading %q: %+v", zipFile, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("uploading %q: unexpected status %d: %s", zipFile, resp.StatusCode, string(body))
+	}
+
+	// Kudu returns the URI of the deployment to poll in the `Location` header, falling back to the latest deployment
+	statusUri := resp.Header.Get("Location")
+	if statusUri == "" {
+		statusUri = fmt.Sprintf("%s/api/deployments/latest", baseUri)
+	}
+
+	return statusUri, nil
+}
+
+func (z ZipDeploy) deploymentStatusRefreshFunc(ctx context.Context, statusUri string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, statusUri, nil)
+		if err != nil {
+			return nil, "", fmt.Errorf("building deployment status request: %+v", err)
+		}
+		req.SetBasicAuth(z.Username, z.Password)
+
+		resp, err := z.httpClient().Do(req)
+		if err != nil {
+			return nil, "", fmt.Errorf("polling deployment status: %+v", err)
+		}
+		defer resp.Body.Close()
+
+		// the deployment record may not be available immediately after the upload
+		if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusAccepted {
+			log.Printf("[DEBUG] deployment status at %q is not yet available (status %d)", statusUri, resp.StatusCode)
+			return resp, kuduDeploymentStatusString(kuduDeploymentStatusPending), nil
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, "", fmt.Errorf("polling deployment status: unexpected status %d", resp.StatusCode)
+		}
+
+		var status kuduDeploymentStatus
+		if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+			return nil, "", fmt.Errorf("decoding deployment status: %+v", err)
+		}
+
+		if status.Status == kuduDeploymentStatusFailed {
+			return status, kuduDeploymentStatusString(status.Status), fmt.Errorf("deployment %q failed: %s", status.Id, status.StatusText)
+		}
+
+		if status.Status == kuduDeploymentStatusSuccess && !status.Complete {
+			return status, kuduDeploymentStatusString(kuduDeploymentStatusDeploying), nil
+		}
+
+		return status, kuduDeploymentStatusString(status.Status), nil
+	}
+}
+
+func (z ZipDeploy) httpClient() *http.Client {
+	if z.HTTPClient != nil {
+		return z.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func kuduDeploymentStatusString(status int) string {
+	switch status {
+	case kuduDeploymentStatusPending:
+		return "Pending"
+	case kuduDeploymentStatusBuilding:
+		return "Building"
+	case kuduDeploymentStatusDeploying:
+		return "Deploying"
+	case kuduDeploymentStatusFailed:
+		return "Failed"
+	case kuduDeploymentStatusSuccess:
+		return "Success"
+	}
+	return fmt.Sprintf("Unknown (%d)", status)
+}
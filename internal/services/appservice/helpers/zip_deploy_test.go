@@ -0,0 +1,216 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeScmServer struct {
+	t *testing.T
+
+	username string
+	password string
+
+	// statuses are returned in order from the deployment status endpoint, the last one is repeated
+	statuses     []kuduDeploymentStatus
+	omitLocation bool
+
+	mu       sync.Mutex
+	uploaded []byte
+	polls    int
+}
+
+func (f *fakeScmServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if username, password, ok := r.BasicAuth(); !ok || username != f.username || password != f.password {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/api/zipdeploy":
+		if r.URL.Query().Get("isAsync") != "true" {
+			f.t.Errorf("expected the upload to be async, got query %q", r.URL.RawQuery)
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			f.t.Errorf("reading upload body: %+v", err)
+		}
+		f.uploaded = body
+
+		if !f.omitLocation {
+			w.Header().Set("Location", "http://"+r.Host+"/api/deployments/abc123")
+		}
+		w.WriteHeader(http.StatusAccepted)
+
+	case r.Method == http.MethodGet && (r.URL.Path == "/api/deployments/abc123" || r.URL.Path == "/api/deployments/latest"):
+		if f.uploaded == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		index := f.polls
+		if index >= len(f.statuses) {
+			index = len(f.statuses) - 1
+		}
+		f.polls++
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(f.statuses[index]); err != nil {
+			f.t.Errorf("encoding status: %+v", err)
+		}
+
+	default:
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func writeTestZipPackage(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "package.zip")
+	if err := os.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatalf("writing test package: %+v", err)
+	}
+	return path
+}
+
+func TestZipDeployPublish(t *testing.T) {
+	testData := []struct {
+		Name         string
+		Statuses     []kuduDeploymentStatus
+		OmitLocation bool
+		Password     string
+		ExpectError  string
+		ExpectPolls  int
+	}{
+		{
+			Name: "succeeds after building",
+			Statuses: []kuduDeploymentStatus{
+				{Id: "abc123", Status: kuduDeploymentStatusPending},
+				{Id: "abc123", Status: kuduDeploymentStatusBuilding},
+				{Id: "abc123", Status: kuduDeploymentStatusDeploying},
+				{Id: "abc123", Status: kuduDeploymentStatusSuccess, Complete: true},
+			},
+			ExpectPolls: 4,
+		},
+		{
+			Name: "waits for completion",
+			Statuses: []kuduDeploymentStatus{
+				{Id: "abc123", Status: kuduDeploymentStatusSuccess, Complete: false},
+				{Id: "abc123", Status: kuduDeploymentStatusSuccess, Complete: true},
+			},
+			ExpectPolls: 2,
+		},
+		{
+			Name: "falls back to the latest deployment",
+			Statuses: []kuduDeploymentStatus{
+				{Id: "abc123", Status: kuduDeploymentStatusSuccess, Complete: true},
+			},
+			OmitLocation: true,
+			ExpectPolls:  1,
+		},
+		{
+			Name: "deployment failed",
+			Statuses: []kuduDeploymentStatus{
+				{Id: "abc123", Status: kuduDeploymentStatusBuilding},
+				{Id: "abc123", Status: kuduDeploymentStatusFailed, StatusText: "build failed", Complete: true},
+			},
+			ExpectError: "build failed",
+			ExpectPolls: 2,
+		},
+		{
+			Name:        "invalid credentials",
+			Password:    "wrong",
+			ExpectError: "unexpected status 401",
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.Name, func(t *testing.T) {
+			fake := &fakeScmServer{
+				t:            t,
+				username:     "$example",
+				password:     "secret",
+				statuses:     v.Statuses,
+				omitLocation: v.OmitLocation,
+			}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+
+			password := fake.password
+			if v.Password != "" {
+				password = v.Password
+			}
+
+			contents := "not-really-a-zip-" + v.Name
+			zipFile := writeTestZipPackage(t, contents)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			deployer := ZipDeploy{
+				ScmUri:       server.URL + "/",
+				Username:     fake.username,
+				Password:     password,
+				HTTPClient:   server.Client(),
+				PollInterval: 10 * time.Millisecond,
+			}
+			err := deployer.Publish(ctx, zipFile)
+
+			if v.ExpectError != "" {
+				if err == nil {
+					t.Fatalf("expected an error containing %q but didn't get one", v.ExpectError)
+				}
+				if !strings.Contains(err.Error(), v.ExpectError) {
+					t.Fatalf("expected an error containing %q, got %+v", v.ExpectError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			fake.mu.Lock()
+			defer fake.mu.Unlock()
+
+			if v.Password == "" && string(fake.uploaded) != contents {
+				t.Fatalf("expected the uploaded package to be %q, got %q", contents, string(fake.uploaded))
+			}
+			if fake.polls != v.ExpectPolls {
+				t.Fatalf("expected %d status polls, got %d", v.ExpectPolls, fake.polls)
+			}
+		})
+	}
+}
+
+func TestZipDeployFileHash(t *testing.T) {
+	first, err := ZipDeployFileHash(writeTestZipPackage(t, "hello"))
+	if err != nil {
+		t.Fatalf("hashing: %+v", err)
+	}
+
+	// sha256 of "hello"
+	expected := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	if first != expected {
+		t.Fatalf("expected %q, got %q", expected, first)
+	}
+
+	second, err := ZipDeployFileHash(writeTestZipPackage(t, "hello world"))
+	if err != nil {
+		t.Fatalf("hashing: %+v", err)
+	}
+	if first == second {
+		t.Fatalf("expected different contents to produce different hashes")
+	}
+
+	if _, err := ZipDeployFileHash(filepath.Join(t.TempDir(), "missing.zip")); err == nil {
+		t.Fatalf("expected an error for a missing file")
+	}
+}
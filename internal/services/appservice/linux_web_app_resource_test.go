@@ -1,8 +1,11 @@
 package appservice_test
 
 import (
+	"archive/zip"
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -1013,6 +1016,69 @@ func TestAccLinuxWebApp_appSettings(t *testing.T) {
 	})
 }
 
+func TestAccLinuxWebApp_zipDeploy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
+	r := LinuxWebAppResource{}
+	zipFile := filepath.Join(t.TempDir(), "app.zip")
+	writeTestZipDeployFile(t, zipFile, "hello")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.zipDeploy(data, zipFile),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zip_deploy_file_hash").Exists(),
+			),
+		},
+		data.ImportStep("zip_deploy_file", "zip_deploy_file_hash"),
+		{
+			// the path is unchanged, so the redeployment is triggered by the change in the hash of the contents
+			PreConfig: func() {
+				writeTestZipDeployFile(t, zipFile, "hello world")
+			},
+			Config: r.zipDeploy(data, zipFile),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zip_deploy_file_hash").Exists(),
+			),
+		},
+		data.ImportStep("zip_deploy_file", "zip_deploy_file_hash"),
+	})
+}
+
+func TestAccLinuxWebApp_trafficRouting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
+	r := LinuxWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.trafficRouting(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic_routing.#").HasValue("1"),
+				check.That(data.ResourceName).Key("traffic_routing.0.percentage").HasValue("20"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.trafficRoutingAutoRamp(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic_routing.0.auto_ramp.0.change_interval_in_minutes").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.trafficRoutingRemoved(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic_routing.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 // Exists func
 
 func (r LinuxWebAppResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
@@ -2224,6 +2290,138 @@ resource "azurerm_linux_web_app" "test" {
 
 // TODO - Test for new acr creds?
 
+func (r LinuxWebAppResource) zipDeploy(data acceptance.TestData, zipFile string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  app_settings = {
+    SCM_DO_BUILD_DURING_DEPLOYMENT = "true"
+  }
+
+  site_config {
+    application_stack {
+      node_version = "14-lts"
+    }
+  }
+
+  zip_deploy_file = "%s"
+}
+`, r.baseTemplate(data), data.RandomInteger, filepath.ToSlash(zipFile))
+}
+
+func (r LinuxWebAppResource) trafficRouting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+
+  traffic_routing {
+    slot_name  = "staging"
+    percentage = 20
+  }
+}
+
+resource "azurerm_linux_web_app_slot" "test" {
+  name                = "staging"
+  app_service_name    = azurerm_linux_web_app.test.name
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+`, r.standardPlanTemplate(data), data.RandomInteger)
+}
+
+func (r LinuxWebAppResource) trafficRoutingAutoRamp(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+
+  traffic_routing {
+    slot_name  = "staging"
+    percentage = 10
+
+    auto_ramp {
+      change_step                = 5
+      change_interval_in_minutes = 10
+      max_percentage             = 50
+    }
+  }
+}
+
+resource "azurerm_linux_web_app_slot" "test" {
+  name                = "staging"
+  app_service_name    = azurerm_linux_web_app.test.name
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+`, r.standardPlanTemplate(data), data.RandomInteger)
+}
+
+func (r LinuxWebAppResource) trafficRoutingRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+
+resource "azurerm_linux_web_app_slot" "test" {
+  name                = "staging"
+  app_service_name    = azurerm_linux_web_app.test.name
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+`, r.standardPlanTemplate(data), data.RandomInteger)
+}
+
 // Templates
 
 func (LinuxWebAppResource) baseTemplate(data acceptance.TestData) string {
@@ -2344,3 +2542,23 @@ data "azurerm_storage_account_sas" "test" {
 }
 `, r.standardPlanTemplate(data), data.RandomInteger, data.RandomString)
 }
+
+func writeTestZipDeployFile(t *testing.T, path string, contents string) {
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("creating %q: %+v", path, err)
+	}
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	index, err := writer.Create("index.js")
+	if err != nil {
+		t.Fatalf("adding index.js to %q: %+v", path, err)
+	}
+	if _, err := fmt.Fprintf(index, "require('http').createServer((req, res) => res.end(%q)).listen(process.env.PORT);\n", contents); err != nil {
+		t.Fatalf("writing index.js to %q: %+v", path, err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("closing %q: %+v", path, err)
+	}
+}
@@ -39,11 +39,15 @@ func (r Registration) Resources() []sdk.Resource {
 			AppServiceSourceControlResource{},
 			AppServiceSourceControlTokenResource{},
 			LinuxFunctionAppResource{},
+			LinuxFunctionAppSlotResource{},
 			LinuxWebAppResource{},
 			LinuxWebAppSlotResource{},
 			ServicePlanResource{},
+			WebAppActiveSlotResource{},
 			WindowsWebAppResource{},
+			WindowsWebAppSlotResource{},
 			WindowsFunctionAppResource{},
+			WindowsFunctionAppSlotResource{},
 		}
 	}
 	return []sdk.Resource{}
@@ -0,0 +1,206 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppActiveSlotResource struct{}
+
+type WebAppActiveSlotModel struct {
+	SlotId                 string `tfschema:"slot_id"`
+	OverwriteNetworkConfig bool   `tfschema:"overwrite_network_config"`
+	LastSuccessfulSwap     string `tfschema:"last_successful_swap"`
+}
+
+var _ sdk.ResourceWithUpdate = WebAppActiveSlotResource{}
+
+func (r WebAppActiveSlotResource) ModelObject() interface{} {
+	return &WebAppActiveSlotModel{}
+}
+
+func (r WebAppActiveSlotResource) ResourceType() string {
+	return "azurerm_web_app_active_slot"
+}
+
+func (r WebAppActiveSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WebAppID
+}
+
+func (r WebAppActiveSlotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		// Web App and Function App Slots share the same Resource ID format, so this works with either
+		"slot_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.WebAppSlotID,
+			Description:  "The ID of the Slot to swap with `Production`.",
+		},
+
+		"overwrite_network_config": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "The swap action should overwrite the Production slot's network configuration with the configuration from this slot. Defaults to `true`.",
+		},
+	}
+}
+
+func (r WebAppActiveSlotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"last_successful_swap": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The timestamp of the last successful swap with `Production`.",
+		},
+	}
+}
+
+func (r WebAppActiveSlotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var activeSlot WebAppActiveSlotModel
+			if err := metadata.Decode(&activeSlot); err != nil {
+				return err
+			}
+
+			id, err := parse.WebAppSlotID(activeSlot.SlotId)
+			if err != nil {
+				return err
+			}
+
+			webAppId := parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+
+			if err := r.swapSlotWithProduction(ctx, metadata, *id, activeSlot.OverwriteNetworkConfig); err != nil {
+				return err
+			}
+
+			metadata.SetID(webAppId)
+			return nil
+		},
+	}
+}
+
+func (r WebAppActiveSlotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			webApp, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(webApp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading active slot for %s: %+v", id, err)
+			}
+
+			props := webApp.SiteProperties
+			if props == nil || props.SlotSwapStatus == nil || props.SlotSwapStatus.SourceSlotName == nil {
+				// no swap has completed for this app, so there's no active slot to track
+				metadata.Logger.Infof("no swap status was returned for %s - removing from state", *id)
+				return metadata.MarkAsGone(id)
+			}
+
+			state := WebAppActiveSlotModel{
+				SlotId: parse.NewWebAppSlotID(id.SubscriptionId, id.ResourceGroup, id.SiteName, *props.SlotSwapStatus.SourceSlotName).ID(),
+			}
+
+			if v := props.SlotSwapStatus.TimestampUtc; v != nil {
+				state.LastSuccessfulSwap = v.Format(time.RFC3339)
+			}
+
+			// this isn't returned by the API, so is retained from the config
+			state.OverwriteNetworkConfig = metadata.ResourceData.Get("overwrite_network_config").(bool)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WebAppActiveSlotResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var activeSlot WebAppActiveSlotModel
+			if err := metadata.Decode(&activeSlot); err != nil {
+				return err
+			}
+
+			if !metadata.ResourceData.HasChange("slot_id") {
+				return nil
+			}
+
+			id, err := parse.WebAppSlotID(activeSlot.SlotId)
+			if err != nil {
+				return err
+			}
+
+			return r.swapSlotWithProduction(ctx, metadata, *id, activeSlot.OverwriteNetworkConfig)
+		},
+	}
+}
+
+func (r WebAppActiveSlotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.WebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a swap can't be undone by removing this resource, so this only removes it from the state
+			metadata.Logger.Infof("removing the active slot for %s from state only", *id)
+			return nil
+		},
+	}
+}
+
+func (r WebAppActiveSlotResource) swapSlotWithProduction(ctx context.Context, metadata sdk.ResourceMetaData, id parse.WebAppSlotId, overwriteNetworkConfig bool) error {
+	client := metadata.Client.AppService.WebAppsClient
+
+	webAppId := parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+
+	locks.ByID(webAppId.ID())
+	defer locks.UnlockByID(webAppId.ID())
+
+	slot, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		if utils.ResponseWasNotFound(slot.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("reading %s: %+v", id, err)
+	}
+
+	slotEntity := web.CsmSlotEntity{
+		TargetSlot:   utils.String(id.SlotName),
+		PreserveVnet: utils.Bool(overwriteNetworkConfig),
+	}
+
+	future, err := client.SwapSlotWithProduction(ctx, id.ResourceGroup, id.SiteName, slotEntity)
+	if err != nil {
+		return fmt.Errorf("swapping %s with Production: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for swap of %s with Production: %+v", id, err)
+	}
+
+	return nil
+}
@@ -59,7 +59,9 @@ type WindowsFunctionAppModel struct {
 	PossibleOutboundIPAddresses   string   `tfschema:"possible_outbound_ip_addresses"`
 	PossibleOutboundIPAddressList []string `tfschema:"possible_outbound_ip_address_list"`
 
-	SiteCredentials []helpers.SiteCredential `tfschema:"site_credential"`
+	SiteCredentials   []helpers.SiteCredential `tfschema:"site_credential"`
+	ZipDeployFile     string                   `tfschema:"zip_deploy_file"`
+	ZipDeployFileHash string                   `tfschema:"zip_deploy_file_hash"`
 }
 
 var _ sdk.ResourceWithUpdate = WindowsFunctionAppResource{}
@@ -213,6 +215,13 @@ func (r WindowsFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
 		"site_config": helpers.SiteConfigSchemaWindowsFunctionApp(),
 
 		"tags": tags.Schema(),
+
+		"zip_deploy_file": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The local path and filename of the Zip packaged application to deploy to this Windows Function App. Using this value requires either `WEBSITE_RUN_FROM_PACKAGE=1` or `SCM_DO_BUILD_DURING_DEPLOYMENT=true` to be set on the App in `app_settings`.",
+		},
 	}
 }
 
@@ -261,6 +270,12 @@ func (r WindowsFunctionAppResource) Attributes() map[string]*pluginsdk.Schema {
 		},
 
 		"site_credential": helpers.SiteCredentialSchema(),
+
+		"zip_deploy_file_hash": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The SHA256 hash of the Zip Package which was last deployed from `zip_deploy_file`.",
+		},
 	}
 }
 
@@ -446,6 +461,13 @@ func (r WindowsFunctionAppResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+
+			if functionApp.ZipDeployFile != "" {
+				if err := helpers.PublishZipDeployFile(ctx, client, metadata.ResourceData, id.ResourceGroup, id.SiteName, "", functionApp.ZipDeployFile); err != nil {
+					return fmt.Errorf("deploying Zip Package to Windows %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
@@ -555,6 +577,10 @@ func (r WindowsFunctionAppResource) Read() sdk.ResourceFunc {
 			state.HttpsOnly = utils.NormaliseNilableBool(functionApp.HTTPSOnly)
 			state.ClientCertEnabled = utils.NormaliseNilableBool(functionApp.ClientCertEnabled)
 
+			// the Zip Package isn't returned by the API, so these are retained from the config/state
+			state.ZipDeployFile = metadata.ResourceData.Get("zip_deploy_file").(string)
+			state.ZipDeployFileHash = metadata.ResourceData.Get("zip_deploy_file_hash").(string)
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -708,6 +734,12 @@ func (r WindowsFunctionAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChanges("zip_deploy_file", "zip_deploy_file_hash") && state.ZipDeployFile != "" {
+				if err := helpers.PublishZipDeployFile(ctx, client, metadata.ResourceData, id.ResourceGroup, id.SiteName, "", state.ZipDeployFile); err != nil {
+					return fmt.Errorf("deploying Zip Package to Windows %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
@@ -754,6 +786,10 @@ func (r WindowsFunctionAppResource) CustomizeDiff() sdk.ResourceFunc {
 			client := metadata.Client.AppService.ServicePlanClient
 			rd := metadata.ResourceDiff
 
+			if err := helpers.ZipDeployFileHashCustomizeDiff(rd); err != nil {
+				return err
+			}
+
 			if rd.HasChange("service_plan_id") {
 				currentPlanIdRaw, newPlanIdRaw := rd.GetChange("service_plan_id")
 				if newPlanIdRaw.(string) == "" {
@@ -47,11 +47,16 @@ type WindowsWebAppModel struct {
 	PossibleOutboundIPAddresses   string                      `tfschema:"possible_outbound_ip_addresses"`
 	PossibleOutboundIPAddressList []string                    `tfschema:"possible_outbound_ip_address_list"`
 	SiteCredentials               []helpers.SiteCredential    `tfschema:"site_credential"`
+	TrafficRouting                []helpers.TrafficRouting    `tfschema:"traffic_routing"`
+	ZipDeployFile                 string                      `tfschema:"zip_deploy_file"`
+	ZipDeployFileHash             string                      `tfschema:"zip_deploy_file_hash"`
 	Tags                          map[string]string           `tfschema:"tags"`
 }
 
 var _ sdk.ResourceWithCustomImporter = WindowsWebAppResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = WindowsWebAppResource{}
+
 func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -130,6 +135,15 @@ func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 		"storage_account": helpers.StorageAccountSchemaWindows(),
 
 		"tags": tags.Schema(),
+
+		"traffic_routing": helpers.TrafficRoutingSchema(),
+
+		"zip_deploy_file": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The local path and filename of the Zip packaged application to deploy to this Windows Web App. Using this value requires either `WEBSITE_RUN_FROM_PACKAGE=1` or `SCM_DO_BUILD_DURING_DEPLOYMENT=true` to be set on the App in `app_settings`.",
+		},
 	}
 }
 
@@ -181,6 +195,12 @@ func (r WindowsWebAppResource) Attributes() map[string]*pluginsdk.Schema {
 		},
 
 		"site_credential": helpers.SiteCredentialSchema(),
+
+		"zip_deploy_file_hash": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The SHA256 hash of the Zip Package which was last deployed from `zip_deploy_file`.",
+		},
 	}
 }
 
@@ -346,6 +366,18 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if len(webApp.TrafficRouting) > 0 {
+				if err := helpers.UpdateTrafficRouting(ctx, client, id.ResourceGroup, id.SiteName, webApp.TrafficRouting); err != nil {
+					return fmt.Errorf("setting Traffic Routing for Windows %s: %+v", id, err)
+				}
+			}
+
+			if webApp.ZipDeployFile != "" {
+				if err := helpers.PublishZipDeployFile(ctx, client, metadata.ResourceData, id.ResourceGroup, id.SiteName, "", webApp.ZipDeployFile); err != nil {
+					return fmt.Errorf("deploying Zip Package to Windows %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 
@@ -509,6 +541,14 @@ func (r WindowsWebAppResource) Read() sdk.ResourceFunc {
 
 			state.SiteCredentials = helpers.FlattenSiteCredentials(siteCredentials)
 
+			if webAppSiteConfig.SiteConfig != nil {
+				state.TrafficRouting = helpers.FlattenTrafficRouting(webAppSiteConfig.SiteConfig.Experiments)
+			}
+
+			// the Zip Package isn't returned by the API, so these are retained from the config/state
+			state.ZipDeployFile = metadata.ResourceData.Get("zip_deploy_file").(string)
+			state.ZipDeployFileHash = metadata.ResourceData.Get("zip_deploy_file_hash").(string)
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -669,11 +709,33 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			// a change to `site_config` replaces the whole Site Config, so the Traffic Routing rules are re-applied alongside it
+			if metadata.ResourceData.HasChange("traffic_routing") || (metadata.ResourceData.HasChange("site_config") && len(state.TrafficRouting) > 0) {
+				if err := helpers.UpdateTrafficRouting(ctx, client, id.ResourceGroup, id.SiteName, state.TrafficRouting); err != nil {
+					return fmt.Errorf("updating Traffic Routing for Windows %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("zip_deploy_file", "zip_deploy_file_hash") && state.ZipDeployFile != "" {
+				if err := helpers.PublishZipDeployFile(ctx, client, metadata.ResourceData, id.ResourceGroup, id.SiteName, "", state.ZipDeployFile); err != nil {
+					return fmt.Errorf("deploying Zip Package to Windows %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
 }
 
+func (r WindowsWebAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return helpers.ZipDeployFileHashCustomizeDiff(metadata.ResourceDiff)
+		},
+	}
+}
+
 func (r WindowsWebAppResource) CustomImporter() sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 		client := metadata.Client.AppService.WebAppsClient
@@ -0,0 +1,297 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	dnsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorCustomDomainResource struct{}
+
+type CdnFrontDoorCustomDomainModel struct {
+	Name                  string                        `tfschema:"name"`
+	CdnFrontDoorProfileId string                        `tfschema:"cdn_frontdoor_profile_id"`
+	DnsZoneId             string                        `tfschema:"dns_zone_id"`
+	HostName              string                        `tfschema:"host_name"`
+	Tls                   []CdnFrontDoorCustomDomainTls `tfschema:"tls"`
+	ExpirationDate        string                        `tfschema:"expiration_date"`
+	ValidationToken       string                        `tfschema:"validation_token"`
+}
+
+type CdnFrontDoorCustomDomainTls struct {
+	CertificateType   string `tfschema:"certificate_type"`
+	MinimumTlsVersion string `tfschema:"minimum_tls_version"`
+}
+
+var _ sdk.ResourceWithUpdate = CdnFrontDoorCustomDomainResource{}
+
+func (r CdnFrontDoorCustomDomainResource) ModelObject() interface{} {
+	return &CdnFrontDoorCustomDomainModel{}
+}
+
+func (r CdnFrontDoorCustomDomainResource) ResourceType() string {
+	return "azurerm_cdn_frontdoor_custom_domain"
+}
+
+func (r CdnFrontDoorCustomDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FrontDoorCustomDomainID
+}
+
+func (r CdnFrontDoorCustomDomainResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FrontDoorName(),
+		},
+
+		"cdn_frontdoor_profile_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ProfileID,
+		},
+
+		"host_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dns_zone_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: dnsValidate.DnsZoneID,
+		},
+
+		"tls": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					// customer certificates require a Front Door Secret which isn't supported yet
+					"certificate_type": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(cdn.AfdCertificateTypeManagedCertificate),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(cdn.AfdCertificateTypeManagedCertificate),
+						}, false),
+					},
+
+					"minimum_tls_version": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(cdn.AfdMinimumTLSVersionTLS12),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(cdn.AfdMinimumTLSVersionTLS10),
+							string(cdn.AfdMinimumTLSVersionTLS12),
+						}, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CdnFrontDoorCustomDomainResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"expiration_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"validation_token": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r CdnFrontDoorCustomDomainResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CdnFrontDoorCustomDomainModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cdn.FrontDoorCustomDomainsClient
+
+			profileId, err := parse.ProfileID(model.CdnFrontDoorProfileId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewFrontDoorCustomDomainID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := cdn.AFDDomain{
+				AFDDomainProperties: &cdn.AFDDomainProperties{
+					AzureDNSZone: expandFrontDoorResourceReference(model.DnsZoneId),
+					HostName:     utils.String(model.HostName),
+					TLSSettings:  expandCdnFrontDoorCustomDomainTls(model.Tls),
+				},
+			}
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName, props)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorCustomDomainResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorCustomDomainsClient
+
+			id, err := parse.FrontDoorCustomDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CdnFrontDoorCustomDomainModel{
+				Name:                  id.CustomDomainName,
+				CdnFrontDoorProfileId: parse.NewProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID(),
+			}
+
+			if props := resp.AFDDomainProperties; props != nil {
+				state.DnsZoneId = flattenFrontDoorResourceReference(props.AzureDNSZone)
+				state.HostName = utils.NormalizeNilableString(props.HostName)
+				state.Tls = flattenCdnFrontDoorCustomDomainTls(props.TLSSettings)
+
+				if validationProps := props.ValidationProperties; validationProps != nil {
+					state.ExpirationDate = utils.NormalizeNilableString(validationProps.ExpirationDate)
+					state.ValidationToken = utils.NormalizeNilableString(validationProps.ValidationToken)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CdnFrontDoorCustomDomainResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorCustomDomainsClient
+
+			id, err := parse.FrontDoorCustomDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CdnFrontDoorCustomDomainModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			params := cdn.AFDDomainUpdateParameters{
+				AFDDomainUpdatePropertiesParameters: &cdn.AFDDomainUpdatePropertiesParameters{
+					AzureDNSZone: expandFrontDoorResourceReference(model.DnsZoneId),
+					TLSSettings:  expandCdnFrontDoorCustomDomainTls(model.Tls),
+				},
+			}
+
+			future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName, params)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorCustomDomainResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorCustomDomainsClient
+
+			id, err := parse.FrontDoorCustomDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", *id)
+			future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				if !response.WasNotFound(future.Response()) {
+					return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCdnFrontDoorCustomDomainTls(input []CdnFrontDoorCustomDomainTls) *cdn.AFDDomainHTTPSParameters {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &cdn.AFDDomainHTTPSParameters{
+		CertificateType:   cdn.AfdCertificateType(input[0].CertificateType),
+		MinimumTLSVersion: cdn.AfdMinimumTLSVersion(input[0].MinimumTlsVersion),
+	}
+}
+
+func flattenCdnFrontDoorCustomDomainTls(input *cdn.AFDDomainHTTPSParameters) []CdnFrontDoorCustomDomainTls {
+	if input == nil {
+		return []CdnFrontDoorCustomDomainTls{}
+	}
+
+	return []CdnFrontDoorCustomDomainTls{
+		{
+			CertificateType:   string(input.CertificateType),
+			MinimumTlsVersion: string(input.MinimumTLSVersion),
+		},
+	}
+}
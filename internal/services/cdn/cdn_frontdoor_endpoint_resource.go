@@ -0,0 +1,240 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorEndpointResource struct{}
+
+type CdnFrontDoorEndpointModel struct {
+	Name                         string                 `tfschema:"name"`
+	CdnFrontDoorProfileId        string                 `tfschema:"cdn_frontdoor_profile_id"`
+	Enabled                      bool                   `tfschema:"enabled"`
+	OriginResponseTimeoutSeconds int                    `tfschema:"origin_response_timeout_seconds"`
+	HostName                     string                 `tfschema:"host_name"`
+	Tags                         map[string]interface{} `tfschema:"tags"`
+}
+
+var _ sdk.ResourceWithUpdate = CdnFrontDoorEndpointResource{}
+
+func (r CdnFrontDoorEndpointResource) ModelObject() interface{} {
+	return &CdnFrontDoorEndpointModel{}
+}
+
+func (r CdnFrontDoorEndpointResource) ResourceType() string {
+	return "azurerm_cdn_frontdoor_endpoint"
+}
+
+func (r CdnFrontDoorEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FrontDoorEndpointID
+}
+
+func (r CdnFrontDoorEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FrontDoorName(),
+		},
+
+		"cdn_frontdoor_profile_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ProfileID,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"origin_response_timeout_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      60,
+			ValidateFunc: validation.IntBetween(16, 240),
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r CdnFrontDoorEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"host_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r CdnFrontDoorEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CdnFrontDoorEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cdn.FrontDoorEndpointsClient
+
+			profileId, err := parse.ProfileID(model.CdnFrontDoorProfileId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewFrontDoorEndpointID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := cdn.AFDEndpoint{
+				// Front Door endpoints are always global
+				Location: utils.String("global"),
+				AFDEndpointProperties: &cdn.AFDEndpointProperties{
+					EnabledState:                 expandFrontDoorEnabledState(model.Enabled),
+					OriginResponseTimeoutSeconds: utils.Int32(int32(model.OriginResponseTimeoutSeconds)),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, props)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorEndpointsClient
+
+			id, err := parse.FrontDoorEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CdnFrontDoorEndpointModel{
+				Name:                  id.AfdEndpointName,
+				CdnFrontDoorProfileId: parse.NewProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID(),
+				Tags:                  tags.Flatten(resp.Tags),
+			}
+
+			if props := resp.AFDEndpointProperties; props != nil {
+				state.Enabled = props.EnabledState == cdn.EnabledStateEnabled
+
+				if props.OriginResponseTimeoutSeconds != nil {
+					state.OriginResponseTimeoutSeconds = int(*props.OriginResponseTimeoutSeconds)
+				}
+
+				if props.HostName != nil {
+					state.HostName = *props.HostName
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CdnFrontDoorEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorEndpointsClient
+
+			id, err := parse.FrontDoorEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CdnFrontDoorEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			props := cdn.AFDEndpointUpdateParameters{
+				AFDEndpointPropertiesUpdateParameters: &cdn.AFDEndpointPropertiesUpdateParameters{
+					EnabledState:                 expandFrontDoorEnabledState(model.Enabled),
+					OriginResponseTimeoutSeconds: utils.Int32(int32(model.OriginResponseTimeoutSeconds)),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, props)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorEndpointsClient
+
+			id, err := parse.FrontDoorEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", *id)
+			future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				if !response.WasNotFound(future.Response()) {
+					return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// isCdnFrontDoorProfileSku returns whether the SKU is used by a Front Door (Standard/Premium) Profile, rather than
+// a classic CDN Profile which shares the same API
+func isCdnFrontDoorProfileSku(input *cdn.Sku) bool {
+	if input == nil {
+		return false
+	}
+
+	return input.Name == cdn.SkuNameStandardAzureFrontDoor || input.Name == cdn.SkuNamePremiumAzureFrontDoor
+}
+
+// requireCdnFrontDoorProfilePremiumSku returns an error when the Front Door Profile doesn't use the Premium SKU,
+// which is required by features such as Private Link Origins
+func requireCdnFrontDoorProfilePremiumSku(ctx context.Context, client *cdn.ProfilesClient, id parse.ProfileId, feature string) error {
+	profile, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if profile.Sku == nil || profile.Sku.Name != cdn.SkuNamePremiumAzureFrontDoor {
+		skuName := ""
+		if profile.Sku != nil {
+			skuName = string(profile.Sku.Name)
+		}
+		return fmt.Errorf("%s can only be used when the `sku_name` of %s is `%s` but got %q", feature, id, string(cdn.SkuNamePremiumAzureFrontDoor), skuName)
+	}
+
+	return nil
+}
+
+func expandFrontDoorEnabledState(input bool) cdn.EnabledState {
+	if input {
+		return cdn.EnabledStateEnabled
+	}
+
+	return cdn.EnabledStateDisabled
+}
+
+func expandFrontDoorResourceReference(input string) *cdn.ResourceReference {
+	if input == "" {
+		return nil
+	}
+
+	return &cdn.ResourceReference{
+		ID: utils.String(input),
+	}
+}
+
+func flattenFrontDoorResourceReference(input *cdn.ResourceReference) string {
+	if input == nil || input.ID == nil {
+		return ""
+	}
+
+	return *input.ID
+}
+
+func expandFrontDoorResourceReferences(input []string) *[]cdn.ResourceReference {
+	output := make([]cdn.ResourceReference, 0)
+	for _, v := range input {
+		output = append(output, cdn.ResourceReference{
+			ID: utils.String(v),
+		})
+	}
+
+	return &output
+}
+
+func flattenFrontDoorResourceReferences(input *[]cdn.ResourceReference) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if v.ID != nil {
+			output = append(output, *v.ID)
+		}
+	}
+
+	return output
+}
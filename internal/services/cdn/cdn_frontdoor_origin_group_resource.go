@@ -0,0 +1,357 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorOriginGroupResource struct{}
+
+type CdnFrontDoorOriginGroupModel struct {
+	Name                   string                                 `tfschema:"name"`
+	CdnFrontDoorProfileId  string                                 `tfschema:"cdn_frontdoor_profile_id"`
+	HealthProbe            []CdnFrontDoorOriginGroupHealthProbe   `tfschema:"health_probe"`
+	LoadBalancing          []CdnFrontDoorOriginGroupLoadBalancing `tfschema:"load_balancing"`
+	SessionAffinityEnabled bool                                   `tfschema:"session_affinity_enabled"`
+}
+
+type CdnFrontDoorOriginGroupHealthProbe struct {
+	IntervalInSeconds int    `tfschema:"interval_in_seconds"`
+	Path              string `tfschema:"path"`
+	Protocol          string `tfschema:"protocol"`
+	RequestType       string `tfschema:"request_type"`
+}
+
+type CdnFrontDoorOriginGroupLoadBalancing struct {
+	AdditionalLatencyInMilliseconds int `tfschema:"additional_latency_in_milliseconds"`
+	SampleSize                      int `tfschema:"sample_size"`
+	SuccessfulSamplesRequired       int `tfschema:"successful_samples_required"`
+}
+
+var _ sdk.ResourceWithUpdate = CdnFrontDoorOriginGroupResource{}
+
+func (r CdnFrontDoorOriginGroupResource) ModelObject() interface{} {
+	return &CdnFrontDoorOriginGroupModel{}
+}
+
+func (r CdnFrontDoorOriginGroupResource) ResourceType() string {
+	return "azurerm_cdn_frontdoor_origin_group"
+}
+
+func (r CdnFrontDoorOriginGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FrontDoorOriginGroupID
+}
+
+func (r CdnFrontDoorOriginGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FrontDoorName(),
+		},
+
+		"cdn_frontdoor_profile_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ProfileID,
+		},
+
+		"load_balancing": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"additional_latency_in_milliseconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      50,
+						ValidateFunc: validation.IntBetween(0, 1000),
+					},
+
+					"sample_size": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      4,
+						ValidateFunc: validation.IntBetween(0, 255),
+					},
+
+					"successful_samples_required": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      3,
+						ValidateFunc: validation.IntBetween(0, 255),
+					},
+				},
+			},
+		},
+
+		"health_probe": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(5, 31536000),
+					},
+
+					"protocol": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(cdn.ProbeProtocolHTTP),
+							string(cdn.ProbeProtocolHTTPS),
+						}, false),
+					},
+
+					"path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "/",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"request_type": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(cdn.HealthProbeRequestTypeHEAD),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(cdn.HealthProbeRequestTypeGET),
+							string(cdn.HealthProbeRequestTypeHEAD),
+						}, false),
+					},
+				},
+			},
+		},
+
+		"session_affinity_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r CdnFrontDoorOriginGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CdnFrontDoorOriginGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CdnFrontDoorOriginGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cdn.FrontDoorOriginGroupsClient
+
+			profileId, err := parse.ProfileID(model.CdnFrontDoorProfileId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewFrontDoorOriginGroupID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, expandCdnFrontDoorOriginGroup(model))
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorOriginGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorOriginGroupsClient
+
+			id, err := parse.FrontDoorOriginGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CdnFrontDoorOriginGroupModel{
+				Name:                  id.OriginGroupName,
+				CdnFrontDoorProfileId: parse.NewProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID(),
+			}
+
+			if props := resp.AFDOriginGroupProperties; props != nil {
+				state.HealthProbe = flattenCdnFrontDoorOriginGroupHealthProbe(props.HealthProbeSettings)
+				state.LoadBalancing = flattenCdnFrontDoorOriginGroupLoadBalancing(props.LoadBalancingSettings)
+				state.SessionAffinityEnabled = props.SessionAffinityState == cdn.EnabledStateEnabled
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CdnFrontDoorOriginGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorOriginGroupsClient
+
+			id, err := parse.FrontDoorOriginGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CdnFrontDoorOriginGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Update (PATCH) API ignores omitted fields, which means the `health_probe` can't be removed
+			// using it - as such we send the full payload using the Create (PUT) API instead
+			future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, expandCdnFrontDoorOriginGroup(model))
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorOriginGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorOriginGroupsClient
+
+			id, err := parse.FrontDoorOriginGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", *id)
+			future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				if !response.WasNotFound(future.Response()) {
+					return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCdnFrontDoorOriginGroup(input CdnFrontDoorOriginGroupModel) cdn.AFDOriginGroup {
+	props := &cdn.AFDOriginGroupProperties{
+		SessionAffinityState: expandFrontDoorEnabledState(input.SessionAffinityEnabled),
+	}
+
+	if len(input.HealthProbe) > 0 {
+		probe := input.HealthProbe[0]
+		props.HealthProbeSettings = &cdn.HealthProbeParameters{
+			ProbeIntervalInSeconds: utils.Int32(int32(probe.IntervalInSeconds)),
+			ProbePath:              utils.String(probe.Path),
+			ProbeProtocol:          cdn.ProbeProtocol(probe.Protocol),
+			ProbeRequestType:       cdn.HealthProbeRequestType(probe.RequestType),
+		}
+	}
+
+	if len(input.LoadBalancing) > 0 {
+		loadBalancing := input.LoadBalancing[0]
+		props.LoadBalancingSettings = &cdn.LoadBalancingSettingsParameters{
+			AdditionalLatencyInMilliseconds: utils.Int32(int32(loadBalancing.AdditionalLatencyInMilliseconds)),
+			SampleSize:                      utils.Int32(int32(loadBalancing.SampleSize)),
+			SuccessfulSamplesRequired:       utils.Int32(int32(loadBalancing.SuccessfulSamplesRequired)),
+		}
+	}
+
+	return cdn.AFDOriginGroup{
+		AFDOriginGroupProperties: props,
+	}
+}
+
+func flattenCdnFrontDoorOriginGroupHealthProbe(input *cdn.HealthProbeParameters) []CdnFrontDoorOriginGroupHealthProbe {
+	if input == nil {
+		return []CdnFrontDoorOriginGroupHealthProbe{}
+	}
+
+	output := CdnFrontDoorOriginGroupHealthProbe{
+		Protocol:    string(input.ProbeProtocol),
+		RequestType: string(input.ProbeRequestType),
+	}
+
+	if input.ProbeIntervalInSeconds != nil {
+		output.IntervalInSeconds = int(*input.ProbeIntervalInSeconds)
+	}
+
+	if input.ProbePath != nil {
+		output.Path = *input.ProbePath
+	}
+
+	return []CdnFrontDoorOriginGroupHealthProbe{output}
+}
+
+func flattenCdnFrontDoorOriginGroupLoadBalancing(input *cdn.LoadBalancingSettingsParameters) []CdnFrontDoorOriginGroupLoadBalancing {
+	if input == nil {
+		return []CdnFrontDoorOriginGroupLoadBalancing{}
+	}
+
+	output := CdnFrontDoorOriginGroupLoadBalancing{}
+
+	if input.AdditionalLatencyInMilliseconds != nil {
+		output.AdditionalLatencyInMilliseconds = int(*input.AdditionalLatencyInMilliseconds)
+	}
+
+	if input.SampleSize != nil {
+		output.SampleSize = int(*input.SampleSize)
+	}
+
+	if input.SuccessfulSamplesRequired != nil {
+		output.SuccessfulSamplesRequired = int(*input.SuccessfulSamplesRequired)
+	}
+
+	return []CdnFrontDoorOriginGroupLoadBalancing{output}
+}
@@ -0,0 +1,396 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorOriginResource struct{}
+
+type CdnFrontDoorOriginModel struct {
+	Name                      string                          `tfschema:"name"`
+	CdnFrontDoorOriginGroupId string                          `tfschema:"cdn_frontdoor_origin_group_id"`
+	Enabled                   bool                            `tfschema:"enabled"`
+	HostName                  string                          `tfschema:"host_name"`
+	HttpPort                  int                             `tfschema:"http_port"`
+	HttpsPort                 int                             `tfschema:"https_port"`
+	OriginHostHeader          string                          `tfschema:"origin_host_header"`
+	Priority                  int                             `tfschema:"priority"`
+	PrivateLink               []CdnFrontDoorOriginPrivateLink `tfschema:"private_link"`
+	Weight                    int                             `tfschema:"weight"`
+}
+
+type CdnFrontDoorOriginPrivateLink struct {
+	Location            string `tfschema:"location"`
+	PrivateLinkTargetId string `tfschema:"private_link_target_id"`
+	RequestMessage      string `tfschema:"request_message"`
+	TargetType          string `tfschema:"target_type"`
+}
+
+var _ sdk.ResourceWithUpdate = CdnFrontDoorOriginResource{}
+
+func (r CdnFrontDoorOriginResource) ModelObject() interface{} {
+	return &CdnFrontDoorOriginModel{}
+}
+
+func (r CdnFrontDoorOriginResource) ResourceType() string {
+	return "azurerm_cdn_frontdoor_origin"
+}
+
+func (r CdnFrontDoorOriginResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FrontDoorOriginID
+}
+
+func (r CdnFrontDoorOriginResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FrontDoorName(),
+		},
+
+		"cdn_frontdoor_origin_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FrontDoorOriginGroupID,
+		},
+
+		"host_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"http_port": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      80,
+			ValidateFunc: validation.IsPortNumber,
+		},
+
+		"https_port": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      443,
+			ValidateFunc: validation.IsPortNumber,
+		},
+
+		"origin_host_header": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 5),
+		},
+
+		"weight": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      500,
+			ValidateFunc: validation.IntBetween(1, 1000),
+		},
+
+		"private_link": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"location": {
+						Type:             pluginsdk.TypeString,
+						Required:         true,
+						ValidateFunc:     validation.StringIsNotEmpty,
+						StateFunc:        location.StateFunc,
+						DiffSuppressFunc: location.DiffSuppressFunc,
+					},
+
+					"private_link_target_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+
+					"request_message": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "Access request for CDN Front Door Private Link Origin",
+						ValidateFunc: validation.StringLenBetween(1, 140),
+					},
+
+					"target_type": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"blob",
+							"blob_secondary",
+							"sites",
+							"web",
+						}, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CdnFrontDoorOriginResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CdnFrontDoorOriginResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CdnFrontDoorOriginModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cdn.FrontDoorOriginsClient
+
+			originGroupId, err := parse.FrontDoorOriginGroupID(model.CdnFrontDoorOriginGroupId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewFrontDoorOriginID(originGroupId.SubscriptionId, originGroupId.ResourceGroup, originGroupId.ProfileName, originGroupId.OriginGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if len(model.PrivateLink) > 0 {
+				profileId := parse.NewProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName)
+				if err := requireCdnFrontDoorProfilePremiumSku(ctx, metadata.Client.Cdn.ProfilesClient, profileId, "`private_link`"); err != nil {
+					return err
+				}
+			}
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName, expandCdnFrontDoorOrigin(model))
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorOriginResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorOriginsClient
+
+			id, err := parse.FrontDoorOriginID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CdnFrontDoorOriginModel{
+				Name:                      id.OriginName,
+				CdnFrontDoorOriginGroupId: parse.NewFrontDoorOriginGroupID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.OriginGroupName).ID(),
+			}
+
+			if props := resp.AFDOriginProperties; props != nil {
+				state.Enabled = props.EnabledState == cdn.EnabledStateEnabled
+				state.HostName = utils.NormalizeNilableString(props.HostName)
+				state.OriginHostHeader = utils.NormalizeNilableString(props.OriginHostHeader)
+
+				if props.HTTPPort != nil {
+					state.HttpPort = int(*props.HTTPPort)
+				}
+				if props.HTTPSPort != nil {
+					state.HttpsPort = int(*props.HTTPSPort)
+				}
+				if props.Priority != nil {
+					state.Priority = int(*props.Priority)
+				}
+				if props.Weight != nil {
+					state.Weight = int(*props.Weight)
+				}
+
+				privateLink, err := flattenCdnFrontDoorOriginPrivateLink(props.SharedPrivateLinkResource)
+				if err != nil {
+					return fmt.Errorf("flattening `private_link`: %+v", err)
+				}
+				state.PrivateLink = privateLink
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CdnFrontDoorOriginResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorOriginsClient
+
+			id, err := parse.FrontDoorOriginID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CdnFrontDoorOriginModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if len(model.PrivateLink) > 0 {
+				profileId := parse.NewProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName)
+				if err := requireCdnFrontDoorProfilePremiumSku(ctx, metadata.Client.Cdn.ProfilesClient, profileId, "`private_link`"); err != nil {
+					return err
+				}
+			}
+
+			// as with the Origin Group the Update (PATCH) API can't remove the `private_link`, so the full
+			// payload is sent using the Create (PUT) API
+			future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName, expandCdnFrontDoorOrigin(model))
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorOriginResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorOriginsClient
+
+			id, err := parse.FrontDoorOriginID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", *id)
+			future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				if !response.WasNotFound(future.Response()) {
+					return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCdnFrontDoorOrigin(input CdnFrontDoorOriginModel) cdn.AFDOrigin {
+	props := &cdn.AFDOriginProperties{
+		EnabledState: expandFrontDoorEnabledState(input.Enabled),
+		HostName:     utils.String(input.HostName),
+		HTTPPort:     utils.Int32(int32(input.HttpPort)),
+		HTTPSPort:    utils.Int32(int32(input.HttpsPort)),
+		Priority:     utils.Int32(int32(input.Priority)),
+		Weight:       utils.Int32(int32(input.Weight)),
+	}
+
+	if input.OriginHostHeader != "" {
+		props.OriginHostHeader = utils.String(input.OriginHostHeader)
+	}
+
+	if len(input.PrivateLink) > 0 {
+		privateLink := input.PrivateLink[0]
+		sharedPrivateLink := cdn.SharedPrivateLinkResourceProperties{
+			PrivateLink: &cdn.ResourceReference{
+				ID: utils.String(privateLink.PrivateLinkTargetId),
+			},
+			PrivateLinkLocation: utils.String(privateLink.Location),
+			RequestMessage:      utils.String(privateLink.RequestMessage),
+		}
+
+		if privateLink.TargetType != "" {
+			sharedPrivateLink.GroupID = utils.String(privateLink.TargetType)
+		}
+
+		props.SharedPrivateLinkResource = sharedPrivateLink
+	}
+
+	return cdn.AFDOrigin{
+		AFDOriginProperties: props,
+	}
+}
+
+func flattenCdnFrontDoorOriginPrivateLink(input interface{}) ([]CdnFrontDoorOriginPrivateLink, error) {
+	if input == nil {
+		return []CdnFrontDoorOriginPrivateLink{}, nil
+	}
+
+	// the SDK exposes this field as an `interface{}` - so we round-trip it through json to get the typed model
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling: %+v", err)
+	}
+
+	var sharedPrivateLink cdn.SharedPrivateLinkResourceProperties
+	if err := json.Unmarshal(raw, &sharedPrivateLink); err != nil {
+		return nil, fmt.Errorf("unmarshaling: %+v", err)
+	}
+
+	if sharedPrivateLink.PrivateLink == nil || sharedPrivateLink.PrivateLink.ID == nil {
+		return []CdnFrontDoorOriginPrivateLink{}, nil
+	}
+
+	return []CdnFrontDoorOriginPrivateLink{
+		{
+			Location:            location.NormalizeNilable(sharedPrivateLink.PrivateLinkLocation),
+			PrivateLinkTargetId: *sharedPrivateLink.PrivateLink.ID,
+			RequestMessage:      utils.NormalizeNilableString(sharedPrivateLink.RequestMessage),
+			TargetType:          utils.NormalizeNilableString(sharedPrivateLink.GroupID),
+		},
+	}, nil
+}
@@ -0,0 +1,230 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorProfileResource struct{}
+
+type CdnFrontDoorProfileModel struct {
+	Name              string                 `tfschema:"name"`
+	ResourceGroupName string                 `tfschema:"resource_group_name"`
+	SkuName           string                 `tfschema:"sku_name"`
+	ResourceGuid      string                 `tfschema:"resource_guid"`
+	Tags              map[string]interface{} `tfschema:"tags"`
+}
+
+var _ sdk.ResourceWithUpdate = CdnFrontDoorProfileResource{}
+
+func (r CdnFrontDoorProfileResource) ModelObject() interface{} {
+	return &CdnFrontDoorProfileModel{}
+}
+
+func (r CdnFrontDoorProfileResource) ResourceType() string {
+	return "azurerm_cdn_frontdoor_profile"
+}
+
+func (r CdnFrontDoorProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ProfileID
+}
+
+func (r CdnFrontDoorProfileResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FrontDoorName(),
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(cdn.SkuNameStandardAzureFrontDoor),
+				string(cdn.SkuNamePremiumAzureFrontDoor),
+			}, false),
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r CdnFrontDoorProfileResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_guid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r CdnFrontDoorProfileResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CdnFrontDoorProfileModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cdn.ProfilesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := parse.NewProfileID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := cdn.Profile{
+				// Front Door profiles are always global
+				Location: utils.String("global"),
+				Sku: &cdn.Sku{
+					Name: cdn.SkuName(model.SkuName),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.Name, props)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorProfileResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.ProfilesClient
+
+			id, err := parse.ProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// classic CDN Profiles share this API, so these are rejected rather than being managed (or imported) here
+			if !isCdnFrontDoorProfileSku(resp.Sku) {
+				skuName := ""
+				if resp.Sku != nil {
+					skuName = string(resp.Sku.Name)
+				}
+				return fmt.Errorf("%s is not a CDN Front Door Profile since it uses the SKU %q - it should be managed using the `azurerm_cdn_profile` resource", *id, skuName)
+			}
+
+			state := CdnFrontDoorProfileModel{
+				Name:              id.Name,
+				ResourceGroupName: id.ResourceGroup,
+				Tags:              tags.Flatten(resp.Tags),
+			}
+
+			if sku := resp.Sku; sku != nil {
+				state.SkuName = string(sku.Name)
+			}
+
+			if props := resp.ProfileProperties; props != nil && props.FrontdoorID != nil {
+				state.ResourceGuid = *props.FrontdoorID
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CdnFrontDoorProfileResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.ProfilesClient
+
+			id, err := parse.ProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CdnFrontDoorProfileModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				props := cdn.ProfileUpdateParameters{
+					Tags: tags.Expand(model.Tags),
+				}
+
+				future, err := client.Update(ctx, id.ResourceGroup, id.Name, props)
+				if err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+				if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorProfileResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.ProfilesClient
+
+			id, err := parse.ProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", *id)
+			future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				if !response.WasNotFound(future.Response()) {
+					return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,429 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorRouteResource struct{}
+
+type CdnFrontDoorRouteModel struct {
+	Name                        string                   `tfschema:"name"`
+	CdnFrontDoorEndpointId      string                   `tfschema:"cdn_frontdoor_endpoint_id"`
+	CdnFrontDoorOriginGroupId   string                   `tfschema:"cdn_frontdoor_origin_group_id"`
+	CdnFrontDoorCustomDomainIds []string                 `tfschema:"cdn_frontdoor_custom_domain_ids"`
+	CdnFrontDoorOriginPath      string                   `tfschema:"cdn_frontdoor_origin_path"`
+	CdnFrontDoorRuleSetIds      []string                 `tfschema:"cdn_frontdoor_rule_set_ids"`
+	Cache                       []CdnFrontDoorRouteCache `tfschema:"cache"`
+	Enabled                     bool                     `tfschema:"enabled"`
+	ForwardingProtocol          string                   `tfschema:"forwarding_protocol"`
+	HttpsRedirectEnabled        bool                     `tfschema:"https_redirect_enabled"`
+	LinkToDefaultDomainEnabled  bool                     `tfschema:"link_to_default_domain_enabled"`
+	PatternsToMatch             []string                 `tfschema:"patterns_to_match"`
+	SupportedProtocols          []string                 `tfschema:"supported_protocols"`
+}
+
+type CdnFrontDoorRouteCache struct {
+	CompressionEnabled         bool     `tfschema:"compression_enabled"`
+	ContentTypesToCompress     []string `tfschema:"content_types_to_compress"`
+	QueryStringCachingBehavior string   `tfschema:"query_string_caching_behavior"`
+}
+
+var _ sdk.ResourceWithUpdate = CdnFrontDoorRouteResource{}
+
+func (r CdnFrontDoorRouteResource) ModelObject() interface{} {
+	return &CdnFrontDoorRouteModel{}
+}
+
+func (r CdnFrontDoorRouteResource) ResourceType() string {
+	return "azurerm_cdn_frontdoor_route"
+}
+
+func (r CdnFrontDoorRouteResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FrontDoorRouteID
+}
+
+func (r CdnFrontDoorRouteResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FrontDoorName(),
+		},
+
+		"cdn_frontdoor_endpoint_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FrontDoorEndpointID,
+		},
+
+		"cdn_frontdoor_origin_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.FrontDoorOriginGroupID,
+		},
+
+		"patterns_to_match": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"supported_protocols": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cdn.AFDEndpointProtocolsHTTP),
+					string(cdn.AFDEndpointProtocolsHTTPS),
+				}, false),
+			},
+		},
+
+		"cdn_frontdoor_custom_domain_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.FrontDoorCustomDomainID,
+			},
+		},
+
+		"cdn_frontdoor_origin_path": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cdn_frontdoor_rule_set_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.FrontDoorRuleSetID,
+			},
+		},
+
+		"cache": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"query_string_caching_behavior": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(cdn.AfdQueryStringCachingBehaviorIgnoreQueryString),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(cdn.AfdQueryStringCachingBehaviorIgnoreQueryString),
+							string(cdn.AfdQueryStringCachingBehaviorUseQueryString),
+							string(cdn.AfdQueryStringCachingBehaviorNotSet),
+						}, false),
+					},
+
+					"compression_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"content_types_to_compress": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"forwarding_protocol": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(cdn.ForwardingProtocolMatchRequest),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(cdn.ForwardingProtocolHTTPOnly),
+				string(cdn.ForwardingProtocolHTTPSOnly),
+				string(cdn.ForwardingProtocolMatchRequest),
+			}, false),
+		},
+
+		"https_redirect_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"link_to_default_domain_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r CdnFrontDoorRouteResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CdnFrontDoorRouteResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CdnFrontDoorRouteModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cdn.FrontDoorRoutesClient
+
+			endpointId, err := parse.FrontDoorEndpointID(model.CdnFrontDoorEndpointId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewFrontDoorRouteID(endpointId.SubscriptionId, endpointId.ResourceGroup, endpointId.ProfileName, endpointId.AfdEndpointName, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, expandCdnFrontDoorRoute(model))
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorRouteResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorRoutesClient
+
+			id, err := parse.FrontDoorRouteID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CdnFrontDoorRouteModel{
+				Name:                   id.RouteName,
+				CdnFrontDoorEndpointId: parse.NewFrontDoorEndpointID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName).ID(),
+			}
+
+			if props := resp.RouteProperties; props != nil {
+				state.CdnFrontDoorCustomDomainIds = flattenFrontDoorResourceReferences(props.CustomDomains)
+				state.CdnFrontDoorOriginGroupId = flattenFrontDoorResourceReference(props.OriginGroup)
+				state.CdnFrontDoorOriginPath = utils.NormalizeNilableString(props.OriginPath)
+				state.CdnFrontDoorRuleSetIds = flattenFrontDoorResourceReferences(props.RuleSets)
+				state.Enabled = props.EnabledState == cdn.EnabledStateEnabled
+				state.ForwardingProtocol = string(props.ForwardingProtocol)
+				state.HttpsRedirectEnabled = props.HTTPSRedirect == cdn.HTTPSRedirectEnabled
+				state.LinkToDefaultDomainEnabled = props.LinkToDefaultDomain == cdn.LinkToDefaultDomainEnabled
+
+				if props.PatternsToMatch != nil {
+					state.PatternsToMatch = *props.PatternsToMatch
+				}
+
+				supportedProtocols := make([]string, 0)
+				if props.SupportedProtocols != nil {
+					for _, v := range *props.SupportedProtocols {
+						supportedProtocols = append(supportedProtocols, string(v))
+					}
+				}
+				state.SupportedProtocols = supportedProtocols
+
+				cache, err := flattenCdnFrontDoorRouteCache(props.QueryStringCachingBehavior, props.CompressionSettings)
+				if err != nil {
+					return fmt.Errorf("flattening `cache`: %+v", err)
+				}
+				state.Cache = cache
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CdnFrontDoorRouteResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorRoutesClient
+
+			id, err := parse.FrontDoorRouteID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CdnFrontDoorRouteModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Update (PATCH) API can't remove the `cache` or `cdn_frontdoor_origin_path`, so the full
+			// payload is sent using the Create (PUT) API
+			future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, expandCdnFrontDoorRoute(model))
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorRouteResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorRoutesClient
+
+			id, err := parse.FrontDoorRouteID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", *id)
+			future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				if !response.WasNotFound(future.Response()) {
+					return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCdnFrontDoorRoute(input CdnFrontDoorRouteModel) cdn.Route {
+	supportedProtocols := make([]cdn.AFDEndpointProtocols, 0)
+	for _, v := range input.SupportedProtocols {
+		supportedProtocols = append(supportedProtocols, cdn.AFDEndpointProtocols(v))
+	}
+
+	httpsRedirect := cdn.HTTPSRedirectDisabled
+	if input.HttpsRedirectEnabled {
+		httpsRedirect = cdn.HTTPSRedirectEnabled
+	}
+
+	linkToDefaultDomain := cdn.LinkToDefaultDomainDisabled
+	if input.LinkToDefaultDomainEnabled {
+		linkToDefaultDomain = cdn.LinkToDefaultDomainEnabled
+	}
+
+	props := &cdn.RouteProperties{
+		CustomDomains:       expandFrontDoorResourceReferences(input.CdnFrontDoorCustomDomainIds),
+		EnabledState:        expandFrontDoorEnabledState(input.Enabled),
+		ForwardingProtocol:  cdn.ForwardingProtocol(input.ForwardingProtocol),
+		HTTPSRedirect:       httpsRedirect,
+		LinkToDefaultDomain: linkToDefaultDomain,
+		OriginGroup:         expandFrontDoorResourceReference(input.CdnFrontDoorOriginGroupId),
+		PatternsToMatch:     &input.PatternsToMatch,
+		RuleSets:            expandFrontDoorResourceReferences(input.CdnFrontDoorRuleSetIds),
+		SupportedProtocols:  &supportedProtocols,
+	}
+
+	if input.CdnFrontDoorOriginPath != "" {
+		props.OriginPath = utils.String(input.CdnFrontDoorOriginPath)
+	}
+
+	if len(input.Cache) > 0 {
+		cache := input.Cache[0]
+		props.QueryStringCachingBehavior = cdn.AfdQueryStringCachingBehavior(cache.QueryStringCachingBehavior)
+		props.CompressionSettings = cdn.CompressionSettings{
+			ContentTypesToCompress: &cache.ContentTypesToCompress,
+			IsCompressionEnabled:   utils.Bool(cache.CompressionEnabled),
+		}
+	}
+
+	return cdn.Route{
+		RouteProperties: props,
+	}
+}
+
+func flattenCdnFrontDoorRouteCache(queryStringCachingBehavior cdn.AfdQueryStringCachingBehavior, compressionSettings interface{}) ([]CdnFrontDoorRouteCache, error) {
+	if compressionSettings == nil && queryStringCachingBehavior == "" {
+		return []CdnFrontDoorRouteCache{}, nil
+	}
+
+	output := CdnFrontDoorRouteCache{
+		ContentTypesToCompress:     []string{},
+		QueryStringCachingBehavior: string(queryStringCachingBehavior),
+	}
+
+	if compressionSettings != nil {
+		// the SDK exposes this field as an `interface{}` - so we round-trip it through json to get the typed model
+		raw, err := json.Marshal(compressionSettings)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling `compression_settings`: %+v", err)
+		}
+
+		var settings cdn.CompressionSettings
+		if err := json.Unmarshal(raw, &settings); err != nil {
+			return nil, fmt.Errorf("unmarshaling `compression_settings`: %+v", err)
+		}
+
+		if settings.ContentTypesToCompress != nil {
+			output.ContentTypesToCompress = *settings.ContentTypesToCompress
+		}
+		if settings.IsCompressionEnabled != nil {
+			output.CompressionEnabled = *settings.IsCompressionEnabled
+		}
+	}
+
+	return []CdnFrontDoorRouteCache{output}, nil
+}
@@ -0,0 +1,344 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorRuleResource struct{}
+
+// NOTE: the `conditions` and `actions` blocks are shared with the `delivery_rule` block of the classic CDN Endpoint
+// and are read/written through the ResourceData directly, rather than being part of this model
+type CdnFrontDoorRuleModel struct {
+	Name                  string `tfschema:"name"`
+	CdnFrontDoorRuleSetId string `tfschema:"cdn_frontdoor_rule_set_id"`
+	BehaviorOnMatch       string `tfschema:"behavior_on_match"`
+	Order                 int    `tfschema:"order"`
+}
+
+var _ sdk.ResourceWithUpdate = CdnFrontDoorRuleResource{}
+
+func (r CdnFrontDoorRuleResource) ModelObject() interface{} {
+	return &CdnFrontDoorRuleModel{}
+}
+
+func (r CdnFrontDoorRuleResource) ResourceType() string {
+	return "azurerm_cdn_frontdoor_rule"
+}
+
+func (r CdnFrontDoorRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FrontDoorRuleID
+}
+
+func (r CdnFrontDoorRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FrontDoorRuleName(),
+		},
+
+		"cdn_frontdoor_rule_set_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FrontDoorRuleSetID,
+		},
+
+		"order": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"actions": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: cdnFrontDoorRuleDeliveryRuleSchema("_action"),
+			},
+		},
+
+		"behavior_on_match": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(cdn.MatchProcessingBehaviorContinue),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(cdn.MatchProcessingBehaviorContinue),
+				string(cdn.MatchProcessingBehaviorStop),
+			}, false),
+		},
+
+		"conditions": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: cdnFrontDoorRuleDeliveryRuleSchema("_condition"),
+			},
+		},
+	}
+}
+
+func (r CdnFrontDoorRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CdnFrontDoorRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CdnFrontDoorRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cdn.FrontDoorRulesClient
+
+			ruleSetId, err := parse.FrontDoorRuleSetID(model.CdnFrontDoorRuleSetId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewFrontDoorRuleID(ruleSetId.SubscriptionId, ruleSetId.ResourceGroup, ruleSetId.ProfileName, ruleSetId.RuleSetName, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			conditions, actions, err := expandCdnFrontDoorRuleConditionsAndActions(metadata.ResourceData)
+			if err != nil {
+				return err
+			}
+
+			rule := cdn.Rule{
+				RuleProperties: &cdn.RuleProperties{
+					Actions:                 actions,
+					Conditions:              conditions,
+					MatchProcessingBehavior: cdn.MatchProcessingBehavior(model.BehaviorOnMatch),
+					Order:                   utils.Int32(int32(model.Order)),
+				},
+			}
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName, rule)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorRulesClient
+
+			id, err := parse.FrontDoorRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CdnFrontDoorRuleModel{
+				Name:                  id.RuleName,
+				CdnFrontDoorRuleSetId: parse.NewFrontDoorRuleSetID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.RuleSetName).ID(),
+			}
+
+			if props := resp.RuleProperties; props != nil {
+				state.BehaviorOnMatch = string(props.MatchProcessingBehavior)
+				if props.Order != nil {
+					state.Order = int(*props.Order)
+				}
+
+				conditions, err := flattenCdnFrontDoorRuleConditions(props.Conditions)
+				if err != nil {
+					return fmt.Errorf("flattening `conditions`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("conditions", conditions); err != nil {
+					return fmt.Errorf("setting `conditions`: %+v", err)
+				}
+
+				actions, err := flattenCdnFrontDoorRuleActions(props.Actions)
+				if err != nil {
+					return fmt.Errorf("flattening `actions`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("actions", actions); err != nil {
+					return fmt.Errorf("setting `actions`: %+v", err)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CdnFrontDoorRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorRulesClient
+
+			id, err := parse.FrontDoorRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CdnFrontDoorRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			conditions, actions, err := expandCdnFrontDoorRuleConditionsAndActions(metadata.ResourceData)
+			if err != nil {
+				return err
+			}
+
+			params := cdn.RuleUpdateParameters{
+				RuleUpdatePropertiesParameters: &cdn.RuleUpdatePropertiesParameters{
+					Actions:                 actions,
+					Conditions:              conditions,
+					MatchProcessingBehavior: cdn.MatchProcessingBehavior(model.BehaviorOnMatch),
+					Order:                   utils.Int32(int32(model.Order)),
+				},
+			}
+
+			future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName, params)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorRulesClient
+
+			id, err := parse.FrontDoorRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", *id)
+			future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				if !response.WasNotFound(future.Response()) {
+					return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// cdnFrontDoorRuleDeliveryRuleSchema returns the conditions (or actions) from the classic CDN Endpoint `delivery_rule`
+// block, which share the same API models as a Front Door Rule
+func cdnFrontDoorRuleDeliveryRuleSchema(suffix string) map[string]*pluginsdk.Schema {
+	output := make(map[string]*pluginsdk.Schema)
+	for key, value := range endpointDeliveryRule().Elem.(*pluginsdk.Resource).Schema {
+		if strings.HasSuffix(key, suffix) {
+			output[key] = value
+		}
+	}
+	return output
+}
+
+func expandCdnFrontDoorRuleConditionsAndActions(d *pluginsdk.ResourceData) (*[]cdn.BasicDeliveryRuleCondition, *[]cdn.BasicDeliveryRuleAction, error) {
+	conditions := make([]cdn.BasicDeliveryRuleCondition, 0)
+	if raw := d.Get("conditions").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		conditions = *expandDeliveryRuleConditions(raw[0].(map[string]interface{}))
+	}
+
+	actions := make([]cdn.BasicDeliveryRuleAction, 0)
+	if raw := d.Get("actions").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		expanded, err := expandDeliveryRuleActions(raw[0].(map[string]interface{}))
+		if err != nil {
+			return nil, nil, fmt.Errorf("expanding `actions`: %+v", err)
+		}
+		actions = expanded
+	}
+
+	if len(actions) == 0 {
+		return nil, nil, fmt.Errorf("at least one action must be specified within the `actions` block")
+	}
+
+	return &conditions, &actions, nil
+}
+
+func flattenCdnFrontDoorRuleConditions(input *[]cdn.BasicDeliveryRuleCondition) ([]interface{}, error) {
+	if input == nil || len(*input) == 0 {
+		return []interface{}{}, nil
+	}
+
+	conditions, err := flattenDeliveryRuleConditions(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]interface{})
+	for key, value := range *conditions {
+		output[key] = value
+	}
+
+	return []interface{}{output}, nil
+}
+
+func flattenCdnFrontDoorRuleActions(input *[]cdn.BasicDeliveryRuleAction) ([]interface{}, error) {
+	if input == nil || len(*input) == 0 {
+		return []interface{}{}, nil
+	}
+
+	actions, err := flattenDeliveryRuleActions(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]interface{})
+	for key, value := range *actions {
+		output[key] = value
+	}
+
+	return []interface{}{output}, nil
+}
@@ -0,0 +1,282 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	frontDoorValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorSecurityPolicyResource struct{}
+
+type CdnFrontDoorSecurityPolicyModel struct {
+	Name                         string                                  `tfschema:"name"`
+	CdnFrontDoorProfileId        string                                  `tfschema:"cdn_frontdoor_profile_id"`
+	CdnFrontDoorFirewallPolicyId string                                  `tfschema:"cdn_frontdoor_firewall_policy_id"`
+	Association                  []CdnFrontDoorSecurityPolicyAssociation `tfschema:"association"`
+}
+
+type CdnFrontDoorSecurityPolicyAssociation struct {
+	DomainIds       []string `tfschema:"domain_ids"`
+	PatternsToMatch []string `tfschema:"patterns_to_match"`
+}
+
+var _ sdk.ResourceWithUpdate = CdnFrontDoorSecurityPolicyResource{}
+
+func (r CdnFrontDoorSecurityPolicyResource) ModelObject() interface{} {
+	return &CdnFrontDoorSecurityPolicyModel{}
+}
+
+func (r CdnFrontDoorSecurityPolicyResource) ResourceType() string {
+	return "azurerm_cdn_frontdoor_security_policy"
+}
+
+func (r CdnFrontDoorSecurityPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FrontDoorSecurityPolicyID
+}
+
+func (r CdnFrontDoorSecurityPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FrontDoorName(),
+		},
+
+		"cdn_frontdoor_profile_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ProfileID,
+		},
+
+		"cdn_frontdoor_firewall_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: frontDoorValidate.WebApplicationFirewallPolicyID,
+		},
+
+		"association": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					// either a Front Door Endpoint or Custom Domain ID
+					"domain_ids": {
+						Type:     pluginsdk.TypeSet,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+
+					"patterns_to_match": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CdnFrontDoorSecurityPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CdnFrontDoorSecurityPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CdnFrontDoorSecurityPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cdn.FrontDoorSecurityPoliciesClient
+
+			profileId, err := parse.ProfileID(model.CdnFrontDoorProfileId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewFrontDoorSecurityPolicyID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			policy := cdn.SecurityPolicy{
+				SecurityPolicyProperties: expandCdnFrontDoorSecurityPolicy(model),
+			}
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName, policy)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorSecurityPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorSecurityPoliciesClient
+
+			id, err := parse.FrontDoorSecurityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CdnFrontDoorSecurityPolicyModel{
+				Name:                  id.SecurityPolicyName,
+				CdnFrontDoorProfileId: parse.NewProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID(),
+			}
+
+			if props := resp.SecurityPolicyProperties; props != nil && props.Parameters != nil {
+				params, ok := props.Parameters.AsSecurityPolicyWebApplicationFirewallParameters()
+				if !ok {
+					return fmt.Errorf("retrieving %s: expected a Web Application Firewall security policy", *id)
+				}
+
+				state.CdnFrontDoorFirewallPolicyId = flattenFrontDoorResourceReference(params.WafPolicy)
+				state.Association = flattenCdnFrontDoorSecurityPolicyAssociations(params.Associations)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CdnFrontDoorSecurityPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorSecurityPoliciesClient
+
+			id, err := parse.FrontDoorSecurityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CdnFrontDoorSecurityPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			future, err := client.Patch(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName, *expandCdnFrontDoorSecurityPolicy(model))
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CdnFrontDoorSecurityPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn.FrontDoorSecurityPoliciesClient
+
+			id, err := parse.FrontDoorSecurityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", *id)
+			future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				if !response.WasNotFound(future.Response()) {
+					return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCdnFrontDoorSecurityPolicy(input CdnFrontDoorSecurityPolicyModel) *cdn.SecurityPolicyProperties {
+	associations := make([]cdn.SecurityPolicyWebApplicationFirewallAssociation, 0)
+	for _, v := range input.Association {
+		patternsToMatch := v.PatternsToMatch
+		associations = append(associations, cdn.SecurityPolicyWebApplicationFirewallAssociation{
+			Domains:         expandFrontDoorResourceReferences(v.DomainIds),
+			PatternsToMatch: &patternsToMatch,
+		})
+	}
+
+	return &cdn.SecurityPolicyProperties{
+		Parameters: cdn.SecurityPolicyWebApplicationFirewallParameters{
+			Associations: &associations,
+			Type:         cdn.TypeWebApplicationFirewall,
+			WafPolicy:    expandFrontDoorResourceReference(input.CdnFrontDoorFirewallPolicyId),
+		},
+	}
+}
+
+func flattenCdnFrontDoorSecurityPolicyAssociations(input *[]cdn.SecurityPolicyWebApplicationFirewallAssociation) []CdnFrontDoorSecurityPolicyAssociation {
+	output := make([]CdnFrontDoorSecurityPolicyAssociation, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		association := CdnFrontDoorSecurityPolicyAssociation{
+			DomainIds:       flattenFrontDoorResourceReferences(v.Domains),
+			PatternsToMatch: []string{},
+		}
+		if v.PatternsToMatch != nil {
+			association.PatternsToMatch = *v.PatternsToMatch
+		}
+		output = append(output, association)
+	}
+
+	return output
+}
@@ -0,0 +1,214 @@
+package cdn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorSecurityPolicyResource struct{}
+
+func TestAccCdnFrontDoorSecurityPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_security_policy", "test")
+	r := CdnFrontDoorSecurityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCdnFrontDoorSecurityPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_security_policy", "test")
+	r := CdnFrontDoorSecurityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCdnFrontDoorSecurityPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_security_policy", "test")
+	r := CdnFrontDoorSecurityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CdnFrontDoorSecurityPolicyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FrontDoorSecurityPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Cdn.FrontDoorSecurityPoliciesClient.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return utils.Bool(true), nil
+}
+
+// the Front Door Firewall Policy for a Standard/Premium profile requires a `sku` which isn't supported by the
+// `azurerm_frontdoor_firewall_policy` resource at this time - so it's provisioned via an ARM Template
+func (r CdnFrontDoorSecurityPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cdn-afdx-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cdn_frontdoor_profile" "test" {
+  name                = "acctestprofile-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Standard_AzureFrontDoor"
+}
+
+resource "azurerm_cdn_frontdoor_endpoint" "test" {
+  name                     = "acctest-endpoint-%[1]d"
+  cdn_frontdoor_profile_id = azurerm_cdn_frontdoor_profile.test.id
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_cdn_frontdoor_custom_domain" "test" {
+  name                     = "acctest-customdomain-%[1]d"
+  cdn_frontdoor_profile_id = azurerm_cdn_frontdoor_profile.test.id
+  dns_zone_id              = azurerm_dns_zone.test.id
+  host_name                = join(".", ["fabrikam", azurerm_dns_zone.test.name])
+
+  tls {}
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-waf-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Network/frontDoorWebApplicationFirewallPolicies",
+      "apiVersion": "2020-11-01",
+      "name": "acctestwaf%[1]d",
+      "location": "Global",
+      "sku": {
+        "name": "Standard_AzureFrontDoor"
+      },
+      "properties": {
+        "policySettings": {
+          "enabledState": "Enabled",
+          "mode": "Prevention"
+        }
+      }
+    }
+  ],
+  "outputs": {
+    "policyId": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.Network/frontDoorWebApplicationFirewallPolicies', 'acctestwaf%[1]d')]"
+    }
+  }
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r CdnFrontDoorSecurityPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_security_policy" "test" {
+  name                             = "acctest-securitypolicy-%d"
+  cdn_frontdoor_profile_id         = azurerm_cdn_frontdoor_profile.test.id
+  cdn_frontdoor_firewall_policy_id = jsondecode(azurerm_resource_group_template_deployment.test.output_content).policyId.value
+
+  association {
+    domain_ids        = [azurerm_cdn_frontdoor_endpoint.test.id]
+    patterns_to_match = ["/*"]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CdnFrontDoorSecurityPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_security_policy" "import" {
+  name                             = azurerm_cdn_frontdoor_security_policy.test.name
+  cdn_frontdoor_profile_id         = azurerm_cdn_frontdoor_security_policy.test.cdn_frontdoor_profile_id
+  cdn_frontdoor_firewall_policy_id = azurerm_cdn_frontdoor_security_policy.test.cdn_frontdoor_firewall_policy_id
+
+  association {
+    domain_ids        = [azurerm_cdn_frontdoor_endpoint.test.id]
+    patterns_to_match = ["/*"]
+  }
+}
+`, r.basic(data))
+}
+
+func (r CdnFrontDoorSecurityPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_security_policy" "test" {
+  name                             = "acctest-securitypolicy-%d"
+  cdn_frontdoor_profile_id         = azurerm_cdn_frontdoor_profile.test.id
+  cdn_frontdoor_firewall_policy_id = jsondecode(azurerm_resource_group_template_deployment.test.output_content).policyId.value
+
+  association {
+    domain_ids = [
+      azurerm_cdn_frontdoor_endpoint.test.id,
+      azurerm_cdn_frontdoor_custom_domain.test.id,
+    ]
+    patterns_to_match = ["/*"]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -9,6 +9,15 @@ type Client struct {
 	CustomDomainsClient *cdn.CustomDomainsClient
 	EndpointsClient     *cdn.EndpointsClient
 	ProfilesClient      *cdn.ProfilesClient
+
+	FrontDoorCustomDomainsClient    *cdn.AFDCustomDomainsClient
+	FrontDoorEndpointsClient        *cdn.AFDEndpointsClient
+	FrontDoorOriginGroupsClient     *cdn.AFDOriginGroupsClient
+	FrontDoorOriginsClient          *cdn.AFDOriginsClient
+	FrontDoorRoutesClient           *cdn.RoutesClient
+	FrontDoorRulesClient            *cdn.RulesClient
+	FrontDoorRuleSetsClient         *cdn.RuleSetsClient
+	FrontDoorSecurityPoliciesClient *cdn.SecurityPoliciesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -21,9 +30,42 @@ func NewClient(o *common.ClientOptions) *Client {
 	profilesClient := cdn.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&profilesClient.Client, o.ResourceManagerAuthorizer)
 
+	frontDoorCustomDomainsClient := cdn.NewAFDCustomDomainsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorCustomDomainsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorEndpointsClient := cdn.NewAFDEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorOriginGroupsClient := cdn.NewAFDOriginGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorOriginGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorOriginsClient := cdn.NewAFDOriginsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorOriginsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorRoutesClient := cdn.NewRoutesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorRoutesClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorRulesClient := cdn.NewRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorRuleSetsClient := cdn.NewRuleSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorRuleSetsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorSecurityPoliciesClient := cdn.NewSecurityPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorSecurityPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		CustomDomainsClient: &customDomainsClient,
 		EndpointsClient:     &endpointsClient,
 		ProfilesClient:      &profilesClient,
+
+		FrontDoorCustomDomainsClient:    &frontDoorCustomDomainsClient,
+		FrontDoorEndpointsClient:        &frontDoorEndpointsClient,
+		FrontDoorOriginGroupsClient:     &frontDoorOriginGroupsClient,
+		FrontDoorOriginsClient:          &frontDoorOriginsClient,
+		FrontDoorRoutesClient:           &frontDoorRoutesClient,
+		FrontDoorRulesClient:            &frontDoorRulesClient,
+		FrontDoorRuleSetsClient:         &frontDoorRuleSetsClient,
+		FrontDoorSecurityPoliciesClient: &frontDoorSecurityPoliciesClient,
 	}
 }
@@ -1,9 +1,13 @@
 package cdn
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+var _ sdk.TypedServiceRegistration = Registration{}
+var _ sdk.UntypedServiceRegistration = Registration{}
+
 type Registration struct{}
 
 // Name is the name of this Service
@@ -33,3 +37,23 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_cdn_profile":                resourceCdnProfile(),
 	}
 }
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		CdnFrontDoorCustomDomainResource{},
+		CdnFrontDoorEndpointResource{},
+		CdnFrontDoorOriginGroupResource{},
+		CdnFrontDoorOriginResource{},
+		CdnFrontDoorProfileResource{},
+		CdnFrontDoorRouteResource{},
+		CdnFrontDoorRuleResource{},
+		CdnFrontDoorRuleSetResource{},
+		CdnFrontDoorSecurityPolicyResource{},
+	}
+}
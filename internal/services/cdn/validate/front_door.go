@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func FrontDoorName() pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[\da-zA-Z]([-\da-zA-Z]{0,88}[\da-zA-Z])?$`),
+		"The name must be between 1 and 90 characters in length, begin and end with a letter or number and may contain only letters, numbers and hyphens.",
+	)
+}
+
+func FrontDoorRuleName() pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z][\da-zA-Z]{0,59}$`),
+		"The name must be between 1 and 60 characters in length, begin with a letter and may contain only letters and numbers.",
+	)
+}
@@ -0,0 +1,116 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFrontDoorName(t *testing.T) {
+	cases := []struct {
+		Name        string
+		ShouldError bool
+	}{
+		{
+			Name:        "",
+			ShouldError: true,
+		},
+		{
+			Name:        "a",
+			ShouldError: false,
+		},
+		{
+			Name:        "1",
+			ShouldError: false,
+		},
+		{
+			Name:        "endpoint-1",
+			ShouldError: false,
+		},
+		{
+			Name:        "-endpoint",
+			ShouldError: true,
+		},
+		{
+			Name:        "endpoint-",
+			ShouldError: true,
+		},
+		{
+			Name:        "end_point",
+			ShouldError: true,
+		},
+		{
+			Name:        strings.Repeat("a", 90),
+			ShouldError: false,
+		},
+		{
+			Name:        strings.Repeat("a", 91),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := FrontDoorName()(tc.Name, "name")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Name)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Name, len(errors))
+			}
+		})
+	}
+}
+
+func TestFrontDoorRuleName(t *testing.T) {
+	cases := []struct {
+		Name        string
+		ShouldError bool
+	}{
+		{
+			Name:        "",
+			ShouldError: true,
+		},
+		{
+			Name:        "a",
+			ShouldError: false,
+		},
+		{
+			Name:        "ruleSet1",
+			ShouldError: false,
+		},
+		{
+			Name:        "1ruleSet",
+			ShouldError: true,
+		},
+		{
+			Name:        "rule-set",
+			ShouldError: true,
+		},
+		{
+			Name:        strings.Repeat("a", 60),
+			ShouldError: false,
+		},
+		{
+			Name:        strings.Repeat("a", 61),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := FrontDoorRuleName()(tc.Name, "name")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Name)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Name, len(errors))
+			}
+		})
+	}
+}
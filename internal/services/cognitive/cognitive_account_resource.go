@@ -20,7 +20,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/validate"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -354,19 +353,20 @@ func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 	networkAcls, subnetIds := expandCognitiveAccountNetworkAcls(d)
 
 	// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
-	virtualNetworkNames := make([]string, 0)
+	virtualNetworkIDs := make([]string, 0)
 	for _, v := range subnetIds {
 		id, err := networkParse.SubnetIDInsensitively(v)
 		if err != nil {
 			return err
 		}
-		if !utils.SliceContainsValue(virtualNetworkNames, id.VirtualNetworkName) {
-			virtualNetworkNames = append(virtualNetworkNames, id.VirtualNetworkName)
+		virtualNetworkId := networkParse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName).ID()
+		if !utils.SliceContainsValue(virtualNetworkIDs, virtualNetworkId) {
+			virtualNetworkIDs = append(virtualNetworkIDs, virtualNetworkId)
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	locks.MultipleByID(&virtualNetworkIDs)
+	defer locks.UnlockMultipleByID(&virtualNetworkIDs)
 	publicNetworkAccess := cognitiveservicesaccounts.PublicNetworkAccessEnabled
 	if !d.Get("public_network_access_enabled").(bool) {
 		publicNetworkAccess = cognitiveservicesaccounts.PublicNetworkAccessDisabled
@@ -439,19 +439,20 @@ func resourceCognitiveAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 	networkAcls, subnetIds := expandCognitiveAccountNetworkAcls(d)
 
 	// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
-	virtualNetworkNames := make([]string, 0)
+	virtualNetworkIDs := make([]string, 0)
 	for _, v := range subnetIds {
 		id, err := networkParse.SubnetIDInsensitively(v)
 		if err != nil {
 			return err
 		}
-		if !utils.SliceContainsValue(virtualNetworkNames, id.VirtualNetworkName) {
-			virtualNetworkNames = append(virtualNetworkNames, id.VirtualNetworkName)
+		virtualNetworkId := networkParse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName).ID()
+		if !utils.SliceContainsValue(virtualNetworkIDs, virtualNetworkId) {
+			virtualNetworkIDs = append(virtualNetworkIDs, virtualNetworkId)
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	locks.MultipleByID(&virtualNetworkIDs)
+	defer locks.UnlockMultipleByID(&virtualNetworkIDs)
 
 	publicNetworkAccess := cognitiveservicesaccounts.PublicNetworkAccessEnabled
 	if !d.Get("public_network_access_enabled").(bool) {
@@ -0,0 +1,281 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceGalleryApplication() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceGalleryApplicationCreate,
+		Read:   resourceGalleryApplicationRead,
+		Update: resourceGalleryApplicationUpdate,
+		Delete: resourceGalleryApplicationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.GalleryApplicationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GalleryApplicationName,
+			},
+
+			"gallery_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SharedImageGalleryID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"supported_os_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.OperatingSystemTypesLinux),
+					string(compute.OperatingSystemTypesWindows),
+				}, false),
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"end_of_life_date": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"eula": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"privacy_statement_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"release_note_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceGalleryApplicationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	galleryId, err := parse.SharedImageGalleryID(d.Get("gallery_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewGalleryApplicationID(galleryId.SubscriptionId, galleryId.ResourceGroup, galleryId.GalleryName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_gallery_application", id.ID())
+	}
+
+	properties, err := expandGalleryApplicationProperties(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := compute.GalleryApplication{
+		Location:                     utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		GalleryApplicationProperties: properties,
+		Tags:                         tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceGalleryApplicationRead(d, meta)
+}
+
+func resourceGalleryApplicationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GalleryApplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ApplicationName)
+	d.Set("gallery_id", parse.NewSharedImageGalleryID(id.SubscriptionId, id.ResourceGroup, id.GalleryName).ID())
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.GalleryApplicationProperties; props != nil {
+		d.Set("supported_os_type", string(props.SupportedOSType))
+		d.Set("description", props.Description)
+		d.Set("eula", props.Eula)
+		d.Set("privacy_statement_uri", props.PrivacyStatementURI)
+		d.Set("release_note_uri", props.ReleaseNoteURI)
+
+		endOfLifeDate := ""
+		if props.EndOfLifeDate != nil {
+			endOfLifeDate = props.EndOfLifeDate.Format(time.RFC3339)
+		}
+		d.Set("end_of_life_date", endOfLifeDate)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceGalleryApplicationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GalleryApplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	properties, err := expandGalleryApplicationProperties(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := compute.GalleryApplicationUpdate{
+		GalleryApplicationProperties: properties,
+		Tags:                         tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, parameters)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+	}
+
+	return resourceGalleryApplicationRead(d, meta)
+}
+
+func resourceGalleryApplicationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GalleryApplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandGalleryApplicationProperties(d *pluginsdk.ResourceData) (*compute.GalleryApplicationProperties, error) {
+	properties := &compute.GalleryApplicationProperties{
+		SupportedOSType: compute.OperatingSystemTypes(d.Get("supported_os_type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		properties.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("end_of_life_date"); ok {
+		endOfLifeDate, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing `end_of_life_date` %q: %+v", v.(string), err)
+		}
+		properties.EndOfLifeDate = &date.Time{
+			Time: endOfLifeDate,
+		}
+	}
+
+	if v, ok := d.GetOk("eula"); ok {
+		properties.Eula = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("privacy_statement_uri"); ok {
+		properties.PrivacyStatementURI = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("release_note_uri"); ok {
+		properties.ReleaseNoteURI = utils.String(v.(string))
+	}
+
+	return properties, nil
+}
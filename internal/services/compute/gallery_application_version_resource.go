@@ -0,0 +1,477 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceGalleryApplicationVersion() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceGalleryApplicationVersionCreate,
+		Read:   resourceGalleryApplicationVersionRead,
+		Update: resourceGalleryApplicationVersionUpdate,
+		Delete: resourceGalleryApplicationVersionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.GalleryApplicationVersionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GalleryApplicationVersionName,
+			},
+
+			"gallery_application_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GalleryApplicationID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"source": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"media_link": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+
+						"default_configuration_link": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
+
+			"manage_action": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"install": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+
+						"remove": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+
+						"update": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+					},
+				},
+			},
+
+			"target_region": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							StateFunc:        location.StateFunc,
+							DiffSuppressFunc: location.DiffSuppressFunc,
+						},
+
+						"regional_replica_count": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+
+						// the storage account type of an existing region can't be changed, this is surfaced by the API
+						"storage_account_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(compute.StorageAccountTypeStandardLRS),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.StorageAccountTypePremiumLRS),
+								string(compute.StorageAccountTypeStandardLRS),
+								string(compute.StorageAccountTypeStandardZRS),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"enable_health_check": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"end_of_life_date": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"exclude_from_latest": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceGalleryApplicationVersionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationVersionsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applicationId, err := parse.GalleryApplicationID(d.Get("gallery_application_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewGalleryApplicationVersionID(applicationId.SubscriptionId, applicationId.ResourceGroup, applicationId.GalleryName, applicationId.ApplicationName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_gallery_application_version", id.ID())
+	}
+
+	publishingProfile, err := expandGalleryApplicationVersionPublishingProfile(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := compute.GalleryApplicationVersion{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		GalleryApplicationVersionProperties: &compute.GalleryApplicationVersionProperties{
+			PublishingProfile: publishingProfile,
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceGalleryApplicationVersionRead(d, meta)
+}
+
+func resourceGalleryApplicationVersionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationVersionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GalleryApplicationVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.VersionName)
+	d.Set("gallery_application_id", parse.NewGalleryApplicationID(id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.ApplicationName).ID())
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.GalleryApplicationVersionProperties; props != nil {
+		if profile := props.PublishingProfile; profile != nil {
+			d.Set("enable_health_check", profile.EnableHealthCheck)
+			d.Set("exclude_from_latest", profile.ExcludeFromLatest)
+
+			endOfLifeDate := ""
+			if profile.EndOfLifeDate != nil {
+				endOfLifeDate = profile.EndOfLifeDate.Format(time.RFC3339)
+			}
+			d.Set("end_of_life_date", endOfLifeDate)
+
+			if err := d.Set("source", flattenGalleryApplicationVersionSource(profile.Source)); err != nil {
+				return fmt.Errorf("setting `source`: %+v", err)
+			}
+
+			if err := d.Set("manage_action", flattenGalleryApplicationVersionManageAction(profile.ManageActions)); err != nil {
+				return fmt.Errorf("setting `manage_action`: %+v", err)
+			}
+
+			if err := d.Set("target_region", flattenGalleryApplicationVersionTargetRegions(profile.TargetRegions)); err != nil {
+				return fmt.Errorf("setting `target_region`: %+v", err)
+			}
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceGalleryApplicationVersionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationVersionsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GalleryApplicationVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	publishingProfile, err := expandGalleryApplicationVersionPublishingProfile(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := compute.GalleryApplicationVersionUpdate{
+		GalleryApplicationVersionProperties: &compute.GalleryApplicationVersionProperties{
+			PublishingProfile: publishingProfile,
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, parameters)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+	}
+
+	return resourceGalleryApplicationVersionRead(d, meta)
+}
+
+func resourceGalleryApplicationVersionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationVersionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GalleryApplicationVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandGalleryApplicationVersionPublishingProfile(d *pluginsdk.ResourceData) (*compute.GalleryApplicationVersionPublishingProfile, error) {
+	profile := &compute.GalleryApplicationVersionPublishingProfile{
+		Source:            expandGalleryApplicationVersionSource(d.Get("source").([]interface{})),
+		ManageActions:     expandGalleryApplicationVersionManageAction(d.Get("manage_action").([]interface{})),
+		TargetRegions:     expandGalleryApplicationVersionTargetRegions(d.Get("target_region").([]interface{})),
+		EnableHealthCheck: utils.Bool(d.Get("enable_health_check").(bool)),
+		ExcludeFromLatest: utils.Bool(d.Get("exclude_from_latest").(bool)),
+	}
+
+	if v, ok := d.GetOk("end_of_life_date"); ok {
+		endOfLifeDate, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing `end_of_life_date` %q: %+v", v.(string), err)
+		}
+		profile.EndOfLifeDate = &date.Time{
+			Time: endOfLifeDate,
+		}
+	}
+
+	return profile, nil
+}
+
+func expandGalleryApplicationVersionSource(input []interface{}) *compute.UserArtifactSource {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	source := &compute.UserArtifactSource{
+		MediaLink: utils.String(raw["media_link"].(string)),
+	}
+
+	if v := raw["default_configuration_link"].(string); v != "" {
+		source.DefaultConfigurationLink = utils.String(v)
+	}
+
+	return source
+}
+
+func flattenGalleryApplicationVersionSource(input *compute.UserArtifactSource) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	mediaLink := ""
+	if input.MediaLink != nil {
+		mediaLink = *input.MediaLink
+	}
+
+	defaultConfigurationLink := ""
+	if input.DefaultConfigurationLink != nil {
+		defaultConfigurationLink = *input.DefaultConfigurationLink
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"media_link":                 mediaLink,
+			"default_configuration_link": defaultConfigurationLink,
+		},
+	}
+}
+
+func expandGalleryApplicationVersionManageAction(input []interface{}) *compute.UserArtifactManage {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	manageAction := &compute.UserArtifactManage{
+		Install: utils.String(raw["install"].(string)),
+		Remove:  utils.String(raw["remove"].(string)),
+	}
+
+	if v := raw["update"].(string); v != "" {
+		manageAction.Update = utils.String(v)
+	}
+
+	return manageAction
+}
+
+func flattenGalleryApplicationVersionManageAction(input *compute.UserArtifactManage) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	install := ""
+	if input.Install != nil {
+		install = *input.Install
+	}
+
+	remove := ""
+	if input.Remove != nil {
+		remove = *input.Remove
+	}
+
+	update := ""
+	if input.Update != nil {
+		update = *input.Update
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"install": install,
+			"remove":  remove,
+			"update":  update,
+		},
+	}
+}
+
+func expandGalleryApplicationVersionTargetRegions(input []interface{}) *[]compute.TargetRegion {
+	results := make([]compute.TargetRegion, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		results = append(results, compute.TargetRegion{
+			Name:                 utils.String(azure.NormalizeLocation(raw["name"].(string))),
+			RegionalReplicaCount: utils.Int32(int32(raw["regional_replica_count"].(int))),
+			StorageAccountType:   compute.StorageAccountType(raw["storage_account_type"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenGalleryApplicationVersionTargetRegions(input *[]compute.TargetRegion) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		name := ""
+		if v.Name != nil {
+			name = azure.NormalizeLocation(*v.Name)
+		}
+
+		regionalReplicaCount := 0
+		if v.RegionalReplicaCount != nil {
+			regionalReplicaCount = int(*v.RegionalReplicaCount)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                   name,
+			"regional_replica_count": regionalReplicaCount,
+			"storage_account_type":   string(v.StorageAccountType),
+		})
+	}
+
+	return results
+}
@@ -21,24 +21,25 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_availability_set":          dataSourceAvailabilitySet(),
-		"azurerm_dedicated_host":            dataSourceDedicatedHost(),
-		"azurerm_dedicated_host_group":      dataSourceDedicatedHostGroup(),
-		"azurerm_disk_encryption_set":       dataSourceDiskEncryptionSet(),
-		"azurerm_managed_disk":              dataSourceManagedDisk(),
-		"azurerm_image":                     dataSourceImage(),
-		"azurerm_images":                    dataSourceImages(),
-		"azurerm_disk_access":               dataSourceDiskAccess(),
-		"azurerm_platform_image":            dataSourcePlatformImage(),
-		"azurerm_proximity_placement_group": dataSourceProximityPlacementGroup(),
-		"azurerm_shared_image_gallery":      dataSourceSharedImageGallery(),
-		"azurerm_shared_image_version":      dataSourceSharedImageVersion(),
-		"azurerm_shared_image_versions":     dataSourceSharedImageVersions(),
-		"azurerm_shared_image":              dataSourceSharedImage(),
-		"azurerm_snapshot":                  dataSourceSnapshot(),
-		"azurerm_virtual_machine":           dataSourceVirtualMachine(),
-		"azurerm_virtual_machine_scale_set": dataSourceVirtualMachineScaleSet(),
-		"azurerm_ssh_public_key":            dataSourceSshPublicKey(),
+		"azurerm_availability_set":            dataSourceAvailabilitySet(),
+		"azurerm_dedicated_host":              dataSourceDedicatedHost(),
+		"azurerm_dedicated_host_group":        dataSourceDedicatedHostGroup(),
+		"azurerm_disk_encryption_set":         dataSourceDiskEncryptionSet(),
+		"azurerm_managed_disk":                dataSourceManagedDisk(),
+		"azurerm_image":                       dataSourceImage(),
+		"azurerm_images":                      dataSourceImages(),
+		"azurerm_disk_access":                 dataSourceDiskAccess(),
+		"azurerm_platform_image":              dataSourcePlatformImage(),
+		"azurerm_proximity_placement_group":   dataSourceProximityPlacementGroup(),
+		"azurerm_shared_image_gallery":        dataSourceSharedImageGallery(),
+		"azurerm_shared_image_version":        dataSourceSharedImageVersion(),
+		"azurerm_shared_image_versions":       dataSourceSharedImageVersions(),
+		"azurerm_shared_image":                dataSourceSharedImage(),
+		"azurerm_snapshot":                    dataSourceSnapshot(),
+		"azurerm_virtual_machine":             dataSourceVirtualMachine(),
+		"azurerm_virtual_machine_run_command": dataSourceVirtualMachineRunCommand(),
+		"azurerm_virtual_machine_scale_set":   dataSourceVirtualMachineScaleSet(),
+		"azurerm_ssh_public_key":              dataSourceSshPublicKey(),
 	}
 }
 
@@ -46,9 +47,13 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	resources := map[string]*pluginsdk.Resource{
 		"azurerm_availability_set":                       resourceAvailabilitySet(),
+		"azurerm_capacity_reservation":                   resourceCapacityReservation(),
+		"azurerm_capacity_reservation_group":             resourceCapacityReservationGroup(),
 		"azurerm_dedicated_host":                         resourceDedicatedHost(),
 		"azurerm_dedicated_host_group":                   resourceDedicatedHostGroup(),
 		"azurerm_disk_encryption_set":                    resourceDiskEncryptionSet(),
+		"azurerm_gallery_application":                    resourceGalleryApplication(),
+		"azurerm_gallery_application_version":            resourceGalleryApplicationVersion(),
 		"azurerm_image":                                  resourceImage(),
 		"azurerm_managed_disk":                           resourceManagedDisk(),
 		"azurerm_disk_access":                            resourceDiskAccess(),
@@ -60,12 +65,14 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_snapshot":                               resourceSnapshot(),
 		"azurerm_virtual_machine_data_disk_attachment":   resourceVirtualMachineDataDiskAttachment(),
 		"azurerm_virtual_machine_extension":              resourceVirtualMachineExtension(),
+		"azurerm_virtual_machine_run_command":            resourceVirtualMachineRunCommand(),
 		"azurerm_virtual_machine_scale_set":              resourceVirtualMachineScaleSet(),
 		"azurerm_orchestrated_virtual_machine_scale_set": resourceOrchestratedVirtualMachineScaleSet(),
 		"azurerm_virtual_machine":                        resourceVirtualMachine(),
 		"azurerm_linux_virtual_machine":                  resourceLinuxVirtualMachine(),
 		"azurerm_linux_virtual_machine_scale_set":        resourceLinuxVirtualMachineScaleSet(),
 		"azurerm_virtual_machine_scale_set_extension":    resourceVirtualMachineScaleSetExtension(),
+		"azurerm_virtual_machine_scale_set_run_command":  resourceVirtualMachineScaleSetRunCommand(),
 		"azurerm_windows_virtual_machine":                resourceWindowsVirtualMachine(),
 		"azurerm_windows_virtual_machine_scale_set":      resourceWindowsVirtualMachineScaleSet(),
 		"azurerm_ssh_public_key":                         resourceSshPublicKey(),
@@ -0,0 +1,34 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func GalleryApplicationName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// The name can contain alphanumerics, underscores, hyphens and periods, must start and end with an alphanumeric and can be up to 80 characters long.
+	if matched := regexp.MustCompile(`^[A-Za-z0-9]$|^[A-Za-z0-9][\w.-]{0,78}[A-Za-z0-9]$`).MatchString(v); !matched {
+		errors = append(errors, fmt.Errorf("%s must be between 1 - 80 characters long, begin and end with an alphanumeric character and can only contain alphanumerics, underscores, hyphens and periods", k))
+	}
+	return
+}
+
+func GalleryApplicationVersionName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// the version name is made up of three integer segments: `Major.Minor.Patch`
+	if matched := regexp.MustCompile(`^[0-9]{1,10}\.[0-9]{1,10}\.[0-9]{1,10}$`).MatchString(v); !matched {
+		errors = append(errors, fmt.Errorf("%s must be in the format `Major.Minor.Patch`, such as `1.2.3`. Got %q", k, v))
+	}
+	return
+}
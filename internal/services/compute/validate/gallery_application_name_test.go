@@ -0,0 +1,124 @@
+package validate
+
+import "testing"
+
+func TestGalleryApplicationName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// single character
+			input:    "a",
+			expected: true,
+		},
+		{
+			// basic example
+			input:    "hello",
+			expected: true,
+		},
+		{
+			// can't start with an underscore
+			input:    "_hello",
+			expected: false,
+		},
+		{
+			// can't end with a period
+			input:    "hello.",
+			expected: false,
+		},
+		{
+			// can't end with a hyphen
+			input:    "hello-",
+			expected: false,
+		},
+		{
+			// periods, hyphens and underscores in the middle
+			input:    "hello.wor-ld_1",
+			expected: true,
+		},
+		{
+			// can't contain a space
+			input:    "hello world",
+			expected: false,
+		},
+		{
+			// 80 characters
+			input:    "abcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcde",
+			expected: true,
+		},
+		{
+			// 81 characters
+			input:    "abcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdef",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q...", v.input)
+
+		_, errors := GalleryApplicationName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestGalleryApplicationVersionName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "1.2.3",
+			expected: true,
+		},
+		{
+			// multiple digits
+			input:    "10.20.300",
+			expected: true,
+		},
+		{
+			// missing a segment
+			input:    "1.2",
+			expected: false,
+		},
+		{
+			// too many segments
+			input:    "1.2.3.4",
+			expected: false,
+		},
+		{
+			// not a number
+			input:    "1.2.a",
+			expected: false,
+		},
+		{
+			// unlike image versions, `latest` isn't supported
+			input:    "latest",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q...", v.input)
+
+		_, errors := GalleryApplicationVersionName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -68,6 +68,108 @@ func flattenVirtualMachineAdditionalCapabilities(input *compute.AdditionalCapabi
 	}
 }
 
+func virtualMachineGalleryApplicationSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 100,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"version_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.GalleryApplicationVersionID,
+				},
+
+				"configuration_blob_uri": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				},
+
+				// the Gallery Applications are installed in ascending `order`
+				"order": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 2147483647),
+				},
+
+				"tag": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineGalleryApplication(input []interface{}) *compute.ApplicationProfile {
+	galleryApplications := make([]compute.VMGalleryApplication, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		galleryApplication := compute.VMGalleryApplication{
+			PackageReferenceID: utils.String(raw["version_id"].(string)),
+			Order:              utils.Int32(int32(raw["order"].(int))),
+		}
+
+		if configurationBlobUri := raw["configuration_blob_uri"].(string); configurationBlobUri != "" {
+			galleryApplication.ConfigurationReference = utils.String(configurationBlobUri)
+		}
+
+		if tag := raw["tag"].(string); tag != "" {
+			galleryApplication.Tags = utils.String(tag)
+		}
+
+		galleryApplications = append(galleryApplications, galleryApplication)
+	}
+
+	return &compute.ApplicationProfile{
+		GalleryApplications: &galleryApplications,
+	}
+}
+
+func flattenVirtualMachineGalleryApplication(input *compute.ApplicationProfile) []interface{} {
+	if input == nil || input.GalleryApplications == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0)
+	for _, v := range *input.GalleryApplications {
+		versionId := ""
+		if v.PackageReferenceID != nil {
+			versionId = *v.PackageReferenceID
+		}
+
+		configurationBlobUri := ""
+		if v.ConfigurationReference != nil {
+			configurationBlobUri = *v.ConfigurationReference
+		}
+
+		order := 0
+		if v.Order != nil {
+			order = int(*v.Order)
+		}
+
+		tag := ""
+		if v.Tags != nil {
+			tag = *v.Tags
+		}
+
+		results = append(results, map[string]interface{}{
+			"version_id":             versionId,
+			"configuration_blob_uri": configurationBlobUri,
+			"order":                  order,
+			"tag":                    tag,
+		})
+	}
+
+	return results
+}
+
 func expandVirtualMachineIdentity(input []interface{}) (*compute.VirtualMachineIdentity, error) {
 	config, err := virtualMachineIdentity{}.Expand(input)
 	if err != nil {
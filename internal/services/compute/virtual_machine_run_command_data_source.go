@@ -0,0 +1,150 @@
+package compute
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceVirtualMachineRunCommand() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualMachineRunCommandRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualMachineID,
+			},
+
+			"location": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"source": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"script": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"script_uri": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"command_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"parameter": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"value": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"run_as_user": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"timeout_in_seconds": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"instance_view": virtualMachineRunCommandInstanceViewSchema(),
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dataSourceVirtualMachineRunCommandRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMRunCommandsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualMachineId, err := parse.VirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVirtualMachineRunCommandID(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroup, virtualMachineId.Name, d.Get("name").(string))
+
+	resp, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "instanceView")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("name", id.RunCommandName)
+	d.Set("virtual_machine_id", virtualMachineId.ID())
+	d.Set("location", resp.Location)
+
+	if props := resp.VirtualMachineRunCommandProperties; props != nil {
+		if err := d.Set("source", flattenVirtualMachineRunCommandSource(props.Source)); err != nil {
+			return fmt.Errorf("setting `source`: %+v", err)
+		}
+		if err := d.Set("parameter", flattenVirtualMachineRunCommandParameters(props.Parameters)); err != nil {
+			return fmt.Errorf("setting `parameter`: %+v", err)
+		}
+
+		d.Set("run_as_user", props.RunAsUser)
+
+		timeoutInSeconds := 0
+		if props.TimeoutInSeconds != nil {
+			timeoutInSeconds = int(*props.TimeoutInSeconds)
+		}
+		d.Set("timeout_in_seconds", timeoutInSeconds)
+
+		if err := d.Set("instance_view", flattenVirtualMachineRunCommandInstanceView(props.InstanceView)); err != nil {
+			return fmt.Errorf("setting `instance_view`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
@@ -0,0 +1,40 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualMachineRunCommandDataSource struct {
+}
+
+func TestAccDataSourceVirtualMachineRunCommand_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("source.0.script").HasValue("echo 'hello world'"),
+				check.That(data.ResourceName).Key("instance_view.0.execution_state").HasValue("Succeeded"),
+				check.That(data.ResourceName).Key("instance_view.0.exit_code").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (VirtualMachineRunCommandDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_machine_run_command" "test" {
+  name               = azurerm_virtual_machine_run_command.test.name
+  virtual_machine_id = azurerm_virtual_machine_run_command.test.virtual_machine_id
+}
+`, VirtualMachineRunCommandResource{}.basic(data))
+}
@@ -0,0 +1,540 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVirtualMachineRunCommand() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualMachineRunCommandCreateUpdate,
+		Read:   resourceVirtualMachineRunCommandRead,
+		Update: resourceVirtualMachineRunCommandCreateUpdate,
+		Delete: resourceVirtualMachineRunCommandDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VirtualMachineRunCommandID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineID,
+			},
+
+			"source": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"script": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+						},
+
+						"script_uri": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+							ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+						},
+
+						"command_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+						},
+					},
+				},
+			},
+
+			"parameter": virtualMachineRunCommandParameterSchema(false),
+
+			// due to the sensitive nature, these are not returned by the API
+			"protected_parameter": virtualMachineRunCommandParameterSchema(true),
+
+			"run_as_user": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// due to the sensitive nature, this is not returned by the API
+			"run_as_password": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"run_as_user"},
+			},
+
+			"timeout_in_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 5400),
+			},
+
+			// these are expected to contain a SAS Token, so are treated as sensitive
+			"output_blob_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"error_blob_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"instance_view": virtualMachineRunCommandInstanceViewSchema(),
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceVirtualMachineRunCommandCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMRunCommandsClient
+	vmClient := meta.(*clients.Client).Compute.VMClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualMachineId, err := parse.VirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVirtualMachineRunCommandID(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroup, virtualMachineId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_virtual_machine_run_command", id.ID())
+		}
+	}
+
+	// the Run Command must be in the same location as the Virtual Machine
+	virtualMachine, err := vmClient.Get(ctx, virtualMachineId.ResourceGroup, virtualMachineId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *virtualMachineId, err)
+	}
+	if virtualMachine.Location == nil || *virtualMachine.Location == "" {
+		return fmt.Errorf("reading location of %s", *virtualMachineId)
+	}
+
+	runCommand := compute.VirtualMachineRunCommand{
+		Location: virtualMachine.Location,
+		VirtualMachineRunCommandProperties: &compute.VirtualMachineRunCommandProperties{
+			Source:              expandVirtualMachineRunCommandSource(d.Get("source").([]interface{})),
+			Parameters:          expandVirtualMachineRunCommandParameters(d.Get("parameter").([]interface{})),
+			ProtectedParameters: expandVirtualMachineRunCommandParameters(d.Get("protected_parameter").([]interface{})),
+			AsyncExecution:      utils.Bool(false),
+			TimeoutInSeconds:    utils.Int32(int32(d.Get("timeout_in_seconds").(int))),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("run_as_user").(string); v != "" {
+		runCommand.VirtualMachineRunCommandProperties.RunAsUser = utils.String(v)
+	}
+	if v := d.Get("run_as_password").(string); v != "" {
+		runCommand.VirtualMachineRunCommandProperties.RunAsPassword = utils.String(v)
+	}
+	if v := d.Get("output_blob_uri").(string); v != "" {
+		runCommand.VirtualMachineRunCommandProperties.OutputBlobURI = utils.String(v)
+	}
+	if v := d.Get("error_blob_uri").(string); v != "" {
+		runCommand.VirtualMachineRunCommandProperties.ErrorBlobURI = utils.String(v)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, runCommand)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	// the Run Command exists at this point even if the script fails, so the ID is set prior to polling
+	// to ensure that a failed execution taints the resource rather than orphaning it
+	d.SetId(id.ID())
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	timeout := d.Timeout(pluginsdk.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(pluginsdk.TimeoutCreate)
+	}
+	get := func(ctx context.Context) (compute.VirtualMachineRunCommand, error) {
+		return client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "instanceView")
+	}
+	if err := waitForVirtualMachineRunCommandExecution(ctx, id, get, timeout); err != nil {
+		return err
+	}
+
+	return resourceVirtualMachineRunCommandRead(d, meta)
+}
+
+func resourceVirtualMachineRunCommandRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMRunCommandsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "instanceView")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RunCommandName)
+	d.Set("virtual_machine_id", parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName).ID())
+
+	if props := resp.VirtualMachineRunCommandProperties; props != nil {
+		if err := d.Set("source", flattenVirtualMachineRunCommandSource(props.Source)); err != nil {
+			return fmt.Errorf("setting `source`: %+v", err)
+		}
+		if err := d.Set("parameter", flattenVirtualMachineRunCommandParameters(props.Parameters)); err != nil {
+			return fmt.Errorf("setting `parameter`: %+v", err)
+		}
+
+		d.Set("run_as_user", props.RunAsUser)
+
+		timeoutInSeconds := 0
+		if props.TimeoutInSeconds != nil {
+			timeoutInSeconds = int(*props.TimeoutInSeconds)
+		}
+		d.Set("timeout_in_seconds", timeoutInSeconds)
+
+		if err := d.Set("instance_view", flattenVirtualMachineRunCommandInstanceView(props.InstanceView)); err != nil {
+			return fmt.Errorf("setting `instance_view`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceVirtualMachineRunCommandDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMRunCommandsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// virtualMachineRunCommandGetFunc retrieves a Run Command (including the Instance View), which allows the
+// execution to be polled for both Virtual Machines and Virtual Machine Scale Set instances
+type virtualMachineRunCommandGetFunc func(ctx context.Context) (compute.VirtualMachineRunCommand, error)
+
+func waitForVirtualMachineRunCommandExecution(ctx context.Context, id fmt.Stringer, get virtualMachineRunCommandGetFunc, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for the execution of %s to complete..", id)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(compute.ExecutionStateUnknown),
+			string(compute.ExecutionStatePending),
+			string(compute.ExecutionStateRunning),
+		},
+		Target:     []string{string(compute.ExecutionStateSucceeded)},
+		Refresh:    virtualMachineRunCommandExecutionRefreshFunc(ctx, id, get),
+		MinTimeout: 15 * time.Second,
+		Timeout:    timeout,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the execution of %s to complete: %+v", id, err)
+	}
+
+	return nil
+}
+
+func virtualMachineRunCommandExecutionRefreshFunc(ctx context.Context, id fmt.Stringer, get virtualMachineRunCommandGetFunc) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := get(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("polling the execution state of %s: %+v", id, err)
+		}
+
+		if resp.VirtualMachineRunCommandProperties == nil || resp.VirtualMachineRunCommandProperties.InstanceView == nil {
+			return resp, string(compute.ExecutionStateUnknown), nil
+		}
+
+		instanceView := *resp.VirtualMachineRunCommandProperties.InstanceView
+		switch instanceView.ExecutionState {
+		case compute.ExecutionStateFailed, compute.ExecutionStateTimedOut, compute.ExecutionStateCanceled:
+			exitCode := "unknown"
+			if instanceView.ExitCode != nil {
+				exitCode = fmt.Sprintf("%d", *instanceView.ExitCode)
+			}
+			message := ""
+			if instanceView.ExecutionMessage != nil {
+				message = *instanceView.ExecutionMessage
+			}
+			errorOutput := ""
+			if instanceView.Error != nil {
+				errorOutput = *instanceView.Error
+			}
+
+			return resp, string(instanceView.ExecutionState), fmt.Errorf("the execution finished with the state %q (Exit Code %s): %s\n\n%s", string(instanceView.ExecutionState), exitCode, message, errorOutput)
+		}
+
+		return resp, string(instanceView.ExecutionState), nil
+	}
+}
+
+func virtualMachineRunCommandParameterSchema(sensitive bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:      pluginsdk.TypeList,
+		Optional:  true,
+		Sensitive: sensitive,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"value": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					Sensitive:    sensitive,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineRunCommandInstanceViewSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"execution_state": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"execution_message": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"exit_code": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"output": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"error": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"start_time": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"end_time": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineRunCommandSource(input []interface{}) *compute.VirtualMachineRunCommandScriptSource {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := compute.VirtualMachineRunCommandScriptSource{}
+	if v := raw["script"].(string); v != "" {
+		output.Script = utils.String(v)
+	}
+	if v := raw["script_uri"].(string); v != "" {
+		output.ScriptURI = utils.String(v)
+	}
+	if v := raw["command_id"].(string); v != "" {
+		output.CommandID = utils.String(v)
+	}
+	return &output
+}
+
+func flattenVirtualMachineRunCommandSource(input *compute.VirtualMachineRunCommandScriptSource) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	script := ""
+	if input.Script != nil {
+		script = *input.Script
+	}
+	scriptUri := ""
+	if input.ScriptURI != nil {
+		scriptUri = *input.ScriptURI
+	}
+	commandId := ""
+	if input.CommandID != nil {
+		commandId = *input.CommandID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"script":     script,
+			"script_uri": scriptUri,
+			"command_id": commandId,
+		},
+	}
+}
+
+func expandVirtualMachineRunCommandParameters(input []interface{}) *[]compute.RunCommandInputParameter {
+	output := make([]compute.RunCommandInputParameter, 0)
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		output = append(output, compute.RunCommandInputParameter{
+			Name:  utils.String(raw["name"].(string)),
+			Value: utils.String(raw["value"].(string)),
+		})
+	}
+	return &output
+}
+
+func flattenVirtualMachineRunCommandParameters(input *[]compute.RunCommandInputParameter) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		name := ""
+		if v.Name != nil {
+			name = *v.Name
+		}
+		value := ""
+		if v.Value != nil {
+			value = *v.Value
+		}
+		output = append(output, map[string]interface{}{
+			"name":  name,
+			"value": value,
+		})
+	}
+	return output
+}
+
+func flattenVirtualMachineRunCommandInstanceView(input *compute.VirtualMachineRunCommandInstanceView) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	executionMessage := ""
+	if input.ExecutionMessage != nil {
+		executionMessage = *input.ExecutionMessage
+	}
+	exitCode := 0
+	if input.ExitCode != nil {
+		exitCode = int(*input.ExitCode)
+	}
+	output := ""
+	if input.Output != nil {
+		output = *input.Output
+	}
+	errorOutput := ""
+	if input.Error != nil {
+		errorOutput = *input.Error
+	}
+	startTime := ""
+	if input.StartTime != nil {
+		startTime = input.StartTime.Format(time.RFC3339)
+	}
+	endTime := ""
+	if input.EndTime != nil {
+		endTime = input.EndTime.Format(time.RFC3339)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"execution_state":   string(input.ExecutionState),
+			"execution_message": executionMessage,
+			"exit_code":         exitCode,
+			"output":            output,
+			"error":             errorOutput,
+			"start_time":        startTime,
+			"end_time":          endTime,
+		},
+	}
+}
@@ -0,0 +1,296 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVirtualMachineScaleSetRunCommand() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualMachineScaleSetRunCommandCreateUpdate,
+		Read:   resourceVirtualMachineScaleSetRunCommandRead,
+		Update: resourceVirtualMachineScaleSetRunCommandCreateUpdate,
+		Delete: resourceVirtualMachineScaleSetRunCommandDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VirtualMachineScaleSetRunCommandID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_machine_scale_set_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineScaleSetID,
+			},
+
+			"instance_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"source": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"script": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+						},
+
+						"script_uri": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+							ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+						},
+
+						"command_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+						},
+					},
+				},
+			},
+
+			"parameter": virtualMachineRunCommandParameterSchema(false),
+
+			// due to the sensitive nature, these are not returned by the API
+			"protected_parameter": virtualMachineRunCommandParameterSchema(true),
+
+			"run_as_user": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// due to the sensitive nature, this is not returned by the API
+			"run_as_password": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"run_as_user"},
+			},
+
+			"timeout_in_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 5400),
+			},
+
+			// these are expected to contain a SAS Token, so are treated as sensitive
+			"output_blob_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"error_blob_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"instance_view": virtualMachineRunCommandInstanceViewSchema(),
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceVirtualMachineScaleSetRunCommandCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMRunCommandsClient
+	vmScaleSetClient := meta.(*clients.Client).Compute.VMScaleSetClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualMachineScaleSetId, err := parse.VirtualMachineScaleSetID(d.Get("virtual_machine_scale_set_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVirtualMachineScaleSetRunCommandID(virtualMachineScaleSetId.SubscriptionId, virtualMachineScaleSetId.ResourceGroup, virtualMachineScaleSetId.Name, d.Get("instance_id").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, id.RunCommandName, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_virtual_machine_scale_set_run_command", id.ID())
+		}
+	}
+
+	// the Run Command must be in the same location as the Virtual Machine Scale Set
+	virtualMachineScaleSet, err := vmScaleSetClient.Get(ctx, virtualMachineScaleSetId.ResourceGroup, virtualMachineScaleSetId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *virtualMachineScaleSetId, err)
+	}
+	if virtualMachineScaleSet.Location == nil || *virtualMachineScaleSet.Location == "" {
+		return fmt.Errorf("reading location of %s", *virtualMachineScaleSetId)
+	}
+
+	runCommand := compute.VirtualMachineRunCommand{
+		Location: virtualMachineScaleSet.Location,
+		VirtualMachineRunCommandProperties: &compute.VirtualMachineRunCommandProperties{
+			Source:              expandVirtualMachineRunCommandSource(d.Get("source").([]interface{})),
+			Parameters:          expandVirtualMachineRunCommandParameters(d.Get("parameter").([]interface{})),
+			ProtectedParameters: expandVirtualMachineRunCommandParameters(d.Get("protected_parameter").([]interface{})),
+			AsyncExecution:      utils.Bool(false),
+			TimeoutInSeconds:    utils.Int32(int32(d.Get("timeout_in_seconds").(int))),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("run_as_user").(string); v != "" {
+		runCommand.VirtualMachineRunCommandProperties.RunAsUser = utils.String(v)
+	}
+	if v := d.Get("run_as_password").(string); v != "" {
+		runCommand.VirtualMachineRunCommandProperties.RunAsPassword = utils.String(v)
+	}
+	if v := d.Get("output_blob_uri").(string); v != "" {
+		runCommand.VirtualMachineRunCommandProperties.OutputBlobURI = utils.String(v)
+	}
+	if v := d.Get("error_blob_uri").(string); v != "" {
+		runCommand.VirtualMachineRunCommandProperties.ErrorBlobURI = utils.String(v)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, id.RunCommandName, runCommand)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	// the Run Command exists at this point even if the script fails, so the ID is set prior to polling
+	// to ensure that a failed execution taints the resource rather than orphaning it
+	d.SetId(id.ID())
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	timeout := d.Timeout(pluginsdk.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(pluginsdk.TimeoutCreate)
+	}
+	get := func(ctx context.Context) (compute.VirtualMachineRunCommand, error) {
+		return client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, id.RunCommandName, "instanceView")
+	}
+	if err := waitForVirtualMachineRunCommandExecution(ctx, id, get, timeout); err != nil {
+		return err
+	}
+
+	return resourceVirtualMachineScaleSetRunCommandRead(d, meta)
+}
+
+func resourceVirtualMachineScaleSetRunCommandRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMRunCommandsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineScaleSetRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, id.RunCommandName, "instanceView")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RunCommandName)
+	d.Set("virtual_machine_scale_set_id", parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName).ID())
+	d.Set("instance_id", id.VirtualMachineName)
+
+	if props := resp.VirtualMachineRunCommandProperties; props != nil {
+		if err := d.Set("source", flattenVirtualMachineRunCommandSource(props.Source)); err != nil {
+			return fmt.Errorf("setting `source`: %+v", err)
+		}
+		if err := d.Set("parameter", flattenVirtualMachineRunCommandParameters(props.Parameters)); err != nil {
+			return fmt.Errorf("setting `parameter`: %+v", err)
+		}
+
+		d.Set("run_as_user", props.RunAsUser)
+
+		timeoutInSeconds := 0
+		if props.TimeoutInSeconds != nil {
+			timeoutInSeconds = int(*props.TimeoutInSeconds)
+		}
+		d.Set("timeout_in_seconds", timeoutInSeconds)
+
+		if err := d.Set("instance_view", flattenVirtualMachineRunCommandInstanceView(props.InstanceView)); err != nil {
+			return fmt.Errorf("setting `instance_view`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceVirtualMachineScaleSetRunCommandDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMRunCommandsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineScaleSetRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, id.RunCommandName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package compute
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -121,6 +122,18 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 
 			"boot_diagnostics": bootDiagnosticsSchema(),
 
+			"capacity_reservation_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: computeValidate.CapacityReservationGroupID,
+				// the Compute/VM API is broken and returns the Resource Group name in UPPERCASE :shrug:
+				DiffSuppressFunc: suppress.CaseDifference,
+				ConflictsWith: []string{
+					"availability_set_id",
+					"proximity_placement_group_id",
+				},
+			},
+
 			"computer_name": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -174,6 +187,8 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 				ValidateFunc: azValidate.ISO8601DurationBetween("PT15M", "PT2H"),
 			},
 
+			"gallery_application": virtualMachineGalleryApplicationSchema(),
+
 			"identity": virtualMachineIdentity{}.Schema(),
 
 			"license_type": {
@@ -491,6 +506,18 @@ func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interfa
 		params.OsProfile.CustomData = utils.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("capacity_reservation_group_id"); ok {
+		params.CapacityReservation = &compute.CapacityReservationProfile{
+			CapacityReservationGroup: &compute.SubResource{
+				ID: utils.String(v.(string)),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("gallery_application"); ok {
+		params.ApplicationProfile = expandVirtualMachineGalleryApplication(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("dedicated_host_id"); ok {
 		params.Host = &compute.SubResource{
 			ID: utils.String(v.(string)),
@@ -700,6 +727,16 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	capacityReservationGroupId := ""
+	if props.CapacityReservation != nil && props.CapacityReservation.CapacityReservationGroup != nil && props.CapacityReservation.CapacityReservationGroup.ID != nil {
+		capacityReservationGroupId = *props.CapacityReservation.CapacityReservationGroup.ID
+	}
+	d.Set("capacity_reservation_group_id", capacityReservationGroupId)
+
+	if err := d.Set("gallery_application", flattenVirtualMachineGalleryApplication(props.ApplicationProfile)); err != nil {
+		return fmt.Errorf("setting `gallery_application`: %+v", err)
+	}
+
 	dedicatedHostId := ""
 	if props.Host != nil && props.Host.ID != nil {
 		dedicatedHostId = *props.Host.ID
@@ -933,6 +970,47 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		update.Identity = identity
 	}
 
+	if d.HasChange("capacity_reservation_group_id") {
+		shouldUpdate = true
+
+		// Code="OperationNotAllowed" Message="Updating capacity reservation group of VM is not allowed while the VM is running. Please deallocate the VM and retry the operation."
+		shouldShutDown = true
+		shouldDeallocate = true
+
+		// the VM is moved between the Capacity Reservation Groups once it's been deallocated, so both Groups are
+		// locked to avoid racing a Capacity Reservation within either Group being resized or removed
+		oldGroupId, newGroupId := d.GetChange("capacity_reservation_group_id")
+		groupIds := make([]string, 0)
+		for _, v := range []string{oldGroupId.(string), newGroupId.(string)} {
+			if v != "" {
+				groupIds = append(groupIds, strings.ToLower(v))
+			}
+		}
+		sort.Strings(groupIds)
+		for _, v := range groupIds {
+			locks.ByID(v)
+			defer locks.UnlockByID(v)
+		}
+
+		if v, ok := d.GetOk("capacity_reservation_group_id"); ok {
+			update.CapacityReservation = &compute.CapacityReservationProfile{
+				CapacityReservationGroup: &compute.SubResource{
+					ID: utils.String(v.(string)),
+				},
+			}
+		} else {
+			update.CapacityReservation = &compute.CapacityReservationProfile{
+				CapacityReservationGroup: &compute.SubResource{},
+			}
+		}
+	}
+
+	if d.HasChange("gallery_application") {
+		shouldUpdate = true
+
+		update.ApplicationProfile = expandVirtualMachineGalleryApplication(d.Get("gallery_application").([]interface{}))
+	}
+
 	if d.HasChange("dedicated_host_id") {
 		shouldUpdate = true
 
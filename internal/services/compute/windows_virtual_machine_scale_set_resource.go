@@ -99,6 +99,19 @@ func resourceWindowsVirtualMachineScaleSet() *pluginsdk.Resource {
 
 			"boot_diagnostics": bootDiagnosticsSchema(),
 
+			// changing the Capacity Reservation Group requires that every instance is deallocated, so this is ForceNew
+			"capacity_reservation_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: computeValidate.CapacityReservationGroupID,
+				// the Compute API is broken and returns the Resource Group name in UPPERCASE :shrug:
+				DiffSuppressFunc: suppress.CaseDifference,
+				ConflictsWith: []string{
+					"proximity_placement_group_id",
+				},
+			},
+
 			"computer_name_prefix": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -151,6 +164,8 @@ func resourceWindowsVirtualMachineScaleSet() *pluginsdk.Resource {
 				ValidateFunc: validate.ISO8601DurationBetween("PT15M", "PT2H"),
 			},
 
+			"gallery_application": VirtualMachineScaleSetGalleryApplicationSchema(),
+
 			"health_probe_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -598,6 +613,18 @@ func resourceWindowsVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta
 		props.VirtualMachineScaleSetProperties.PlatformFaultDomainCount = utils.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk("capacity_reservation_group_id"); ok {
+		props.VirtualMachineScaleSetProperties.VirtualMachineProfile.CapacityReservation = &compute.CapacityReservationProfile{
+			CapacityReservationGroup: &compute.SubResource{
+				ID: utils.String(v.(string)),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("gallery_application"); ok {
+		props.VirtualMachineScaleSetProperties.VirtualMachineProfile.ApplicationProfile = expandVirtualMachineGalleryApplication(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("proximity_placement_group_id"); ok {
 		props.VirtualMachineScaleSetProperties.ProximityPlacementGroup = &compute.SubResource{
 			ID: utils.String(v.(string)),
@@ -1154,6 +1181,16 @@ func resourceWindowsVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta i
 			d.Set("health_probe_id", healthProbeId)
 		}
 
+		capacityReservationGroupId := ""
+		if profile.CapacityReservation != nil && profile.CapacityReservation.CapacityReservationGroup != nil && profile.CapacityReservation.CapacityReservationGroup.ID != nil {
+			capacityReservationGroupId = *profile.CapacityReservation.CapacityReservationGroup.ID
+		}
+		d.Set("capacity_reservation_group_id", capacityReservationGroupId)
+
+		if err := d.Set("gallery_application", flattenVirtualMachineGalleryApplication(profile.ApplicationProfile)); err != nil {
+			return fmt.Errorf("setting `gallery_application`: %+v", err)
+		}
+
 		if scheduleProfile := profile.ScheduledEventsProfile; scheduleProfile != nil {
 			if err := d.Set("terminate_notification", FlattenVirtualMachineScaleSetScheduledEventsProfile(scheduleProfile)); err != nil {
 				return fmt.Errorf("setting `terminate_notification`: %+v", err)
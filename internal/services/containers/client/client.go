@@ -8,13 +8,20 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/maintenanceconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/cacherules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/credentialsets"
 )
 
 type Client struct {
 	AgentPoolsClient                *containerservice.AgentPoolsClient
+	CacheRulesClient                *cacherules.CacheRulesClient
+	ConnectedRegistriesClient       *containerregistry.ConnectedRegistriesClient
+	CredentialSetsClient            *credentialsets.CredentialSetsClient
 	GroupsClient                    *containerinstance.ContainerGroupsClient
 	KubernetesClustersClient        *containerservice.ManagedClustersClient
 	MaintenanceConfigurationsClient *containerservice.MaintenanceConfigurationsClient
+	MaintenanceSchedulesClient      *maintenanceconfigurations.MaintenanceConfigurationsClient
 	RegistriesClient                *containerregistry.RegistriesClient
 	ReplicationsClient              *containerregistry.ReplicationsClient
 	ServicesClient                  *legacy.ContainerServicesClient
@@ -22,6 +29,8 @@ type Client struct {
 	TokensClient                    *containerregistry.TokensClient
 	ScopeMapsClient                 *containerregistry.ScopeMapsClient
 	TasksClient                     *legacyacr.TasksClient
+	RegistryAgentPoolsClient        *containerregistry.AgentPoolsClient
+	RunsClient                      *containerregistry.RunsClient
 
 	Environment azure.Environment
 }
@@ -45,6 +54,21 @@ func NewClient(o *common.ClientOptions) *Client {
 	tasksClient := legacyacr.NewTasksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&tasksClient.Client, o.ResourceManagerAuthorizer)
 
+	registryAgentPoolsClient := containerregistry.NewAgentPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&registryAgentPoolsClient.Client, o.ResourceManagerAuthorizer)
+
+	runsClient := containerregistry.NewRunsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&runsClient.Client, o.ResourceManagerAuthorizer)
+
+	connectedRegistriesClient := containerregistry.NewConnectedRegistriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&connectedRegistriesClient.Client, o.ResourceManagerAuthorizer)
+
+	cacheRulesClient := cacherules.NewCacheRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&cacheRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	credentialSetsClient := credentialsets.NewCredentialSetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&credentialSetsClient.Client, o.ResourceManagerAuthorizer)
+
 	groupsClient := containerinstance.NewContainerGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -58,14 +82,21 @@ func NewClient(o *common.ClientOptions) *Client {
 	maintenanceConfigurationsClient := containerservice.NewMaintenanceConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&maintenanceConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	maintenanceSchedulesClient := maintenanceconfigurations.NewMaintenanceConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&maintenanceSchedulesClient.Client, o.ResourceManagerAuthorizer)
+
 	servicesClient := legacy.NewContainerServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
 		AgentPoolsClient:                &agentPoolsClient,
+		CacheRulesClient:                &cacheRulesClient,
+		ConnectedRegistriesClient:       &connectedRegistriesClient,
+		CredentialSetsClient:            &credentialSetsClient,
 		KubernetesClustersClient:        &kubernetesClustersClient,
 		GroupsClient:                    &groupsClient,
 		MaintenanceConfigurationsClient: &maintenanceConfigurationsClient,
+		MaintenanceSchedulesClient:      &maintenanceSchedulesClient,
 		RegistriesClient:                &registriesClient,
 		WebhooksClient:                  &webhooksClient,
 		ReplicationsClient:              &replicationsClient,
@@ -74,5 +105,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		TokensClient:                    &tokensClient,
 		ScopeMapsClient:                 &scopeMapsClient,
 		TasksClient:                     &tasksClient,
+		RegistryAgentPoolsClient:        &registryAgentPoolsClient,
+		RunsClient:                      &runsClient,
 	}
 }
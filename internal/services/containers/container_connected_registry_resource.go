@@ -0,0 +1,498 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerConnectedRegistryResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContainerConnectedRegistryResource{}
+var _ sdk.ResourceWithCustomizeDiff = ContainerConnectedRegistryResource{}
+
+type ContainerConnectedRegistryModel struct {
+	Name                 string   `tfschema:"name"`
+	ContainerRegistryId  string   `tfschema:"container_registry_id"`
+	ParentRegistryId     string   `tfschema:"parent_registry_id"`
+	SyncTokenId          string   `tfschema:"sync_token_id"`
+	SyncSchedule         string   `tfschema:"sync_schedule"`
+	SyncWindow           string   `tfschema:"sync_window"`
+	SyncMessageTTL       string   `tfschema:"sync_message_ttl"`
+	Mode                 string   `tfschema:"mode"`
+	ClientTokenIds       []string `tfschema:"client_token_ids"`
+	LogLevel             string   `tfschema:"log_level"`
+	AuditLogEnabled      bool     `tfschema:"audit_log_enabled"`
+	DataEndpointLocation string   `tfschema:"data_endpoint_location"`
+	DataEndpointHostName string   `tfschema:"data_endpoint_host_name"`
+}
+
+func (r ContainerConnectedRegistryResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerConnectedRegistryName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RegistryID,
+		},
+
+		"sync_token_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryTokenID,
+		},
+
+		"parent_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerConnectedRegistryParentID,
+		},
+
+		"mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(containerregistry.ConnectedRegistryModeRegistry),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(containerregistry.ConnectedRegistryModeMirror),
+				string(containerregistry.ConnectedRegistryModeRegistry),
+			}, false),
+		},
+
+		"sync_schedule": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "* * * * *",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sync_window": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: azValidate.ISO8601DurationBetween("PT3H", "P7D"),
+		},
+
+		"sync_message_ttl": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "P1D",
+			ValidateFunc: azValidate.ISO8601DurationBetween("P1D", "P90D"),
+		},
+
+		"client_token_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ContainerRegistryTokenID,
+			},
+		},
+
+		"log_level": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(containerregistry.LogLevelNone),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(containerregistry.LogLevelDebug),
+				string(containerregistry.LogLevelError),
+				string(containerregistry.LogLevelInformation),
+				string(containerregistry.LogLevelNone),
+				string(containerregistry.LogLevelWarning),
+			}, false),
+		},
+
+		"audit_log_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		// the location of the Container Registry (or one of its `georeplications`) whose Data Endpoint this
+		// Connected Registry synchronises from - this is configured on the Connected Registry rather than in the API
+		"data_endpoint_location": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     location.EnhancedValidate,
+			StateFunc:        location.StateFunc,
+			DiffSuppressFunc: location.DiffSuppressFunc,
+		},
+	}
+}
+
+func (r ContainerConnectedRegistryResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"data_endpoint_host_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContainerConnectedRegistryResource) ResourceType() string {
+	return "azurerm_container_connected_registry"
+}
+
+func (r ContainerConnectedRegistryResource) ModelObject() interface{} {
+	return &ContainerConnectedRegistryModel{}
+}
+
+func (r ContainerConnectedRegistryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerConnectedRegistryID
+}
+
+func (r ContainerConnectedRegistryResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			registryId, err := parse.RegistryID(rd.Get("container_registry_id").(string))
+			if err != nil {
+				// the value may not be known yet
+				return nil
+			}
+
+			if v := rd.Get("parent_registry_id").(string); v != "" {
+				if parentId, err := parse.ContainerConnectedRegistryID(v); err == nil {
+					if !strings.EqualFold(parse.NewRegistryID(parentId.SubscriptionId, parentId.ResourceGroup, parentId.RegistryName).ID(), registryId.ID()) {
+						return fmt.Errorf("the parent Connected Registry %q must belong to the Container Registry %q", v, registryId.ID())
+					}
+					// a child Connected Registry synchronises from its parent rather than a Data Endpoint
+					if raw := rd.GetRawConfig().GetAttr("data_endpoint_location"); raw.IsKnown() && !raw.IsNull() {
+						return fmt.Errorf("`data_endpoint_location` can only be specified when `parent_registry_id` is the Container Registry")
+					}
+				} else if parentId, err := parse.RegistryID(v); err == nil && !strings.EqualFold(parentId.ID(), registryId.ID()) {
+					return fmt.Errorf("`parent_registry_id` must be either the Container Registry %q or one of its Connected Registries", registryId.ID())
+				}
+			}
+
+			if v := rd.Get("sync_token_id").(string); v != "" {
+				if tokenId, err := parse.ContainerRegistryTokenID(v); err == nil && !strings.EqualFold(tokenId.RegistryName, registryId.Name) {
+					return fmt.Errorf("the Token %q used by `sync_token_id` must belong to the Container Registry %q", v, registryId.ID())
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerConnectedRegistryResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ConnectedRegistriesClient
+			registryClient := metadata.Client.Containers.RegistriesClient
+
+			var model ContainerConnectedRegistryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := parse.RegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewContainerConnectedRegistryID(registryId.SubscriptionId, registryId.ResourceGroup, registryId.Name, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.ConnectedRegistryName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// Connected Registries synchronise through the data endpoints of the Container Registry (one per geo-replicated location)
+			registry, err := registryClient.Get(ctx, registryId.ResourceGroup, registryId.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", registryId, err)
+			}
+			if props := registry.RegistryProperties; props == nil || props.DataEndpointEnabled == nil || !*props.DataEndpointEnabled {
+				return fmt.Errorf("`data_endpoint_enabled` must be enabled on %s to host Connected Registries", registryId)
+			}
+			if model.DataEndpointLocation != "" {
+				if err := validateContainerConnectedRegistryDataEndpointLocation(registry, model.DataEndpointLocation); err != nil {
+					return err
+				}
+			}
+
+			parent := &containerregistry.ParentProperties{
+				SyncProperties: &containerregistry.SyncProperties{
+					TokenID:    utils.String(model.SyncTokenId),
+					Schedule:   utils.String(model.SyncSchedule),
+					MessageTTL: utils.String(model.SyncMessageTTL),
+				},
+			}
+			if model.SyncWindow != "" {
+				parent.SyncProperties.SyncWindow = utils.String(model.SyncWindow)
+			}
+			// the Container Registry is the implicit parent, so only a parent Connected Registry is specified
+			if _, err := parse.ContainerConnectedRegistryID(model.ParentRegistryId); err == nil {
+				parent.ID = utils.String(model.ParentRegistryId)
+			}
+
+			params := containerregistry.ConnectedRegistry{
+				ConnectedRegistryProperties: &containerregistry.ConnectedRegistryProperties{
+					Mode:           containerregistry.ConnectedRegistryMode(model.Mode),
+					Parent:         parent,
+					ClientTokenIds: expandContainerConnectedRegistryClientTokenIds(model.ClientTokenIds),
+					Logging:        expandContainerConnectedRegistryLogging(model),
+				},
+			}
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.RegistryName, id.ConnectedRegistryName, params)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerConnectedRegistryResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ConnectedRegistriesClient
+			registryClient := metadata.Client.Containers.RegistriesClient
+
+			id, err := parse.ContainerConnectedRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.ConnectedRegistryName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			var state ContainerConnectedRegistryModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId := parse.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName)
+			model := ContainerConnectedRegistryModel{
+				Name:                id.ConnectedRegistryName,
+				ContainerRegistryId: registryId.ID(),
+				// the API doesn't return the parent when it's the Container Registry itself
+				ParentRegistryId: registryId.ID(),
+			}
+
+			if props := resp.ConnectedRegistryProperties; props != nil {
+				model.Mode = string(props.Mode)
+
+				if props.ClientTokenIds != nil {
+					model.ClientTokenIds = *props.ClientTokenIds
+				}
+
+				if logging := props.Logging; logging != nil {
+					model.LogLevel = string(logging.LogLevel)
+					model.AuditLogEnabled = logging.AuditLogStatus == containerregistry.Enabled
+				}
+
+				if parent := props.Parent; parent != nil {
+					if parent.ID != nil && *parent.ID != "" {
+						parentId, err := parse.ContainerConnectedRegistryID(*parent.ID)
+						if err != nil {
+							return err
+						}
+						model.ParentRegistryId = parentId.ID()
+					}
+
+					if sync := parent.SyncProperties; sync != nil {
+						if sync.TokenID != nil {
+							tokenId, err := parse.ContainerRegistryTokenID(*sync.TokenID)
+							if err != nil {
+								return err
+							}
+							model.SyncTokenId = tokenId.ID()
+						}
+						if sync.Schedule != nil {
+							model.SyncSchedule = *sync.Schedule
+						}
+						if sync.SyncWindow != nil {
+							model.SyncWindow = *sync.SyncWindow
+						}
+						if sync.MessageTTL != nil {
+							model.SyncMessageTTL = *sync.MessageTTL
+						}
+					}
+				}
+			}
+
+			// only Connected Registries whose parent is the Container Registry synchronise from a Data Endpoint
+			if _, err := parse.ContainerConnectedRegistryID(model.ParentRegistryId); err != nil {
+				registry, err := registryClient.Get(ctx, registryId.ResourceGroup, registryId.Name)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", registryId, err)
+				}
+
+				model.DataEndpointLocation = state.DataEndpointLocation
+				if model.DataEndpointLocation == "" && registry.Location != nil {
+					// e.g. when importing, where this defaults to the location of the Container Registry
+					model.DataEndpointLocation = azure.NormalizeLocation(*registry.Location)
+				}
+				if props := registry.RegistryProperties; props != nil {
+					model.DataEndpointHostName = containerRegistryDataEndpointHostName(props.DataEndpointHostNames, model.DataEndpointLocation)
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ContainerConnectedRegistryResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ConnectedRegistriesClient
+
+			id, err := parse.ContainerConnectedRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerConnectedRegistryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("data_endpoint_location") && model.DataEndpointLocation != "" {
+				registryClient := metadata.Client.Containers.RegistriesClient
+				registry, err := registryClient.Get(ctx, id.ResourceGroup, id.RegistryName)
+				if err != nil {
+					return fmt.Errorf("retrieving Container Registry %q (Resource Group %q): %+v", id.RegistryName, id.ResourceGroup, err)
+				}
+				if err := validateContainerConnectedRegistryDataEndpointLocation(registry, model.DataEndpointLocation); err != nil {
+					return err
+				}
+			}
+
+			props := &containerregistry.ConnectedRegistryUpdateProperties{}
+			if metadata.ResourceData.HasChanges("sync_schedule", "sync_window", "sync_message_ttl") {
+				props.SyncProperties = &containerregistry.SyncUpdateProperties{
+					Schedule:   utils.String(model.SyncSchedule),
+					MessageTTL: utils.String(model.SyncMessageTTL),
+				}
+				if model.SyncWindow != "" {
+					props.SyncProperties.SyncWindow = utils.String(model.SyncWindow)
+				}
+			}
+			if metadata.ResourceData.HasChanges("log_level", "audit_log_enabled") {
+				props.Logging = expandContainerConnectedRegistryLogging(model)
+			}
+			if metadata.ResourceData.HasChange("client_token_ids") {
+				props.ClientTokenIds = expandContainerConnectedRegistryClientTokenIds(model.ClientTokenIds)
+			}
+
+			params := containerregistry.ConnectedRegistryUpdateParameters{
+				ConnectedRegistryUpdateProperties: props,
+			}
+			future, err := client.Update(ctx, id.ResourceGroup, id.RegistryName, id.ConnectedRegistryName, params)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerConnectedRegistryResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ConnectedRegistriesClient
+
+			id, err := parse.ContainerConnectedRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.RegistryName, id.ConnectedRegistryName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				if !response.WasNotFound(future.Response()) {
+					return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// validateContainerConnectedRegistryDataEndpointLocation ensures the Container Registry has a Data Endpoint within the
+// specified location, which is only the case for the location of the Container Registry and its geo-replications
+func validateContainerConnectedRegistryDataEndpointLocation(registry containerregistry.Registry, dataEndpointLocation string) error {
+	var hostNames *[]string
+	if props := registry.RegistryProperties; props != nil {
+		hostNames = props.DataEndpointHostNames
+	}
+
+	if containerRegistryDataEndpointHostName(hostNames, dataEndpointLocation) == "" {
+		return fmt.Errorf("the Container Registry %q has no Data Endpoint in %q - `data_endpoint_location` must be the location of the Container Registry or one of its `georeplications`", utils.NormalizeNilableString(registry.Name), azure.NormalizeLocation(dataEndpointLocation))
+	}
+
+	return nil
+}
+
+func expandContainerConnectedRegistryClientTokenIds(input []string) *[]string {
+	ids := make([]string, 0)
+	ids = append(ids, input...)
+	return &ids
+}
+
+func expandContainerConnectedRegistryLogging(model ContainerConnectedRegistryModel) *containerregistry.LoggingProperties {
+	auditLogStatus := containerregistry.Disabled
+	if model.AuditLogEnabled {
+		auditLogStatus = containerregistry.Enabled
+	}
+
+	return &containerregistry.LoggingProperties{
+		LogLevel:       containerregistry.LogLevel(model.LogLevel),
+		AuditLogStatus: auditLogStatus,
+	}
+}
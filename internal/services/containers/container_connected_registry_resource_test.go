@@ -0,0 +1,235 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerConnectedRegistryResource struct{}
+
+func TestAccContainerConnectedRegistry_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_connected_registry", "test")
+	r := ContainerConnectedRegistryResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerConnectedRegistry_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_connected_registry", "test")
+	r := ContainerConnectedRegistryResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerConnectedRegistry_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_connected_registry", "test")
+	r := ContainerConnectedRegistryResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerConnectedRegistry_geoReplicated(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_connected_registry", "test")
+	r := ContainerConnectedRegistryResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.geoReplicated(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_container_registry.test").Key("data_endpoint_host_names.#").HasValue("2"),
+				check.That("azurerm_container_registry.test").Key("georeplications.0.data_endpoint_host_name").Exists(),
+				check.That(data.ResourceName).Key("data_endpoint_host_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerConnectedRegistryResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Containers.ConnectedRegistriesClient
+
+	id, err := parse.ContainerConnectedRegistryID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.ConnectedRegistryName); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r ContainerConnectedRegistryResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_connected_registry" "test" {
+  name                  = "testacccr%d"
+  container_registry_id = azurerm_container_registry.test.id
+  sync_token_id         = azurerm_container_registry_token.test.id
+}
+`, r.template(data, ""), data.RandomInteger)
+}
+
+func (r ContainerConnectedRegistryResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_connected_registry" "import" {
+  name                  = azurerm_container_connected_registry.test.name
+  container_registry_id = azurerm_container_connected_registry.test.container_registry_id
+  sync_token_id         = azurerm_container_connected_registry.test.sync_token_id
+}
+`, r.basic(data))
+}
+
+func (r ContainerConnectedRegistryResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_scope_map" "client" {
+  name                    = "testacc-client-scopemap-%d"
+  container_registry_name = azurerm_container_registry.test.name
+  resource_group_name     = azurerm_container_registry.test.resource_group_name
+  actions = [
+    "repositories/hello-world/content/read",
+  ]
+}
+
+resource "azurerm_container_registry_token" "client" {
+  name                    = "testacc-client-token-%d"
+  container_registry_name = azurerm_container_registry.test.name
+  resource_group_name     = azurerm_container_registry.test.resource_group_name
+  scope_map_id            = azurerm_container_registry_scope_map.client.id
+}
+
+resource "azurerm_container_connected_registry" "test" {
+  name                  = "testacccr%d"
+  container_registry_id = azurerm_container_registry.test.id
+  sync_token_id         = azurerm_container_registry_token.test.id
+  sync_schedule         = "0 12 * * 1-6"
+  sync_window           = "PT3H"
+  sync_message_ttl      = "P2D"
+  client_token_ids      = [azurerm_container_registry_token.client.id]
+  log_level             = "Debug"
+  audit_log_enabled     = true
+}
+`, r.template(data, ""), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r ContainerConnectedRegistryResource) geoReplicated(data acceptance.TestData) string {
+	georeplications := fmt.Sprintf(`
+  georeplications {
+    location = "%s"
+  }
+`, data.Locations.Secondary)
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_connected_registry" "test" {
+  name                   = "testacccr%d"
+  container_registry_id  = azurerm_container_registry.test.id
+  sync_token_id          = azurerm_container_registry_token.test.id
+  data_endpoint_location = "%s"
+}
+`, r.template(data, georeplications), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (r ContainerConnectedRegistryResource) template(data acceptance.TestData, georeplications string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                  = "testacccr%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  sku                   = "Premium"
+  data_endpoint_enabled = true
+%s
+}
+
+resource "azurerm_container_registry_scope_map" "test" {
+  name                    = "testacc-scopemap-%d"
+  container_registry_name = azurerm_container_registry.test.name
+  resource_group_name     = azurerm_container_registry.test.resource_group_name
+  actions = [
+    "repositories/hello-world/content/delete",
+    "repositories/hello-world/content/read",
+    "repositories/hello-world/content/write",
+    "repositories/hello-world/metadata/read",
+    "repositories/hello-world/metadata/write",
+    "gateway/testacccr%d/config/read",
+    "gateway/testacccr%d/config/write",
+    "gateway/testacccr%d/message/read",
+    "gateway/testacccr%d/message/write",
+  ]
+}
+
+resource "azurerm_container_registry_token" "test" {
+  name                    = "testacc-token-%d"
+  container_registry_name = azurerm_container_registry.test.name
+  resource_group_name     = azurerm_container_registry.test.resource_group_name
+  scope_map_id            = azurerm_container_registry_scope_map.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, georeplications, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,264 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryAgentPoolResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContainerRegistryAgentPoolResource{}
+
+type ContainerRegistryAgentPoolModel struct {
+	Name                   string            `tfschema:"name"`
+	ContainerRegistryId    string            `tfschema:"container_registry_id"`
+	InstanceCount          int               `tfschema:"instance_count"`
+	Tier                   string            `tfschema:"tier"`
+	VirtualNetworkSubnetId string            `tfschema:"virtual_network_subnet_id"`
+	Tags                   map[string]string `tfschema:"tags"`
+}
+
+func (r ContainerRegistryAgentPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryAgentPoolName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RegistryID,
+		},
+
+		"instance_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"tier": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "S1",
+			ValidateFunc: validation.StringInSlice([]string{
+				"S1",
+				"S2",
+				"S3",
+				"I6",
+			}, false),
+		},
+
+		"virtual_network_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ContainerRegistryAgentPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryAgentPoolResource) ResourceType() string {
+	return "azurerm_container_registry_agent_pool"
+}
+
+func (r ContainerRegistryAgentPoolResource) ModelObject() interface{} {
+	return &ContainerRegistryAgentPoolModel{}
+}
+
+func (r ContainerRegistryAgentPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerRegistryAgentPoolID
+}
+
+func (r ContainerRegistryAgentPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.RegistryAgentPoolsClient
+			registryClient := metadata.Client.Containers.RegistriesClient
+
+			var model ContainerRegistryAgentPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := parse.RegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			registry, err := registryClient.Get(ctx, registryId.ResourceGroup, registryId.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", registryId, err)
+			}
+
+			id := parse.NewContainerRegistryAgentPoolID(registryId.SubscriptionId, registryId.ResourceGroup, registryId.Name, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			params := containerregistry.AgentPool{
+				AgentPoolProperties: &containerregistry.AgentPoolProperties{
+					Count: utils.Int32(int32(model.InstanceCount)),
+					Tier:  utils.String(model.Tier),
+					Os:    containerregistry.Linux,
+				},
+				// the Agent Pool must be in the same location as the Container Registry
+				Location: utils.String(location.NormalizeNilable(registry.Location)),
+				Tags:     tags.FromTypedObject(model.Tags),
+			}
+
+			if model.VirtualNetworkSubnetId != "" {
+				params.AgentPoolProperties.VirtualNetworkSubnetResourceID = utils.String(model.VirtualNetworkSubnetId)
+			}
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName, params)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryAgentPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.RegistryAgentPoolsClient
+
+			id, err := parse.ContainerRegistryAgentPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := ContainerRegistryAgentPoolModel{
+				Name:                id.AgentPoolName,
+				ContainerRegistryId: parse.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName).ID(),
+				Tags:                tags.ToTypedObject(resp.Tags),
+			}
+
+			if props := resp.AgentPoolProperties; props != nil {
+				if props.Count != nil {
+					model.InstanceCount = int(*props.Count)
+				}
+				if props.Tier != nil {
+					model.Tier = *props.Tier
+				}
+				if props.VirtualNetworkSubnetResourceID != nil {
+					model.VirtualNetworkSubnetId = *props.VirtualNetworkSubnetResourceID
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ContainerRegistryAgentPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.RegistryAgentPoolsClient
+
+			id, err := parse.ContainerRegistryAgentPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerRegistryAgentPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			params := containerregistry.AgentPoolUpdateParameters{}
+			if metadata.ResourceData.HasChange("instance_count") {
+				params.AgentPoolPropertiesUpdateParameters = &containerregistry.AgentPoolPropertiesUpdateParameters{
+					Count: utils.Int32(int32(model.InstanceCount)),
+				}
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				params.Tags = tags.FromTypedObject(model.Tags)
+			}
+
+			future, err := client.Update(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName, params)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryAgentPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.RegistryAgentPoolsClient
+
+			id, err := parse.ContainerRegistryAgentPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				if !response.WasNotFound(future.Response()) {
+					return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,230 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/cacherules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryCacheRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContainerRegistryCacheRuleResource{}
+
+type ContainerRegistryCacheRuleModel struct {
+	Name                string `tfschema:"name"`
+	ContainerRegistryId string `tfschema:"container_registry_id"`
+	SourceRepo          string `tfschema:"source_repo"`
+	TargetRepo          string `tfschema:"target_repo"`
+	CredentialSetId     string `tfschema:"credential_set_id"`
+}
+
+func (r ContainerRegistryCacheRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryCacheRuleName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RegistryID,
+		},
+
+		"source_repo": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"target_repo": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"credential_set_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.ContainerRegistryCredentialSetID,
+		},
+	}
+}
+
+func (r ContainerRegistryCacheRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryCacheRuleResource) ResourceType() string {
+	return "azurerm_container_registry_cache_rule"
+}
+
+func (r ContainerRegistryCacheRuleResource) ModelObject() interface{} {
+	return &ContainerRegistryCacheRuleModel{}
+}
+
+func (r ContainerRegistryCacheRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerRegistryCacheRuleID
+}
+
+func (r ContainerRegistryCacheRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.CacheRulesClient
+
+			var model ContainerRegistryCacheRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := parse.RegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewContainerRegistryCacheRuleID(registryId.SubscriptionId, registryId.ResourceGroup, registryId.Name, model.Name)
+			sdkId := cacherules.NewCacheRuleID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.CacheRuleName)
+
+			existing, err := client.Get(ctx, sdkId)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			params := cacherules.CacheRule{
+				Properties: &cacherules.CacheRuleProperties{
+					SourceRepository: utils.String(model.SourceRepo),
+					TargetRepository: utils.String(model.TargetRepo),
+				},
+			}
+			if model.CredentialSetId != "" {
+				params.Properties.CredentialSetResourceId = utils.String(model.CredentialSetId)
+			}
+
+			if err := client.CreateThenPoll(ctx, sdkId, params); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryCacheRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.CacheRulesClient
+
+			id, err := parse.ContainerRegistryCacheRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, cacherules.NewCacheRuleID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.CacheRuleName))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := ContainerRegistryCacheRuleModel{
+				Name:                id.CacheRuleName,
+				ContainerRegistryId: parse.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName).ID(),
+			}
+
+			if resp.Model != nil {
+				if props := resp.Model.Properties; props != nil {
+					if props.SourceRepository != nil {
+						model.SourceRepo = *props.SourceRepository
+					}
+					if props.TargetRepository != nil {
+						model.TargetRepo = *props.TargetRepository
+					}
+					if props.CredentialSetResourceId != nil && *props.CredentialSetResourceId != "" {
+						credentialSetId, err := parse.ContainerRegistryCredentialSetID(*props.CredentialSetResourceId)
+						if err != nil {
+							return err
+						}
+						model.CredentialSetId = credentialSetId.ID()
+					}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ContainerRegistryCacheRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.CacheRulesClient
+
+			id, err := parse.ContainerRegistryCacheRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerRegistryCacheRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			params := cacherules.CacheRuleUpdateParameters{
+				Properties: &cacherules.CacheRuleUpdateProperties{
+					// an empty value removes the Credential Set from the Cache Rule
+					CredentialSetResourceId: utils.String(model.CredentialSetId),
+				},
+			}
+
+			if err := client.UpdateThenPoll(ctx, cacherules.NewCacheRuleID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.CacheRuleName), params); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryCacheRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.CacheRulesClient
+
+			id, err := parse.ContainerRegistryCacheRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, cacherules.NewCacheRuleID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.CacheRuleName)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
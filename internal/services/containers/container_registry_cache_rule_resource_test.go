@@ -0,0 +1,138 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/cacherules"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryCacheRuleResource struct{}
+
+func TestAccContainerRegistryCacheRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_cache_rule", "test")
+	r := ContainerRegistryCacheRuleResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryCacheRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_cache_rule", "test")
+	r := ContainerRegistryCacheRuleResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryCacheRule_credentialSet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_cache_rule", "test")
+	r := ContainerRegistryCacheRuleResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.credentialSet(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential_set_id").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerRegistryCacheRuleResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Containers.CacheRulesClient
+
+	id, err := parse.ContainerRegistryCacheRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, cacherules.NewCacheRuleID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.CacheRuleName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ContainerRegistryCacheRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_cache_rule" "test" {
+  name                  = "testacc-cache-rule-%d"
+  container_registry_id = azurerm_container_registry.test.id
+  source_repo           = "docker.io/library/hello-world"
+  target_repo           = "hello-world"
+}
+`, ContainerRegistryCredentialSetResource{}.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryCacheRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_cache_rule" "import" {
+  name                  = azurerm_container_registry_cache_rule.test.name
+  container_registry_id = azurerm_container_registry_cache_rule.test.container_registry_id
+  source_repo           = azurerm_container_registry_cache_rule.test.source_repo
+  target_repo           = azurerm_container_registry_cache_rule.test.target_repo
+}
+`, r.basic(data))
+}
+
+func (r ContainerRegistryCacheRuleResource) credentialSet(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_cache_rule" "test" {
+  name                  = "testacc-cache-rule-%d"
+  container_registry_id = azurerm_container_registry.test.id
+  source_repo           = "docker.io/library/hello-world"
+  target_repo           = "hello-world"
+  credential_set_id     = azurerm_container_registry_credential_set.test.id
+}
+`, ContainerRegistryCredentialSetResource{}.basic(data), data.RandomInteger)
+}
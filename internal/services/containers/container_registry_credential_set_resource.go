@@ -0,0 +1,290 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/credentialsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryCredentialSetResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContainerRegistryCredentialSetResource{}
+
+type ContainerRegistryCredentialSetModel struct {
+	Name                      string                                              `tfschema:"name"`
+	ContainerRegistryId       string                                              `tfschema:"container_registry_id"`
+	LoginServer               string                                              `tfschema:"login_server"`
+	AuthenticationCredentials []ContainerRegistryCredentialSetAuthenticationModel `tfschema:"authentication_credentials"`
+}
+
+type ContainerRegistryCredentialSetAuthenticationModel struct {
+	UsernameSecretId string `tfschema:"username_secret_id"`
+	PasswordSecretId string `tfschema:"password_secret_id"`
+}
+
+func (r ContainerRegistryCredentialSetResource) Arguments() map[string]*pluginsdk.Schema {
+	// the identity is used to retrieve the credentials from Key Vault, so is required
+	identitySchema := commonschema.SystemAssignedIdentity()
+	identitySchema.Optional = false
+	identitySchema.Required = true
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryCredentialSetName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RegistryID,
+		},
+
+		"login_server": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"authentication_credentials": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"username_secret_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.VersionlessNestedItemId,
+					},
+
+					"password_secret_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.VersionlessNestedItemId,
+					},
+				},
+			},
+		},
+
+		"identity": identitySchema,
+	}
+}
+
+func (r ContainerRegistryCredentialSetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryCredentialSetResource) ResourceType() string {
+	return "azurerm_container_registry_credential_set"
+}
+
+func (r ContainerRegistryCredentialSetResource) ModelObject() interface{} {
+	return &ContainerRegistryCredentialSetModel{}
+}
+
+func (r ContainerRegistryCredentialSetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerRegistryCredentialSetID
+}
+
+func (r ContainerRegistryCredentialSetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.CredentialSetsClient
+
+			var model ContainerRegistryCredentialSetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := parse.RegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewContainerRegistryCredentialSetID(registryId.SubscriptionId, registryId.ResourceGroup, registryId.Name, model.Name)
+			sdkId := credentialsets.NewCredentialSetID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.CredentialSetName)
+
+			existing, err := client.Get(ctx, sdkId)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAssigned(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			params := credentialsets.CredentialSet{
+				Identity: expandedIdentity,
+				Properties: &credentialsets.CredentialSetProperties{
+					LoginServer:     utils.String(model.LoginServer),
+					AuthCredentials: expandContainerRegistryCredentialSetAuthenticationCredentials(model.AuthenticationCredentials),
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, sdkId, params); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryCredentialSetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.CredentialSetsClient
+
+			id, err := parse.ContainerRegistryCredentialSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, credentialsets.NewCredentialSetID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.CredentialSetName))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := ContainerRegistryCredentialSetModel{
+				Name:                id.CredentialSetName,
+				ContainerRegistryId: parse.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName).ID(),
+			}
+
+			if resp.Model != nil {
+				if err := metadata.ResourceData.Set("identity", identity.FlattenSystemAssigned(resp.Model.Identity)); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				if props := resp.Model.Properties; props != nil {
+					if props.LoginServer != nil {
+						model.LoginServer = *props.LoginServer
+					}
+					model.AuthenticationCredentials = flattenContainerRegistryCredentialSetAuthenticationCredentials(props.AuthCredentials)
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ContainerRegistryCredentialSetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.CredentialSetsClient
+
+			id, err := parse.ContainerRegistryCredentialSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerRegistryCredentialSetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			params := credentialsets.CredentialSetUpdateParameters{}
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandSystemAssigned(metadata.ResourceData.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				params.Identity = expandedIdentity
+			}
+			if metadata.ResourceData.HasChange("authentication_credentials") {
+				params.Properties = &credentialsets.CredentialSetUpdateProperties{
+					AuthCredentials: expandContainerRegistryCredentialSetAuthenticationCredentials(model.AuthenticationCredentials),
+				}
+			}
+
+			if err := client.UpdateThenPoll(ctx, credentialsets.NewCredentialSetID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.CredentialSetName), params); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryCredentialSetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.CredentialSetsClient
+
+			id, err := parse.ContainerRegistryCredentialSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, credentialsets.NewCredentialSetID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.CredentialSetName)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerRegistryCredentialSetAuthenticationCredentials(input []ContainerRegistryCredentialSetAuthenticationModel) *[]credentialsets.AuthCredential {
+	output := make([]credentialsets.AuthCredential, 0)
+	for _, v := range input {
+		// the API only supports a single set of credentials, which must use this name
+		name := credentialsets.CredentialNameCredentialOne
+		output = append(output, credentialsets.AuthCredential{
+			Name:                     &name,
+			UsernameSecretIdentifier: utils.String(v.UsernameSecretId),
+			PasswordSecretIdentifier: utils.String(v.PasswordSecretId),
+		})
+	}
+	return &output
+}
+
+func flattenContainerRegistryCredentialSetAuthenticationCredentials(input *[]credentialsets.AuthCredential) []ContainerRegistryCredentialSetAuthenticationModel {
+	output := make([]ContainerRegistryCredentialSetAuthenticationModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		item := ContainerRegistryCredentialSetAuthenticationModel{}
+		if v.UsernameSecretIdentifier != nil {
+			item.UsernameSecretId = *v.UsernameSecretIdentifier
+		}
+		if v.PasswordSecretIdentifier != nil {
+			item.PasswordSecretId = *v.PasswordSecretIdentifier
+		}
+		output = append(output, item)
+	}
+	return output
+}
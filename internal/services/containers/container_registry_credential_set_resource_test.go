@@ -0,0 +1,218 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/credentialsets"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryCredentialSetResource struct{}
+
+func TestAccContainerRegistryCredentialSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_credential_set", "test")
+	r := ContainerRegistryCredentialSetResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryCredentialSet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_credential_set", "test")
+	r := ContainerRegistryCredentialSetResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryCredentialSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_credential_set", "test")
+	r := ContainerRegistryCredentialSetResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerRegistryCredentialSetResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Containers.CredentialSetsClient
+
+	id, err := parse.ContainerRegistryCredentialSetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, credentialsets.NewCredentialSetID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.CredentialSetName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ContainerRegistryCredentialSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_credential_set" "test" {
+  name                  = "testacc-credential-set-%d"
+  container_registry_id = azurerm_container_registry.test.id
+  login_server          = "docker.io"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  authentication_credentials {
+    username_secret_id = azurerm_key_vault_secret.username.versionless_id
+    password_secret_id = azurerm_key_vault_secret.password.versionless_id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryCredentialSetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_credential_set" "import" {
+  name                  = azurerm_container_registry_credential_set.test.name
+  container_registry_id = azurerm_container_registry_credential_set.test.container_registry_id
+  login_server          = azurerm_container_registry_credential_set.test.login_server
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  authentication_credentials {
+    username_secret_id = azurerm_key_vault_secret.username.versionless_id
+    password_secret_id = azurerm_key_vault_secret.password.versionless_id
+  }
+}
+`, r.basic(data))
+}
+
+func (r ContainerRegistryCredentialSetResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_secret" "password2" {
+  name         = "password2"
+  value        = "updatedpassword"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_container_registry_credential_set" "test" {
+  name                  = "testacc-credential-set-%d"
+  container_registry_id = azurerm_container_registry.test.id
+  login_server          = "docker.io"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  authentication_credentials {
+    username_secret_id = azurerm_key_vault_secret.username.versionless_id
+    password_secret_id = azurerm_key_vault_secret.password2.versionless_id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryCredentialSetResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy = true
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    secret_permissions = [
+      "Delete",
+      "Get",
+      "Purge",
+      "Set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_secret" "username" {
+  name         = "username"
+  value        = "testuser"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_key_vault_secret" "password" {
+  name         = "password"
+  value        = "testpassword"
+  key_vault_id = azurerm_key_vault.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomString)
+}
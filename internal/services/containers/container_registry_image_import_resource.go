@@ -0,0 +1,274 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryImageImportResource struct{}
+
+var _ sdk.ResourceWithCustomImporter = ContainerRegistryImageImportResource{}
+
+type ContainerRegistryImageImportModel struct {
+	ContainerRegistryId   string                                    `tfschema:"container_registry_id"`
+	Source                []ContainerRegistryImageImportSourceModel `tfschema:"source"`
+	TargetTags            []string                                  `tfschema:"target_tags"`
+	ForceOverwriteEnabled bool                                      `tfschema:"force_overwrite_enabled"`
+}
+
+type ContainerRegistryImageImportSourceModel struct {
+	Image               string `tfschema:"image"`
+	Digest              string `tfschema:"digest"`
+	RegistryUri         string `tfschema:"registry_uri"`
+	ContainerRegistryId string `tfschema:"container_registry_id"`
+	Username            string `tfschema:"username"`
+	Password            string `tfschema:"password"`
+}
+
+func (r ContainerRegistryImageImportResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RegistryID,
+		},
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"image": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					// the digest is what this resource is keyed on, changing it re-imports the image
+					"digest": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringMatch(
+							regexp.MustCompile(`^sha256:[a-f0-9]{64}$`),
+							"`digest` must be a sha256 digest in the format `sha256:{64 hex characters}`",
+						),
+					},
+
+					"registry_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.registry_uri", "source.0.container_registry_id"},
+					},
+
+					"container_registry_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validate.RegistryID,
+						ExactlyOneOf: []string{"source.0.registry_uri", "source.0.container_registry_id"},
+					},
+
+					"username": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						RequiredWith: []string{"source.0.password", "source.0.registry_uri"},
+					},
+
+					"password": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						RequiredWith: []string{"source.0.username", "source.0.registry_uri"},
+					},
+				},
+			},
+		},
+
+		"target_tags": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"force_overwrite_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r ContainerRegistryImageImportResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryImageImportResource) ResourceType() string {
+	return "azurerm_container_registry_image_import"
+}
+
+func (r ContainerRegistryImageImportResource) ModelObject() interface{} {
+	return &ContainerRegistryImageImportModel{}
+}
+
+func (r ContainerRegistryImageImportResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerRegistryImageImportID
+}
+
+// CustomImporter rejects importing this resource, since neither the `source` nor the `target_tags` can be retrieved
+// from the Resource Manager API - meaning an imported resource would always be replaced
+func (r ContainerRegistryImageImportResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("importing an existing %q isn't supported, since the `source` and `target_tags` of an imported image can't be retrieved", r.ResourceType())
+	}
+}
+
+func (r ContainerRegistryImageImportResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.RegistriesClient
+
+			var model ContainerRegistryImageImportModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := parse.RegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			source := model.Source[0]
+			sourceImage := containerRegistryImageImportSourceReference(source.Image, source.Digest)
+			id := parse.NewContainerRegistryImageImportID(*registryId, sourceImage)
+
+			importSource := containerregistry.ImportSource{
+				SourceImage: utils.String(sourceImage),
+			}
+			if source.ContainerRegistryId != "" {
+				importSource.ResourceID = utils.String(source.ContainerRegistryId)
+			}
+			if source.RegistryUri != "" {
+				importSource.RegistryURI = utils.String(source.RegistryUri)
+			}
+			if source.Username != "" {
+				importSource.Credentials = &containerregistry.ImportSourceCredentials{
+					Username: utils.String(source.Username),
+					Password: utils.String(source.Password),
+				}
+			}
+
+			mode := containerregistry.NoForce
+			if model.ForceOverwriteEnabled {
+				mode = containerregistry.Force
+			}
+
+			params := containerregistry.ImportImageParameters{
+				Source:     &importSource,
+				TargetTags: &model.TargetTags,
+				Mode:       mode,
+			}
+
+			future, err := client.ImportImage(ctx, registryId.ResourceGroup, registryId.Name, params)
+			if err != nil {
+				return fmt.Errorf("importing %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for import of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryImageImportResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.RegistriesClient
+
+			id, err := parse.ContainerRegistryImageImportID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the imported image isn't exposed by the Resource Manager API, so the best we can do is check
+			// that the Container Registry still exists - the remaining fields are retained from the state
+			resp, err := client.Get(ctx, id.Registry.ResourceGroup, id.Registry.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id.Registry, err)
+			}
+
+			return metadata.ResourceData.Set("container_registry_id", id.Registry.ID())
+		},
+	}
+}
+
+func (r ContainerRegistryImageImportResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ContainerRegistryImageImportID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// repositories can only be removed using the data plane API of the Container Registry,
+			// so the imported image is retained and this is only removed from the state
+			log.Printf("[DEBUG] the image for %s is retained in the Container Registry - removing from the state only", id)
+			return nil
+		},
+	}
+}
+
+// containerRegistryImageImportSourceReference returns the reference to the source image which should be imported,
+// when a digest is specified this replaces any tag on the image, since the image is then pinned to that manifest
+func containerRegistryImageImportSourceReference(image, digest string) string {
+	if digest == "" {
+		return image
+	}
+
+	repository := image
+	if i := strings.LastIndex(repository, "@"); i != -1 {
+		repository = repository[:i]
+	}
+	if i := strings.LastIndex(repository, ":"); i != -1 && i > strings.LastIndex(repository, "/") {
+		repository = repository[:i]
+	}
+
+	return fmt.Sprintf("%s@%s", repository, digest)
+}
@@ -0,0 +1,180 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryImageImportResource struct{}
+
+func TestAccContainerRegistryImageImport_publicRegistry(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_image_import", "test")
+	r := ContainerRegistryImageImportResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.publicRegistry(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccContainerRegistryImageImport_digest(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_image_import", "test")
+	r := ContainerRegistryImageImportResource{}
+
+	// two distinct manifests of mcr.microsoft.com/hello-world, changing the digest should re-import the image
+	digest := "sha256:92c7f9c92844bbbb5d0a101b22f7c2a7949e40f8ea90c8b3bc396879d95e899a"
+	updatedDigest := "sha256:d715f14f9eca81473d9112df50457893aa4d099adeb4729f679006bf5ea12407"
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.digest(data, digest),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("id").MatchesRegex(regexp.MustCompile(fmt.Sprintf(`\|hello-world@%s$`, digest))),
+			),
+		},
+		{
+			Config: r.digest(data, updatedDigest),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("id").MatchesRegex(regexp.MustCompile(fmt.Sprintf(`\|hello-world@%s$`, updatedDigest))),
+			),
+		},
+	})
+}
+
+func TestAccContainerRegistryImageImport_containerRegistry(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_image_import", "test")
+	r := ContainerRegistryImageImportResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.containerRegistry(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r ContainerRegistryImageImportResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Containers.RegistriesClient
+
+	id, err := parse.ContainerRegistryImageImportID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the imported image isn't exposed by the Resource Manager API, so this checks the Container Registry
+	if resp, err := client.Get(ctx, id.Registry.ResourceGroup, id.Registry.Name); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id.Registry, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r ContainerRegistryImageImportResource) publicRegistry(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_image_import" "test" {
+  container_registry_id = azurerm_container_registry.test.id
+  target_tags           = ["hello-world:latest", "hello-world:v1"]
+
+  source {
+    registry_uri = "mcr.microsoft.com"
+    image        = "hello-world:latest"
+  }
+}
+`, r.template(data))
+}
+
+func (r ContainerRegistryImageImportResource) digest(data acceptance.TestData, digest string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_image_import" "test" {
+  container_registry_id   = azurerm_container_registry.test.id
+  target_tags             = ["hello-world:pinned"]
+  force_overwrite_enabled = true
+
+  source {
+    registry_uri = "mcr.microsoft.com"
+    image        = "hello-world:latest"
+    digest       = "%s"
+  }
+}
+`, r.template(data), digest)
+}
+
+func (r ContainerRegistryImageImportResource) containerRegistry(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry" "source" {
+  name                = "testacccrsrc%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+
+resource "azurerm_container_registry_image_import" "source" {
+  container_registry_id = azurerm_container_registry.source.id
+  target_tags           = ["hello-world:latest"]
+
+  source {
+    registry_uri = "mcr.microsoft.com"
+    image        = "hello-world:latest"
+  }
+}
+
+resource "azurerm_container_registry_image_import" "test" {
+  container_registry_id = azurerm_container_registry.test.id
+  target_tags           = ["base/hello-world:latest"]
+
+  source {
+    container_registry_id = azurerm_container_registry.source.id
+    image                 = "hello-world:latest"
+  }
+
+  depends_on = [azurerm_container_registry_image_import.source]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryImageImportResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
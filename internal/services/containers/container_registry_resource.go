@@ -120,6 +120,12 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 						},
 
 						"tags": tags.Schema(),
+
+						// the Data Endpoint within this location, which a Connected Registry can synchronise from
+						"data_endpoint_host_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -347,6 +353,16 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			// one Data Endpoint is provisioned per geo-replicated location, each of which can be
+			// used as the synchronisation target for an `azurerm_container_connected_registry`
+			"data_endpoint_host_names": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"network_rule_bypass_option": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -799,9 +815,15 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("zone_redundancy_enabled", properties.ZoneRedundancy == containerregistry.ZoneRedundancyEnabled)
 		d.Set("anonymous_pull_enabled", properties.AnonymousPullEnabled)
 		d.Set("data_endpoint_enabled", properties.DataEndpointEnabled)
+		d.Set("data_endpoint_host_names", utils.FlattenStringSlice(properties.DataEndpointHostNames))
 		d.Set("network_rule_bypass_option", string(properties.NetworkRuleBypassOptions))
 	}
 
+	var dataEndpointHostNames *[]string
+	if properties := resp.RegistryProperties; properties != nil {
+		dataEndpointHostNames = properties.DataEndpointHostNames
+	}
+
 	if sku := resp.Sku; sku != nil {
 		d.Set("sku", string(sku.Tier))
 	}
@@ -839,6 +861,7 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 				replication["tags"] = tags.Flatten(value.Tags)
 				replication["zone_redundancy_enabled"] = value.ZoneRedundancy == containerregistry.ZoneRedundancyEnabled
 				replication["regional_endpoint_enabled"] = value.RegionEndpointEnabled != nil && *value.RegionEndpointEnabled
+				replication["data_endpoint_host_name"] = containerRegistryDataEndpointHostName(dataEndpointHostNames, valueLocation)
 				geoReplications = append(geoReplications, replication)
 			}
 		}
@@ -997,6 +1020,23 @@ func expandReplications(p []interface{}) []containerregistry.Replication {
 	return replications
 }
 
+// containerRegistryDataEndpointHostName returns the Data Endpoint of the Container Registry within the specified location,
+// Data Endpoints are in the format `{registryName}.{location}.data.azurecr.io` - an empty string is returned when none exists
+func containerRegistryDataEndpointHostName(hostNames *[]string, location string) string {
+	if hostNames == nil || location == "" {
+		return ""
+	}
+
+	segment := fmt.Sprintf(".%s.data.", azure.NormalizeLocation(location))
+	for _, hostName := range *hostNames {
+		if strings.Contains(strings.ToLower(hostName), segment) {
+			return hostName
+		}
+	}
+
+	return ""
+}
+
 func expandIdentityProperties(e []interface{}) *containerregistry.IdentityProperties {
 	identityProperties := containerregistry.IdentityProperties{}
 	identityProperties.Type = containerregistry.ResourceIdentityTypeNone
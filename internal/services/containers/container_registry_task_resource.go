@@ -621,7 +621,7 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 		"agent_pool_name": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
-			ValidateFunc: validation.StringIsNotEmpty,
+			ValidateFunc: validate.ContainerRegistryAgentPoolName,
 		},
 		"enabled": {
 			Type:     pluginsdk.TypeBool,
@@ -938,8 +938,11 @@ func (r ContainerRegistryTaskResource) Update() sdk.ResourceFunc {
 			if metadata.ResourceData.HasChange("agent_setting") {
 				existing.TaskProperties.AgentConfiguration = expandRegistryTaskAgentProperties(model.AgentConfig)
 			}
-			if metadata.ResourceData.HasChange("agent_pool_name") && model.AgentPoolName != "" {
-				existing.TaskProperties.AgentPoolName = &model.AgentPoolName
+			if metadata.ResourceData.HasChange("agent_pool_name") {
+				existing.TaskProperties.AgentPoolName = nil
+				if model.AgentPoolName != "" {
+					existing.TaskProperties.AgentPoolName = &model.AgentPoolName
+				}
 			}
 			if metadata.ResourceData.HasChange("enabled") {
 				status := legacyacr.TaskStatusDisabled
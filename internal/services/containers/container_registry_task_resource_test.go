@@ -399,6 +399,37 @@ func TestAccContainerRegistryTask_systemTask(t *testing.T) {
 	})
 }
 
+func TestAccContainerRegistryTask_agentPool(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+
+	preCheckGithubRepo(t)
+
+	r := ContainerRegistryTaskResource{
+		githubRepo: githubRepo{
+			url:   os.Getenv("ARM_TEST_ACR_TASK_GITHUB_REPO_URL"),
+			token: os.Getenv("ARM_TEST_ACR_TASK_GITHUB_USER_TOKEN"),
+		},
+	}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.agentPool(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("docker_step.0.context_access_token"),
+		{
+			Config: r.agentPool(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("agent_pool_name").HasValue(""),
+			),
+		},
+		data.ImportStep("docker_step.0.context_access_token"),
+	})
+}
+
 func TestAccContainerRegistryTask_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
 
@@ -915,6 +946,50 @@ resource "azurerm_container_registry_task" "test" {
 `, template)
 }
 
+func (r ContainerRegistryTaskResource) agentPool(data acceptance.TestData, useAgentPool bool) string {
+	agentPoolName := ""
+	if useAgentPool {
+		agentPoolName = "agent_pool_name = azurerm_container_registry_agent_pool.test.name"
+	}
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ACRTask-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccrtask%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+}
+
+resource "azurerm_container_registry_agent_pool" "test" {
+  name                  = "acctestap%d"
+  container_registry_id = azurerm_container_registry.test.id
+}
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacccrTask%d"
+  container_registry_id = azurerm_container_registry.test.id
+  %s
+  platform {
+    os = "Linux"
+  }
+  docker_step {
+    dockerfile_path      = "Dockerfile"
+    context_path         = "%s"
+    context_access_token = "%s"
+    image_names          = ["helloworld:{{.Run.ID}}"]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomIntOfLength(8), data.RandomInteger, agentPoolName, r.githubRepo.url, r.githubRepo.token)
+}
+
 func (r ContainerRegistryTaskResource) requiresImport(data acceptance.TestData) string {
 	template := r.dockerStepBasic(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,204 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryTaskScheduleRunNowResource struct{}
+
+var _ sdk.Resource = ContainerRegistryTaskScheduleRunNowResource{}
+
+type ContainerRegistryTaskScheduleRunNowModel struct {
+	TaskId string `tfschema:"container_registry_task_id"`
+	RunId  string `tfschema:"run_id"`
+	Status string `tfschema:"status"`
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_registry_task_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryTaskID,
+		},
+	}
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"run_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) ResourceType() string {
+	return "azurerm_container_registry_task_schedule_run_now"
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) ModelObject() interface{} {
+	return &ContainerRegistryTaskScheduleRunNowModel{}
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerRegistryRunID
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.RegistriesClient
+			runsClient := metadata.Client.Containers.RunsClient
+
+			var model ContainerRegistryTaskScheduleRunNowModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			taskId, err := parse.ContainerRegistryTaskID(model.TaskId)
+			if err != nil {
+				return err
+			}
+
+			req := containerregistry.TaskRunRequest{
+				TaskID: utils.String(taskId.ID()),
+			}
+			future, err := client.ScheduleRun(ctx, taskId.ResourceGroup, taskId.RegistryName, req)
+			if err != nil {
+				return fmt.Errorf("scheduling a run for %s: %+v", taskId, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for the run for %s to be scheduled: %+v", taskId, err)
+			}
+			run, err := future.Result(*client)
+			if err != nil {
+				return fmt.Errorf("retrieving the scheduled run for %s: %+v", taskId, err)
+			}
+			if run.RunProperties == nil || run.RunProperties.RunID == nil {
+				return fmt.Errorf("retrieving the scheduled run for %s: `runId` was nil", taskId)
+			}
+
+			id := parse.NewContainerRegistryRunID(taskId.SubscriptionId, taskId.ResourceGroup, taskId.RegistryName, *run.RunProperties.RunID)
+
+			// the ID is set prior to waiting for the run to finish, so that a failed (or timed out) run is
+			// tracked in the state and can be re-triggered by replacing this resource
+			metadata.SetID(id)
+
+			log.Printf("[DEBUG] Waiting for %s to finish..", id)
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending: []string{
+					string(containerregistry.RunStatusQueued),
+					string(containerregistry.RunStatusStarted),
+					string(containerregistry.RunStatusRunning),
+				},
+				Target: []string{
+					string(containerregistry.RunStatusSucceeded),
+				},
+				Refresh:    containerRegistryRunStatusRefreshFunc(ctx, runsClient, id),
+				MinTimeout: 10 * time.Second,
+				Timeout:    time.Until(deadline),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to finish: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.RunsClient
+
+			id, err := parse.ContainerRegistryRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.RunName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := ContainerRegistryTaskScheduleRunNowModel{
+				RunId: id.RunName,
+			}
+
+			// the Task isn't returned by the API, so is retained from the state
+			if v, ok := metadata.ResourceData.GetOk("container_registry_task_id"); ok {
+				model.TaskId = v.(string)
+			}
+
+			if props := resp.RunProperties; props != nil {
+				model.Status = string(props.Status)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ContainerRegistryRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// Runs can't be deleted, they're retained as part of the history of the Container Registry
+			log.Printf("[DEBUG] %s can't be deleted - removing from the state only", id)
+			return nil
+		},
+	}
+}
+
+func containerRegistryRunStatusRefreshFunc(ctx context.Context, client *containerregistry.RunsClient, id parse.ContainerRegistryRunId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.RunName)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.RunProperties == nil {
+			return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+		}
+
+		status := resp.RunProperties.Status
+		switch status {
+		case containerregistry.RunStatusCanceled, containerregistry.RunStatusError, containerregistry.RunStatusFailed, containerregistry.RunStatusTimeout:
+			return resp, string(status), fmt.Errorf("the run finished with the status %q", string(status))
+		}
+
+		return resp, string(status), nil
+	}
+}
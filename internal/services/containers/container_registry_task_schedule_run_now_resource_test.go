@@ -0,0 +1,120 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryTaskScheduleRunNowResource struct{}
+
+func TestAccContainerRegistryTaskScheduleRunNow_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task_schedule_run_now", "test")
+	r := ContainerRegistryTaskScheduleRunNowResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Succeeded"),
+			),
+		},
+	})
+}
+
+func TestAccContainerRegistryTaskScheduleRunNow_failedRun(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task_schedule_run_now", "test")
+	r := ContainerRegistryTaskScheduleRunNowResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.failedRun(data),
+			ExpectError: regexp.MustCompile("the run finished with the status"),
+		},
+	})
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Containers.RunsClient
+
+	id, err := parse.ContainerRegistryRunID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.RunName); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task_schedule_run_now" "test" {
+  container_registry_task_id = azurerm_container_registry_task.test.id
+}
+`, r.template(data, "mcr.microsoft.com/hello-world"))
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) failedRun(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task_schedule_run_now" "test" {
+  container_registry_task_id = azurerm_container_registry_task.test.id
+}
+`, r.template(data, "mcr.microsoft.com/this-image-does-not-exist"))
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) template(data acceptance.TestData, image string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ACRTask-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccrtask%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacccrTask%d"
+  container_registry_id = azurerm_container_registry.test.id
+  platform {
+    os = "Linux"
+  }
+  encoded_step {
+    task_content = <<EOF
+version: v1.1.0
+steps:
+  - cmd: %s
+    disableWorkingDirectoryOverride: true
+    timeout: 3600
+EOF
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, image)
+}
@@ -0,0 +1,356 @@
+package kubernetes
+
+import (
+	"bytes"
+	stdcontext "context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// NodePoolLabel is the label applied by AKS to each Node identifying the Node Pool it belongs to.
+const NodePoolLabel = "agentpool"
+
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+type objectMeta struct {
+	Name            string            `json:"name"`
+	Namespace       string            `json:"namespace,omitempty"`
+	UID             string            `json:"uid,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	Annotations     map[string]string `json:"annotations,omitempty"`
+	OwnerReferences []ownerReference  `json:"ownerReferences,omitempty"`
+}
+
+type ownerReference struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+type node struct {
+	Metadata objectMeta `json:"metadata"`
+	Spec     nodeSpec   `json:"spec"`
+}
+
+type nodeSpec struct {
+	Unschedulable bool `json:"unschedulable,omitempty"`
+}
+
+type nodeList struct {
+	Items []node `json:"items"`
+}
+
+type pod struct {
+	Metadata objectMeta `json:"metadata"`
+	Spec     podSpec    `json:"spec"`
+}
+
+type podSpec struct {
+	NodeName string `json:"nodeName,omitempty"`
+}
+
+type podList struct {
+	Items []pod `json:"items"`
+}
+
+type eviction struct {
+	APIVersion string     `json:"apiVersion"`
+	Kind       string     `json:"kind"`
+	Metadata   objectMeta `json:"metadata"`
+}
+
+// DrainResult summarises the work done when draining a Node Pool.
+type DrainResult struct {
+	Nodes       []string
+	EvictedPods int
+	SkippedPods int
+}
+
+// NodeDrainer cordons and drains Nodes using the Kubernetes API, honouring any Pod Disruption Budgets.
+type NodeDrainer struct {
+	client *http.Client
+	server string
+	token  string
+
+	// PollInterval is the time to wait between retrying a blocked eviction and checking a Pod has terminated.
+	PollInterval time.Duration
+}
+
+func NewNodeDrainer(server string, client *http.Client, token string) *NodeDrainer {
+	return &NodeDrainer{
+		client:       client,
+		server:       strings.TrimSuffix(server, "/"),
+		token:        token,
+		PollInterval: 5 * time.Second,
+	}
+}
+
+// NewNodeDrainerFromKubeConfig builds a NodeDrainer using the first cluster and user within the kubeconfig.
+func NewNodeDrainerFromKubeConfig(config KubeConfig) (*NodeDrainer, error) {
+	// we don't size-check these since they're validated in the Parse method
+	c := config.Clusters[0].Cluster
+	u := config.Users[0].User
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if c.ClusterAuthorityData != "" {
+		ca, err := base64.StdEncoding.DecodeString(c.ClusterAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("decoding `certificate-authority-data`: %+v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("`certificate-authority-data` contained no valid certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if u.ClientCertificteData != "" && u.ClientKeyData != "" {
+		cert, err := base64.StdEncoding.DecodeString(u.ClientCertificteData)
+		if err != nil {
+			return nil, fmt.Errorf("decoding `client-certificate-data`: %+v", err)
+		}
+		key, err := base64.StdEncoding.DecodeString(u.ClientKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("decoding `client-key-data`: %+v", err)
+		}
+		keyPair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %+v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}
+
+	return NewNodeDrainer(c.Server, client, u.Token), nil
+}
+
+// DrainNodePool cordons every Node within the Node Pool and then evicts the Pods running on them, skipping
+// those managed by a DaemonSet and static (mirror) Pods. Evictions blocked by a Pod Disruption Budget are
+// retried until the context is cancelled.
+func (n NodeDrainer) DrainNodePool(ctx stdcontext.Context, nodePoolName string) (*DrainResult, error) {
+	nodes, err := n.listNodes(ctx, nodePoolName)
+	if err != nil {
+		return nil, fmt.Errorf("listing Nodes in Node Pool %q: %+v", nodePoolName, err)
+	}
+
+	result := DrainResult{
+		Nodes: make([]string, 0, len(nodes)),
+	}
+
+	for i, item := range nodes {
+		if err := n.cordonNode(ctx, item.Metadata.Name); err != nil {
+			return &result, fmt.Errorf("cordoning Node %q: %+v", item.Metadata.Name, err)
+		}
+		log.Printf("[INFO] Cordoned Node %q (%d/%d) in Node Pool %q", item.Metadata.Name, i+1, len(nodes), nodePoolName)
+		result.Nodes = append(result.Nodes, item.Metadata.Name)
+	}
+
+	for i, item := range nodes {
+		evicted, skipped, err := n.drainNode(ctx, item.Metadata.Name)
+		result.EvictedPods += evicted
+		result.SkippedPods += skipped
+		if err != nil {
+			return &result, fmt.Errorf("draining Node %q: %+v", item.Metadata.Name, err)
+		}
+		log.Printf("[INFO] Drained Node %q (%d/%d) in Node Pool %q: %d Pods evicted, %d Pods skipped", item.Metadata.Name, i+1, len(nodes), nodePoolName, evicted, skipped)
+	}
+
+	return &result, nil
+}
+
+func (n NodeDrainer) listNodes(ctx stdcontext.Context, nodePoolName string) ([]node, error) {
+	query := url.Values{
+		"labelSelector": []string{fmt.Sprintf("%s=%s", NodePoolLabel, nodePoolName)},
+	}
+
+	var list nodeList
+	if _, err := n.do(ctx, http.MethodGet, "/api/v1/nodes?"+query.Encode(), "", nil, &list); err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
+func (n NodeDrainer) cordonNode(ctx stdcontext.Context, name string) error {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"unschedulable": true,
+		},
+	}
+
+	_, err := n.do(ctx, http.MethodPatch, fmt.Sprintf("/api/v1/nodes/%s", url.PathEscape(name)), "application/strategic-merge-patch+json", patch, nil)
+	return err
+}
+
+func (n NodeDrainer) drainNode(ctx stdcontext.Context, name string) (evicted int, skipped int, err error) {
+	query := url.Values{
+		"fieldSelector": []string{fmt.Sprintf("spec.nodeName=%s", name)},
+	}
+
+	var list podList
+	if _, err := n.do(ctx, http.MethodGet, "/api/v1/pods?"+query.Encode(), "", nil, &list); err != nil {
+		return 0, 0, fmt.Errorf("listing Pods: %+v", err)
+	}
+
+	pending := make([]pod, 0)
+	for _, item := range list.Items {
+		if !podShouldBeEvicted(item) {
+			skipped++
+			continue
+		}
+
+		if err := n.evictPod(ctx, item); err != nil {
+			return evicted, skipped, err
+		}
+		pending = append(pending, item)
+		evicted++
+	}
+
+	for _, item := range pending {
+		if err := n.waitForPodDeletion(ctx, item); err != nil {
+			return evicted, skipped, err
+		}
+	}
+
+	return evicted, skipped, nil
+}
+
+func podShouldBeEvicted(input pod) bool {
+	if _, ok := input.Metadata.Annotations[mirrorPodAnnotation]; ok {
+		return false
+	}
+
+	for _, owner := range input.Metadata.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (n NodeDrainer) evictPod(ctx stdcontext.Context, input pod) error {
+	body := eviction{
+		APIVersion: "policy/v1",
+		Kind:       "Eviction",
+		Metadata: objectMeta{
+			Name:      input.Metadata.Name,
+			Namespace: input.Metadata.Namespace,
+		},
+	}
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/eviction", url.PathEscape(input.Metadata.Namespace), url.PathEscape(input.Metadata.Name))
+
+	for {
+		status, err := n.do(ctx, http.MethodPost, path, "application/json", body, nil)
+		switch {
+		case err == nil, status == http.StatusNotFound:
+			return nil
+
+		case status == http.StatusTooManyRequests:
+			// the eviction would violate a Pod Disruption Budget, so wait and try again
+			log.Printf("[DEBUG] Eviction of Pod %q (Namespace %q) is blocked by a Pod Disruption Budget - retrying..", input.Metadata.Name, input.Metadata.Namespace)
+
+		default:
+			return fmt.Errorf("evicting Pod %q (Namespace %q): %+v", input.Metadata.Name, input.Metadata.Namespace, err)
+		}
+
+		if err := n.sleep(ctx); err != nil {
+			return fmt.Errorf("waiting to evict Pod %q (Namespace %q): %+v", input.Metadata.Name, input.Metadata.Namespace, err)
+		}
+	}
+}
+
+func (n NodeDrainer) waitForPodDeletion(ctx stdcontext.Context, input pod) error {
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s", url.PathEscape(input.Metadata.Namespace), url.PathEscape(input.Metadata.Name))
+
+	for {
+		var current pod
+		status, err := n.do(ctx, http.MethodGet, path, "", nil, &current)
+		if status == http.StatusNotFound {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("retrieving Pod %q (Namespace %q): %+v", input.Metadata.Name, input.Metadata.Namespace, err)
+		}
+		// a Pod with the same name but a different UID has been rescheduled by its controller
+		if current.Metadata.UID != input.Metadata.UID || current.Spec.NodeName != input.Spec.NodeName {
+			return nil
+		}
+
+		if err := n.sleep(ctx); err != nil {
+			return fmt.Errorf("waiting for Pod %q (Namespace %q) to terminate: %+v", input.Metadata.Name, input.Metadata.Namespace, err)
+		}
+	}
+}
+
+func (n NodeDrainer) sleep(ctx stdcontext.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(n.PollInterval):
+		return nil
+	}
+}
+
+func (n NodeDrainer) do(ctx stdcontext.Context, method, path, contentType string, body interface{}, out interface{}) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return 0, fmt.Errorf("marshalling request body: %+v", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, n.server+path, reader)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if n.token != "" {
+		req.Header.Set("Authorization", "Bearer "+n.token)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, fmt.Errorf("reading response: %+v", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d from %s %s: %s", resp.StatusCode, method, path, strings.TrimSpace(string(respBody)))
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return resp.StatusCode, fmt.Errorf("unmarshalling response: %+v", err)
+		}
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,328 @@
+package kubernetes
+
+import (
+	stdcontext "context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeKubernetesAPI is a minimal in-memory implementation of the parts of the Kubernetes API used to drain Nodes.
+type fakeKubernetesAPI struct {
+	sync.Mutex
+
+	nodes []node
+	pods  []pod
+
+	// blockedEvictions is the number of times an eviction for the named Pod is rejected by a Pod Disruption Budget
+	blockedEvictions map[string]int
+
+	evictions []string
+	token     string
+}
+
+func (f *fakeKubernetesAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.token != "" && r.Header.Get("Authorization") != "Bearer "+f.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/nodes":
+		selector := strings.SplitN(r.URL.Query().Get("labelSelector"), "=", 2)
+		items := make([]node, 0)
+		for _, item := range f.nodes {
+			if len(selector) == 2 && item.Metadata.Labels[selector[0]] == selector[1] {
+				items = append(items, item)
+			}
+		}
+		f.write(w, http.StatusOK, nodeList{Items: items})
+
+	case r.Method == http.MethodPatch && len(segments) == 4 && segments[2] == "nodes":
+		if r.Header.Get("Content-Type") != "application/strategic-merge-patch+json" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		var patch node
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for i, item := range f.nodes {
+			if item.Metadata.Name == segments[3] {
+				f.nodes[i].Spec.Unschedulable = patch.Spec.Unschedulable
+				f.write(w, http.StatusOK, f.nodes[i])
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/pods":
+		nodeName := strings.TrimPrefix(r.URL.Query().Get("fieldSelector"), "spec.nodeName=")
+		items := make([]pod, 0)
+		for _, item := range f.pods {
+			if item.Spec.NodeName == nodeName {
+				items = append(items, item)
+			}
+		}
+		f.write(w, http.StatusOK, podList{Items: items})
+
+	case r.Method == http.MethodPost && len(segments) == 7 && segments[6] == "eviction":
+		key := segments[3] + "/" + segments[5]
+		if f.blockedEvictions[key] > 0 {
+			f.blockedEvictions[key]--
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		for i, item := range f.pods {
+			if item.Metadata.Namespace == segments[3] && item.Metadata.Name == segments[5] {
+				f.pods = append(f.pods[:i], f.pods[i+1:]...)
+				f.evictions = append(f.evictions, key)
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+
+	case r.Method == http.MethodGet && len(segments) == 6 && segments[4] == "pods":
+		for _, item := range f.pods {
+			if item.Metadata.Namespace == segments[3] && item.Metadata.Name == segments[5] {
+				f.write(w, http.StatusOK, item)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func (f *fakeKubernetesAPI) write(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (f *fakeKubernetesAPI) node(name string) node {
+	for _, item := range f.nodes {
+		if item.Metadata.Name == name {
+			return item
+		}
+	}
+	return node{}
+}
+
+func newFakeNode(name, nodePool string) node {
+	return node{
+		Metadata: objectMeta{
+			Name: name,
+			Labels: map[string]string{
+				NodePoolLabel: nodePool,
+			},
+		},
+	}
+}
+
+func newFakePod(namespace, name, nodeName string) pod {
+	return pod{
+		Metadata: objectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       fmt.Sprintf("%s-%s-uid", namespace, name),
+		},
+		Spec: podSpec{
+			NodeName: nodeName,
+		},
+	}
+}
+
+func newFakeAPI() *fakeKubernetesAPI {
+	daemonSetPod := newFakePod("kube-system", "kube-proxy-abcde", "aks-old-0")
+	daemonSetPod.Metadata.OwnerReferences = []ownerReference{{Kind: "DaemonSet", Name: "kube-proxy"}}
+
+	mirrorPod := newFakePod("kube-system", "static-web", "aks-old-1")
+	mirrorPod.Metadata.Annotations = map[string]string{mirrorPodAnnotation: "abc123"}
+
+	return &fakeKubernetesAPI{
+		nodes: []node{
+			newFakeNode("aks-old-0", "old"),
+			newFakeNode("aks-old-1", "old"),
+			newFakeNode("aks-other-0", "other"),
+		},
+		pods: []pod{
+			daemonSetPod,
+			mirrorPod,
+			newFakePod("default", "web-0", "aks-old-0"),
+			newFakePod("default", "web-1", "aks-old-1"),
+			newFakePod("default", "db-0", "aks-old-1"),
+			newFakePod("default", "web-2", "aks-other-0"),
+		},
+		blockedEvictions: map[string]int{},
+	}
+}
+
+func TestNodeDrainerDrainNodePool(t *testing.T) {
+	api := newFakeAPI()
+	api.blockedEvictions["default/db-0"] = 2
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	drainer := NewNodeDrainer(server.URL, server.Client(), "")
+	drainer.PollInterval = time.Millisecond
+
+	result, err := drainer.DrainNodePool(stdcontext.Background(), "old")
+	if err != nil {
+		t.Fatalf("draining: %+v", err)
+	}
+
+	if len(result.Nodes) != 2 || result.EvictedPods != 3 || result.SkippedPods != 2 {
+		t.Fatalf("expected 2 Nodes, 3 evicted and 2 skipped Pods but got %+v", *result)
+	}
+
+	api.Lock()
+	defer api.Unlock()
+
+	for _, name := range []string{"aks-old-0", "aks-old-1"} {
+		if !api.node(name).Spec.Unschedulable {
+			t.Fatalf("expected Node %q to be cordoned", name)
+		}
+	}
+	if api.node("aks-other-0").Spec.Unschedulable {
+		t.Fatalf("expected Node %q in another Node Pool not to be cordoned", "aks-other-0")
+	}
+
+	expected := map[string]bool{
+		"default/web-0": true,
+		"default/web-1": true,
+		"default/db-0":  true,
+	}
+	if len(api.evictions) != len(expected) {
+		t.Fatalf("expected %d evictions but got %+v", len(expected), api.evictions)
+	}
+	for _, key := range api.evictions {
+		if !expected[key] {
+			t.Fatalf("unexpected eviction of %q", key)
+		}
+	}
+}
+
+func TestNodeDrainerDrainNodePoolBlockedByDisruptionBudget(t *testing.T) {
+	api := newFakeAPI()
+	api.blockedEvictions["default/web-0"] = 1000000
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	drainer := NewNodeDrainer(server.URL, server.Client(), "")
+	drainer.PollInterval = time.Millisecond
+
+	ctx, cancel := stdcontext.WithTimeout(stdcontext.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	result, err := drainer.DrainNodePool(ctx, "old")
+	if err == nil {
+		t.Fatalf("expected an error when the eviction is continually blocked but didn't get one")
+	}
+	if !strings.Contains(err.Error(), "web-0") {
+		t.Fatalf("expected the error to reference the blocked Pod but got: %+v", err)
+	}
+	if result == nil || len(result.Nodes) != 2 {
+		t.Fatalf("expected both Nodes to have been cordoned before draining but got %+v", result)
+	}
+}
+
+func TestNodeDrainerDrainNodePoolEmpty(t *testing.T) {
+	api := newFakeAPI()
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	drainer := NewNodeDrainer(server.URL, server.Client(), "")
+	result, err := drainer.DrainNodePool(stdcontext.Background(), "missing")
+	if err != nil {
+		t.Fatalf("draining: %+v", err)
+	}
+	if len(result.Nodes) != 0 || result.EvictedPods != 0 {
+		t.Fatalf("expected nothing to be drained but got %+v", *result)
+	}
+}
+
+func TestNewNodeDrainerFromKubeConfig(t *testing.T) {
+	api := newFakeAPI()
+	api.token = "test-token"
+	server := httptest.NewTLSServer(api)
+	defer server.Close()
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	config := KubeConfig{
+		KubeConfigBase: KubeConfigBase{
+			Clusters: []clusterItem{
+				{
+					Name: "test-cluster",
+					Cluster: cluster{
+						ClusterAuthorityData: base64.StdEncoding.EncodeToString(ca),
+						Server:               server.URL,
+					},
+				},
+			},
+		},
+		Users: []userItem{
+			{
+				Name: "test-user",
+				User: user{
+					Token: "test-token",
+				},
+			},
+		},
+	}
+
+	drainer, err := NewNodeDrainerFromKubeConfig(config)
+	if err != nil {
+		t.Fatalf("building drainer: %+v", err)
+	}
+	drainer.PollInterval = time.Millisecond
+
+	result, err := drainer.DrainNodePool(stdcontext.Background(), "other")
+	if err != nil {
+		t.Fatalf("draining: %+v", err)
+	}
+	if len(result.Nodes) != 1 || result.EvictedPods != 1 {
+		t.Fatalf("expected 1 Node and 1 evicted Pod but got %+v", *result)
+	}
+}
+
+func TestNewNodeDrainerFromKubeConfigInvalidCA(t *testing.T) {
+	config := KubeConfig{
+		KubeConfigBase: KubeConfigBase{
+			Clusters: []clusterItem{
+				{
+					Cluster: cluster{
+						ClusterAuthorityData: base64.StdEncoding.EncodeToString([]byte("not-a-certificate")),
+						Server:               "https://testcluster.org:443",
+					},
+				},
+			},
+		},
+		Users: []userItem{
+			{
+				User: user{
+					Token: "test-token",
+				},
+			},
+		},
+	}
+
+	if _, err := NewNodeDrainerFromKubeConfig(config); err == nil {
+		t.Fatalf("expected an error for an invalid CA but didn't get one")
+	}
+}
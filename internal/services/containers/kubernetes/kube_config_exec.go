@@ -0,0 +1,234 @@
+package kubernetes
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	execAPIVersion  = "client.authentication.k8s.io/v1beta1"
+	execCommand     = "kubelogin"
+	execInstallHint = `
+kubelogin is not installed which is required to connect to AAD enabled cluster.
+
+To learn more, please go to https://aka.ms/aks/kubelogin
+`
+)
+
+type LoginMode string
+
+const (
+	LoginModeAzureCLI         LoginMode = "azurecli"
+	LoginModeDeviceCode       LoginMode = "devicecode"
+	LoginModeInteractive      LoginMode = "interactive"
+	LoginModeMSI              LoginMode = "msi"
+	LoginModeROPC             LoginMode = "ropc"
+	LoginModeServicePrincipal LoginMode = "spn"
+	LoginModeWorkloadIdentity LoginMode = "workloadidentity"
+)
+
+func PossibleValuesForLoginMode() []string {
+	return []string{
+		string(LoginModeAzureCLI),
+		string(LoginModeDeviceCode),
+		string(LoginModeInteractive),
+		string(LoginModeMSI),
+		string(LoginModeROPC),
+		string(LoginModeServicePrincipal),
+		string(LoginModeWorkloadIdentity),
+	}
+}
+
+type userItemExec struct {
+	Name string   `yaml:"name"`
+	User userExec `yaml:"user"`
+}
+
+type userExec struct {
+	Exec execConfig `yaml:"exec"`
+}
+
+type execConfig struct {
+	APIVersion         string   `yaml:"apiVersion"`
+	Command            string   `yaml:"command"`
+	Args               []string `yaml:"args"`
+	Env                []string `yaml:"env"`
+	InstallHint        string   `yaml:"installHint,omitempty"`
+	ProvideClusterInfo bool     `yaml:"provideClusterInfo"`
+}
+
+type KubeConfigExec struct {
+	KubeConfigBase `yaml:",inline"`
+	Users          []userItemExec `yaml:"users"`
+}
+
+// ExecOptions configures how the exec-based credential plugin is invoked.
+type ExecOptions struct {
+	LoginMode LoginMode
+
+	// ClientID overrides the Client ID passed to kubelogin, which is required for the `spn` login mode
+	// and optionally selects a User Assigned Identity for the `msi` login mode.
+	ClientID string
+
+	// Environment is the name of the Azure Environment, e.g. `AzurePublicCloud`.
+	Environment string
+}
+
+// ConvertKubeConfigAADToExec rewrites the `auth-provider` users within an AAD kubeconfig to use
+// the exec-based kubelogin credential plugin, normalising the cluster server and CA along the way.
+func ConvertKubeConfigAADToExec(config KubeConfigAAD, options ExecOptions) (*KubeConfigExec, error) {
+	if len(config.Clusters) == 0 || len(config.Users) == 0 {
+		return nil, fmt.Errorf("Config %+v contains no valid clusters or users", config)
+	}
+
+	base := config.KubeConfigBase
+	clusters := make([]clusterItem, 0, len(base.Clusters))
+	for _, item := range base.Clusters {
+		server, err := normalizeServer(item.Cluster.Server)
+		if err != nil {
+			return nil, fmt.Errorf("normalizing server for cluster %q: %+v", item.Name, err)
+		}
+
+		clusters = append(clusters, clusterItem{
+			Name: item.Name,
+			Cluster: cluster{
+				ClusterAuthorityData: normalizeCertificateAuthorityData(item.Cluster.ClusterAuthorityData),
+				Server:               server,
+			},
+		})
+	}
+	base.Clusters = clusters
+
+	users := make([]userItemExec, 0, len(config.Users))
+	for _, item := range config.Users {
+		args, err := execArgs(item.User.AuthProvider.Config, options)
+		if err != nil {
+			return nil, fmt.Errorf("building exec arguments for user %q: %+v", item.Name, err)
+		}
+
+		users = append(users, userItemExec{
+			Name: item.Name,
+			User: userExec{
+				Exec: execConfig{
+					APIVersion:  execAPIVersion,
+					Command:     execCommand,
+					Args:        args,
+					InstallHint: execInstallHint,
+				},
+			},
+		})
+	}
+
+	return &KubeConfigExec{
+		KubeConfigBase: base,
+		Users:          users,
+	}, nil
+}
+
+// Marshal returns the YAML representation of the kubeconfig.
+func (c KubeConfigExec) Marshal() (string, error) {
+	out, err := yaml.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("Failed to marshal YAML config with error %+v", err)
+	}
+
+	return string(out), nil
+}
+
+func execArgs(config configAzureAD, options ExecOptions) ([]string, error) {
+	if config.APIServerID == "" {
+		return nil, fmt.Errorf("`apiserver-id` was not found in the auth-provider config")
+	}
+
+	mode := options.LoginMode
+	if mode == "" {
+		mode = LoginModeDeviceCode
+	}
+
+	args := []string{"get-token"}
+	if options.Environment != "" {
+		args = append(args, "--environment", options.Environment)
+	}
+	args = append(args, "--server-id", config.APIServerID)
+
+	switch mode {
+	case LoginModeDeviceCode, LoginModeInteractive, LoginModeROPC:
+		clientId := options.ClientID
+		if clientId == "" {
+			clientId = config.ClientID
+		}
+		if clientId != "" {
+			args = append(args, "--client-id", clientId)
+		}
+		if config.TenantID != "" {
+			args = append(args, "--tenant-id", config.TenantID)
+		}
+
+	case LoginModeServicePrincipal:
+		if options.ClientID == "" {
+			return nil, fmt.Errorf("a Client ID must be specified when using the %q login mode", string(mode))
+		}
+		args = append(args, "--client-id", options.ClientID)
+		if config.TenantID != "" {
+			args = append(args, "--tenant-id", config.TenantID)
+		}
+
+	case LoginModeMSI:
+		if options.ClientID != "" {
+			args = append(args, "--client-id", options.ClientID)
+		}
+
+	case LoginModeWorkloadIdentity:
+		if config.TenantID != "" {
+			args = append(args, "--tenant-id", config.TenantID)
+		}
+
+	case LoginModeAzureCLI:
+		// the Azure CLI uses the tenant and identity of the logged in account
+
+	default:
+		return nil, fmt.Errorf("unsupported login mode %q", string(mode))
+	}
+
+	return append(args, "--login", string(mode)), nil
+}
+
+// normalizeServer ensures the server is an absolute `https` URL with a lower-cased host and an explicit port,
+// since the kubeconfig for a private cluster can return the private FQDN without either.
+func normalizeServer(input string) (string, error) {
+	server := strings.TrimSpace(input)
+	if server == "" {
+		return "", fmt.Errorf("server was empty")
+	}
+	if !strings.Contains(server, "://") {
+		server = "https://" + server
+	}
+
+	u, err := url.Parse(server)
+	if err != nil {
+		return "", err
+	}
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("server %q has no host", input)
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "443"
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = net.JoinHostPort(strings.ToLower(u.Hostname()), port)
+	u.Path = strings.TrimSuffix(u.Path, "/")
+
+	return u.String(), nil
+}
+
+// normalizeCertificateAuthorityData removes any whitespace or line-wrapping from the base64 encoded CA.
+func normalizeCertificateAuthorityData(input string) string {
+	return strings.Join(strings.Fields(input), "")
+}
@@ -0,0 +1,185 @@
+package kubernetes
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var updateGoldenFiles = flag.Bool("update", false, "update the golden files within the testdata directory")
+
+func TestConvertKubeConfigAADToExec(t *testing.T) {
+	testCases := []struct {
+		sourceFile string
+		goldenFile string
+		options    ExecOptions
+	}{
+		{
+			sourceFile: "user_with_aad.yml",
+			goldenFile: "user_with_aad_exec_devicecode.golden.yml",
+			options: ExecOptions{
+				Environment: "AzurePublicCloud",
+			},
+		},
+		{
+			sourceFile: "user_with_aad.yml",
+			goldenFile: "user_with_aad_exec_azurecli.golden.yml",
+			options: ExecOptions{
+				LoginMode:   LoginModeAzureCLI,
+				Environment: "AzurePublicCloud",
+			},
+		},
+		{
+			sourceFile: "user_with_aad.yml",
+			goldenFile: "user_with_aad_exec_spn.golden.yml",
+			options: ExecOptions{
+				LoginMode:   LoginModeServicePrincipal,
+				ClientID:    "11111111-1111-1111-1111-111111111111",
+				Environment: "AzurePublicCloud",
+			},
+		},
+		{
+			sourceFile: "user_with_aad.yml",
+			goldenFile: "user_with_aad_exec_msi.golden.yml",
+			options: ExecOptions{
+				LoginMode:   LoginModeMSI,
+				Environment: "AzurePublicCloud",
+			},
+		},
+		{
+			sourceFile: "user_with_aad.yml",
+			goldenFile: "user_with_aad_exec_workloadidentity.golden.yml",
+			options: ExecOptions{
+				LoginMode:   LoginModeWorkloadIdentity,
+				Environment: "AzureChinaCloud",
+			},
+		},
+		{
+			sourceFile: "user_with_aad_private.yml",
+			goldenFile: "user_with_aad_private_exec_azurecli.golden.yml",
+			options: ExecOptions{
+				LoginMode:   LoginModeAzureCLI,
+				Environment: "AzurePublicCloud",
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.goldenFile, func(t *testing.T) {
+			config, err := ParseKubeConfigAAD(LoadConfig(test.sourceFile))
+			if err != nil {
+				t.Fatalf("parsing %q: %+v", test.sourceFile, err)
+			}
+
+			execConfig, err := ConvertKubeConfigAADToExec(*config, test.options)
+			if err != nil {
+				t.Fatalf("converting %q: %+v", test.sourceFile, err)
+			}
+
+			actual, err := execConfig.Marshal()
+			if err != nil {
+				t.Fatalf("marshalling %q: %+v", test.sourceFile, err)
+			}
+
+			goldenPath := filepath.Join("testdata", test.goldenFile)
+			if *updateGoldenFiles {
+				if err := os.WriteFile(goldenPath, []byte(actual), 0644); err != nil {
+					t.Fatalf("updating golden file %q: %+v", goldenPath, err)
+				}
+			}
+
+			expected := LoadConfig(test.goldenFile)
+			if expected == "" {
+				t.Fatalf("golden file %q was empty or not found - run the tests with `-update` to generate it", goldenPath)
+			}
+			if actual != expected {
+				t.Fatalf("expected:\n%s\nbut got:\n%s", expected, actual)
+			}
+		})
+	}
+}
+
+func TestConvertKubeConfigAADToExecInvalid(t *testing.T) {
+	config, err := ParseKubeConfigAAD(LoadConfig("user_with_aad.yml"))
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	testCases := []struct {
+		name    string
+		options ExecOptions
+	}{
+		{
+			name: "spn without client id",
+			options: ExecOptions{
+				LoginMode: LoginModeServicePrincipal,
+			},
+		},
+		{
+			name: "unknown login mode",
+			options: ExecOptions{
+				LoginMode: "password",
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := ConvertKubeConfigAADToExec(*config, test.options); err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+		})
+	}
+}
+
+func TestNormalizeServer(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+		error    bool
+	}{
+		{
+			input:    "https://testcluster.hcp.westeurope.azmk8s.io:443",
+			expected: "https://testcluster.hcp.westeurope.azmk8s.io:443",
+		},
+		{
+			input:    "https://TestCluster.privatelink.westeurope.azmk8s.io",
+			expected: "https://testcluster.privatelink.westeurope.azmk8s.io:443",
+		},
+		{
+			input:    " testcluster.privatelink.westeurope.azmk8s.io/ ",
+			expected: "https://testcluster.privatelink.westeurope.azmk8s.io:443",
+		},
+		{
+			input:    "https://10.0.0.4:8443",
+			expected: "https://10.0.0.4:8443",
+		},
+		{
+			input: "",
+			error: true,
+		},
+		{
+			input: "https://",
+			error: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.input, func(t *testing.T) {
+			actual, err := normalizeServer(test.input)
+			if test.error {
+				if err == nil {
+					t.Fatalf("expected an error but didn't get one")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if actual != test.expected {
+				t.Fatalf("expected %q but got %q", test.expected, actual)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -478,6 +479,66 @@ func dataSourceKubernetesCluster() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 
+			"kube_config_exec": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"host": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"cluster_ca_certificate": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"api_version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"command": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"args": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"kube_config_exec_raw": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"kubelogin": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"login_mode": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(kubernetes.LoginModeDeviceCode),
+							ValidateFunc: validation.StringInSlice(kubernetes.PossibleValuesForLoginMode(), false),
+						},
+						"client_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+
 			"kubelet_identity": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -777,6 +838,16 @@ func dataSourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("setting `kube_config`: %+v", err)
 	}
 
+	execOptions := expandKubernetesClusterKubeLogin(d.Get("kubelogin").([]interface{}), meta.(*clients.Client).Containers.Environment.Name)
+	kubeConfigExecRaw, kubeConfigExec, err := flattenKubernetesClusterKubeConfigExec(profile, execOptions)
+	if err != nil {
+		return fmt.Errorf("building `kube_config_exec`: %+v", err)
+	}
+	d.Set("kube_config_exec_raw", kubeConfigExecRaw)
+	if err := d.Set("kube_config_exec", kubeConfigExec); err != nil {
+		return fmt.Errorf("setting `kube_config_exec`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
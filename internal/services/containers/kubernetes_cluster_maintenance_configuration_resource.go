@@ -0,0 +1,496 @@
+package containers
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/maintenanceconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceKubernetesClusterMaintenanceConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKubernetesClusterMaintenanceConfigurationCreate,
+		Read:   resourceKubernetesClusterMaintenanceConfigurationRead,
+		Update: resourceKubernetesClusterMaintenanceConfigurationUpdate,
+		Delete: resourceKubernetesClusterMaintenanceConfigurationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.KubernetesMaintenanceConfigurationName,
+			},
+
+			"kubernetes_cluster_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ClusterID,
+			},
+
+			"maintenance_window": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"duration_hours": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(4, 24),
+						},
+
+						"start_time": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`),
+								"`start_time` must be in the format `HH:mm`",
+							),
+						},
+
+						"start_date": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: kubernetesClusterMaintenanceConfigurationDate,
+						},
+
+						"utc_offset": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^(-|\+)[0-9]{2}:[0-9]{2}$`),
+								"`utc_offset` must be in the format `+HH:MM` or `-HH:MM`",
+							),
+						},
+
+						"daily": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							ExactlyOneOf: []string{
+								"maintenance_window.0.daily",
+								"maintenance_window.0.weekly",
+								"maintenance_window.0.absolute_monthly",
+								"maintenance_window.0.relative_monthly",
+							},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"interval_days": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 7),
+									},
+								},
+							},
+						},
+
+						"weekly": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							ExactlyOneOf: []string{
+								"maintenance_window.0.daily",
+								"maintenance_window.0.weekly",
+								"maintenance_window.0.absolute_monthly",
+								"maintenance_window.0.relative_monthly",
+							},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"interval_weeks": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 4),
+									},
+
+									"day_of_week": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(maintenanceconfigurations.PossibleValuesForWeekDay(), false),
+									},
+								},
+							},
+						},
+
+						"absolute_monthly": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							ExactlyOneOf: []string{
+								"maintenance_window.0.daily",
+								"maintenance_window.0.weekly",
+								"maintenance_window.0.absolute_monthly",
+								"maintenance_window.0.relative_monthly",
+							},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"interval_months": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 6),
+									},
+
+									"day_of_month": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 31),
+									},
+								},
+							},
+						},
+
+						"relative_monthly": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							ExactlyOneOf: []string{
+								"maintenance_window.0.daily",
+								"maintenance_window.0.weekly",
+								"maintenance_window.0.absolute_monthly",
+								"maintenance_window.0.relative_monthly",
+							},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"interval_months": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 6),
+									},
+
+									"day_of_week": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(maintenanceconfigurations.PossibleValuesForWeekDay(), false),
+									},
+
+									"week_index": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(maintenanceconfigurations.PossibleValuesForType(), false),
+									},
+								},
+							},
+						},
+
+						"not_allowed": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"start": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: kubernetesClusterMaintenanceConfigurationDate,
+									},
+
+									"end": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: kubernetesClusterMaintenanceConfigurationDate,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesClusterMaintenanceConfigurationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	clustersClient := meta.(*clients.Client).Containers.KubernetesClustersClient
+	client := meta.(*clients.Client).Containers.MaintenanceSchedulesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	clusterId, err := parse.ClusterID(d.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := maintenanceconfigurations.NewMaintenanceConfigurationID(clusterId.SubscriptionId, clusterId.ResourceGroup, clusterId.ManagedClusterName, d.Get("name").(string))
+
+	cluster, err := clustersClient.Get(ctx, clusterId.ResourceGroup, clusterId.ManagedClusterName)
+	if err != nil {
+		if utils.ResponseWasNotFound(cluster.Response) {
+			return fmt.Errorf("%s was not found", *clusterId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *clusterId, err)
+	}
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_kubernetes_cluster_maintenance_configuration", id.ID())
+	}
+
+	parameters := maintenanceconfigurations.MaintenanceConfiguration{
+		Properties: &maintenanceconfigurations.MaintenanceConfigurationProperties{
+			MaintenanceWindow: expandKubernetesClusterMaintenanceConfigurationWindow(d.Get("maintenance_window").([]interface{})),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKubernetesClusterMaintenanceConfigurationRead(d, meta)
+}
+
+func resourceKubernetesClusterMaintenanceConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.MaintenanceSchedulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.MaintenanceConfigurationName)
+	d.Set("kubernetes_cluster_id", parse.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName).ID())
+
+	var window []interface{}
+	if model := resp.Model; model != nil && model.Properties != nil {
+		window = flattenKubernetesClusterMaintenanceConfigurationWindow(model.Properties.MaintenanceWindow)
+	}
+	if err := d.Set("maintenance_window", window); err != nil {
+		return fmt.Errorf("setting `maintenance_window`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesClusterMaintenanceConfigurationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.MaintenanceSchedulesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := maintenanceconfigurations.MaintenanceConfiguration{
+		Properties: &maintenanceconfigurations.MaintenanceConfigurationProperties{
+			MaintenanceWindow: expandKubernetesClusterMaintenanceConfigurationWindow(d.Get("maintenance_window").([]interface{})),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceKubernetesClusterMaintenanceConfigurationRead(d, meta)
+}
+
+func resourceKubernetesClusterMaintenanceConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.MaintenanceSchedulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func kubernetesClusterMaintenanceConfigurationDate(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := time.Parse("2006-01-02", v); err != nil {
+		errors = append(errors, fmt.Errorf("%s must be a date in the format `YYYY-MM-DD`, got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
+func expandKubernetesClusterMaintenanceConfigurationWindow(input []interface{}) *maintenanceconfigurations.MaintenanceWindow {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	window := maintenanceconfigurations.MaintenanceWindow{
+		DurationHours:   int64(raw["duration_hours"].(int)),
+		NotAllowedDates: expandKubernetesClusterMaintenanceConfigurationDateSpans(raw["not_allowed"].(*pluginsdk.Set).List()),
+		StartTime:       raw["start_time"].(string),
+	}
+
+	if v := raw["start_date"].(string); v != "" {
+		window.StartDate = utils.String(v)
+	}
+	if v := raw["utc_offset"].(string); v != "" {
+		window.UtcOffset = utils.String(v)
+	}
+
+	if v := raw["daily"].([]interface{}); len(v) > 0 && v[0] != nil {
+		daily := v[0].(map[string]interface{})
+		window.Schedule.Daily = &maintenanceconfigurations.DailySchedule{
+			IntervalDays: int64(daily["interval_days"].(int)),
+		}
+	}
+
+	if v := raw["weekly"].([]interface{}); len(v) > 0 && v[0] != nil {
+		weekly := v[0].(map[string]interface{})
+		window.Schedule.Weekly = &maintenanceconfigurations.WeeklySchedule{
+			DayOfWeek:     maintenanceconfigurations.WeekDay(weekly["day_of_week"].(string)),
+			IntervalWeeks: int64(weekly["interval_weeks"].(int)),
+		}
+	}
+
+	if v := raw["absolute_monthly"].([]interface{}); len(v) > 0 && v[0] != nil {
+		monthly := v[0].(map[string]interface{})
+		window.Schedule.AbsoluteMonthly = &maintenanceconfigurations.AbsoluteMonthlySchedule{
+			DayOfMonth:     int64(monthly["day_of_month"].(int)),
+			IntervalMonths: int64(monthly["interval_months"].(int)),
+		}
+	}
+
+	if v := raw["relative_monthly"].([]interface{}); len(v) > 0 && v[0] != nil {
+		monthly := v[0].(map[string]interface{})
+		window.Schedule.RelativeMonthly = &maintenanceconfigurations.RelativeMonthlySchedule{
+			DayOfWeek:      maintenanceconfigurations.WeekDay(monthly["day_of_week"].(string)),
+			IntervalMonths: int64(monthly["interval_months"].(int)),
+			WeekIndex:      maintenanceconfigurations.Type(monthly["week_index"].(string)),
+		}
+	}
+
+	return &window
+}
+
+func expandKubernetesClusterMaintenanceConfigurationDateSpans(input []interface{}) *[]maintenanceconfigurations.DateSpan {
+	results := make([]maintenanceconfigurations.DateSpan, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, maintenanceconfigurations.DateSpan{
+			Start: v["start"].(string),
+			End:   v["end"].(string),
+		})
+	}
+	return &results
+}
+
+func flattenKubernetesClusterMaintenanceConfigurationWindow(input *maintenanceconfigurations.MaintenanceWindow) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	startDate := ""
+	if input.StartDate != nil {
+		startDate = *input.StartDate
+	}
+	utcOffset := ""
+	if input.UtcOffset != nil {
+		utcOffset = *input.UtcOffset
+	}
+
+	daily := make([]interface{}, 0)
+	if v := input.Schedule.Daily; v != nil {
+		daily = append(daily, map[string]interface{}{
+			"interval_days": int(v.IntervalDays),
+		})
+	}
+
+	weekly := make([]interface{}, 0)
+	if v := input.Schedule.Weekly; v != nil {
+		weekly = append(weekly, map[string]interface{}{
+			"day_of_week":    string(v.DayOfWeek),
+			"interval_weeks": int(v.IntervalWeeks),
+		})
+	}
+
+	absoluteMonthly := make([]interface{}, 0)
+	if v := input.Schedule.AbsoluteMonthly; v != nil {
+		absoluteMonthly = append(absoluteMonthly, map[string]interface{}{
+			"day_of_month":    int(v.DayOfMonth),
+			"interval_months": int(v.IntervalMonths),
+		})
+	}
+
+	relativeMonthly := make([]interface{}, 0)
+	if v := input.Schedule.RelativeMonthly; v != nil {
+		relativeMonthly = append(relativeMonthly, map[string]interface{}{
+			"day_of_week":     string(v.DayOfWeek),
+			"interval_months": int(v.IntervalMonths),
+			"week_index":      string(v.WeekIndex),
+		})
+	}
+
+	notAllowed := make([]interface{}, 0)
+	if input.NotAllowedDates != nil {
+		for _, item := range *input.NotAllowedDates {
+			notAllowed = append(notAllowed, map[string]interface{}{
+				"start": item.Start,
+				"end":   item.End,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"duration_hours":   int(input.DurationHours),
+			"start_time":       input.StartTime,
+			"start_date":       startDate,
+			"utc_offset":       utcOffset,
+			"daily":            daily,
+			"weekly":           weekly,
+			"absolute_monthly": absoluteMonthly,
+			"relative_monthly": relativeMonthly,
+			"not_allowed":      notAllowed,
+		},
+	}
+}
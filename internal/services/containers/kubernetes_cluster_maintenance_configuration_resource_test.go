@@ -0,0 +1,322 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/maintenanceconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesClusterMaintenanceConfigurationResource struct{}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_window.0.weekly.0.day_of_week").HasValue("Sunday"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_window.0.utc_offset").HasValue("+05:30"),
+				check.That(data.ResourceName).Key("maintenance_window.0.not_allowed.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.absoluteMonthly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_nodeOSDaily(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.nodeOSDaily(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_withInlineMaintenanceWindow(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withInlineMaintenanceWindow(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_kubernetes_cluster.test").Key("maintenance_window.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KubernetesClusterMaintenanceConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.MaintenanceSchedulesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (KubernetesClusterMaintenanceConfigurationResource) template(data acceptance.TestData, maintenanceWindow string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  %s
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, maintenanceWindow)
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedAutoUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    duration_hours = 4
+    start_time     = "00:00"
+
+    weekly {
+      interval_weeks = 1
+      day_of_week    = "Sunday"
+    }
+  }
+}
+`, r.template(data, ""))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "import" {
+  name                  = azurerm_kubernetes_cluster_maintenance_configuration.test.name
+  kubernetes_cluster_id = azurerm_kubernetes_cluster_maintenance_configuration.test.kubernetes_cluster_id
+
+  maintenance_window {
+    duration_hours = 4
+    start_time     = "00:00"
+
+    weekly {
+      interval_weeks = 1
+      day_of_week    = "Sunday"
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedAutoUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    duration_hours = 8
+    start_time     = "21:30"
+    start_date     = "2030-01-01"
+    utc_offset     = "+05:30"
+
+    relative_monthly {
+      interval_months = 2
+      day_of_week     = "Saturday"
+      week_index      = "Last"
+    }
+
+    not_allowed {
+      start = "2030-12-20"
+      end   = "2031-01-05"
+    }
+  }
+}
+`, r.template(data, ""))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) absoluteMonthly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedAutoUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    duration_hours = 6
+    start_time     = "02:00"
+    utc_offset     = "-08:00"
+
+    absolute_monthly {
+      interval_months = 1
+      day_of_month    = 15
+    }
+  }
+}
+`, r.template(data, ""))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) nodeOSDaily(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedNodeOSUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    duration_hours = 4
+    start_time     = "03:00"
+
+    daily {
+      interval_days = 1
+    }
+  }
+}
+`, r.template(data, ""))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) withInlineMaintenanceWindow(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedAutoUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    duration_hours = 4
+    start_time     = "00:00"
+
+    weekly {
+      interval_weeks = 1
+      day_of_week    = "Sunday"
+    }
+  }
+}
+`, r.template(data, `
+  maintenance_window {
+    allowed {
+      day   = "Monday"
+      hours = [1, 2]
+    }
+  }
+`))
+}
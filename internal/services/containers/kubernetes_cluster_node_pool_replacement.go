@@ -0,0 +1,235 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/kubernetes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const nodePoolReplacementStrategyCreateBeforeDestroyWithDrain = "create_before_destroy_with_drain"
+
+// nodePoolReplacementTag is applied to the temporary Node Pool to identify the Node Pool it's replacing
+const nodePoolReplacementTag = "azurerm-replacement-for"
+
+// nodePoolReplacementFields are the fields which can only be changed by replacing the Node Pool
+var nodePoolReplacementFields = []string{
+	"availability_zones",
+	"enable_host_encryption",
+	"fips_enabled",
+	"max_pods",
+	"node_labels",
+	"node_taints",
+	"os_disk_size_gb",
+	"os_disk_type",
+	"os_sku",
+	"ultra_ssd_enabled",
+	"vm_size",
+}
+
+// kubernetesClusterNodePoolReplacementCustomizeDiff forces a new resource when a field which requires the Node Pool
+// to be replaced changes, unless the replacement is being orchestrated in-place by the `replacement_strategy`.
+func kubernetesClusterNodePoolReplacementCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.Get("replacement_strategy").(string) == nodePoolReplacementStrategyCreateBeforeDestroyWithDrain {
+		return nil
+	}
+
+	for _, key := range nodePoolReplacementFields {
+		if d.HasChange(key) {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// replaceKubernetesClusterNodePool replaces the Node Pool whilst keeping the workloads running. A temporary Node Pool
+// is created using the new configuration, the Nodes in the existing Node Pool are cordoned and drained before it's
+// deleted, and then the Node Pool is re-created and the temporary Node Pool is drained and deleted in turn - meaning
+// that the name (and ID) of the Node Pool is unchanged.
+func replaceKubernetesClusterNodePool(ctx context.Context, d *pluginsdk.ResourceData, containersClient *client.Client, id parse.NodePoolId) error {
+	poolsClient := containersClient.AgentPoolsClient
+
+	profile, err := expandKubernetesClusterNodePoolProperties(d)
+	if err != nil {
+		return err
+	}
+	temporaryName := temporaryKubernetesClusterNodePoolName(id.AgentPoolName, profile.OsType)
+
+	// retrieve the credentials up-front so that we fail before making any changes
+	drainer, err := kubernetesClusterNodeDrainer(ctx, containersClient, id.ResourceGroup, id.ManagedClusterName)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Replacing Node Pool %q (Kubernetes Cluster %q / Resource Group %q) using the temporary Node Pool %q..", id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup, temporaryName)
+
+	if err := createTemporaryKubernetesClusterNodePool(ctx, poolsClient, id, temporaryName, *profile); err != nil {
+		return fmt.Errorf("replacing Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
+	if err := drainAndDeleteKubernetesClusterNodePool(ctx, drainer, poolsClient, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName); err != nil {
+		return fmt.Errorf("replacing Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v - the temporary Node Pool %q is running the workloads and will be removed once the replacement completes", id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup, err, temporaryName)
+	}
+
+	log.Printf("[INFO] Re-creating Node Pool %q (Kubernetes Cluster %q / Resource Group %q)..", id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup)
+	parameters := containerservice.AgentPool{
+		Name:                                     utils.String(id.AgentPoolName),
+		ManagedClusterAgentPoolProfileProperties: profile,
+	}
+	future, err := poolsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName, parameters)
+	if err != nil {
+		return fmt.Errorf("re-creating Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v - the temporary Node Pool %q is running the workloads and will be removed once the replacement completes", id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup, err, temporaryName)
+	}
+	if err := future.WaitForCompletionRef(ctx, poolsClient.Client); err != nil {
+		return fmt.Errorf("waiting for re-creation of Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v - the temporary Node Pool %q is running the workloads and will be removed once the replacement completes", id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup, err, temporaryName)
+	}
+
+	if err := drainAndDeleteKubernetesClusterNodePool(ctx, drainer, poolsClient, id.ResourceGroup, id.ManagedClusterName, temporaryName); err != nil {
+		return fmt.Errorf("removing the temporary Node Pool %q used to replace Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", temporaryName, id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
+	log.Printf("[INFO] Replaced Node Pool %q (Kubernetes Cluster %q / Resource Group %q)", id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup)
+
+	return nil
+}
+
+// removeTemporaryKubernetesClusterNodePool drains and deletes the temporary Node Pool left behind by a replacement
+// which failed after the original Node Pool had been deleted.
+func removeTemporaryKubernetesClusterNodePool(ctx context.Context, containersClient *client.Client, id parse.NodePoolId, osType containerservice.OSType) error {
+	poolsClient := containersClient.AgentPoolsClient
+	temporaryName := temporaryKubernetesClusterNodePoolName(id.AgentPoolName, osType)
+
+	existing, err := poolsClient.Get(ctx, id.ResourceGroup, id.ManagedClusterName, temporaryName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("checking for the temporary Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", temporaryName, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+	if !isTemporaryKubernetesClusterNodePoolFor(existing, id.AgentPoolName) {
+		return nil
+	}
+
+	drainer, err := kubernetesClusterNodeDrainer(ctx, containersClient, id.ResourceGroup, id.ManagedClusterName)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Removing the temporary Node Pool %q left behind by a previous replacement of Node Pool %q..", temporaryName, id.AgentPoolName)
+	return drainAndDeleteKubernetesClusterNodePool(ctx, drainer, poolsClient, id.ResourceGroup, id.ManagedClusterName, temporaryName)
+}
+
+func createTemporaryKubernetesClusterNodePool(ctx context.Context, poolsClient *containerservice.AgentPoolsClient, id parse.NodePoolId, temporaryName string, profile containerservice.ManagedClusterAgentPoolProfileProperties) error {
+	existing, err := poolsClient.Get(ctx, id.ResourceGroup, id.ManagedClusterName, temporaryName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for an existing Node Pool %q: %+v", temporaryName, err)
+		}
+	}
+	// a temporary Node Pool left behind by a previous replacement is reused, but any other Node Pool is left alone
+	if !utils.ResponseWasNotFound(existing.Response) && !isTemporaryKubernetesClusterNodePoolFor(existing, id.AgentPoolName) {
+		return fmt.Errorf("a Node Pool named %q already exists which isn't a temporary Node Pool for %q - this name is needed to replace the Node Pool", temporaryName, id.AgentPoolName)
+	}
+
+	t := make(map[string]*string)
+	for k, v := range profile.Tags {
+		t[k] = v
+	}
+	t[nodePoolReplacementTag] = utils.String(id.AgentPoolName)
+	profile.Tags = t
+
+	log.Printf("[INFO] Creating the temporary Node Pool %q (Kubernetes Cluster %q / Resource Group %q)..", temporaryName, id.ManagedClusterName, id.ResourceGroup)
+	parameters := containerservice.AgentPool{
+		Name:                                     utils.String(temporaryName),
+		ManagedClusterAgentPoolProfileProperties: &profile,
+	}
+	future, err := poolsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, temporaryName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating the temporary Node Pool %q: %+v", temporaryName, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, poolsClient.Client); err != nil {
+		return fmt.Errorf("waiting for creation of the temporary Node Pool %q: %+v", temporaryName, err)
+	}
+
+	return nil
+}
+
+func drainAndDeleteKubernetesClusterNodePool(ctx context.Context, drainer *kubernetes.NodeDrainer, poolsClient *containerservice.AgentPoolsClient, resourceGroup, clusterName, name string) error {
+	log.Printf("[INFO] Cordoning and draining the Nodes in Node Pool %q (Kubernetes Cluster %q / Resource Group %q)..", name, clusterName, resourceGroup)
+	result, err := drainer.DrainNodePool(ctx, name)
+	if err != nil {
+		return fmt.Errorf("draining Node Pool %q: %+v", name, err)
+	}
+	log.Printf("[INFO] Drained %d Nodes in Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %d Pods evicted, %d Pods skipped", len(result.Nodes), name, clusterName, resourceGroup, result.EvictedPods, result.SkippedPods)
+
+	log.Printf("[INFO] Deleting Node Pool %q (Kubernetes Cluster %q / Resource Group %q)..", name, clusterName, resourceGroup)
+	future, err := poolsClient.Delete(ctx, resourceGroup, clusterName, name)
+	if err != nil {
+		return fmt.Errorf("deleting Node Pool %q: %+v", name, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, poolsClient.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of Node Pool %q: %+v", name, err)
+	}
+
+	return nil
+}
+
+// kubernetesClusterNodeDrainer uses the Admin Credentials for the Kubernetes Cluster to access the Kubernetes API
+func kubernetesClusterNodeDrainer(ctx context.Context, containersClient *client.Client, resourceGroup, clusterName string) (*kubernetes.NodeDrainer, error) {
+	credentials, err := containersClient.KubernetesClustersClient.ListClusterAdminCredentials(ctx, resourceGroup, clusterName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Admin Credentials for Kubernetes Cluster %q (Resource Group %q) - local accounts must be enabled to use the `%s` replacement strategy: %+v", clusterName, resourceGroup, nodePoolReplacementStrategyCreateBeforeDestroyWithDrain, err)
+	}
+	if credentials.Kubeconfigs == nil || len(*credentials.Kubeconfigs) == 0 || (*credentials.Kubeconfigs)[0].Value == nil {
+		return nil, fmt.Errorf("retrieving Admin Credentials for Kubernetes Cluster %q (Resource Group %q): no kubeconfig was returned", clusterName, resourceGroup)
+	}
+
+	kubeConfig, err := kubernetes.ParseKubeConfig(string(*(*credentials.Kubeconfigs)[0].Value))
+	if err != nil {
+		return nil, fmt.Errorf("parsing Admin Credentials for Kubernetes Cluster %q (Resource Group %q): %+v", clusterName, resourceGroup, err)
+	}
+
+	return kubernetes.NewNodeDrainerFromKubeConfig(*kubeConfig)
+}
+
+// temporaryKubernetesClusterNodePoolName returns the name used for the temporary Node Pool, which
+// must fit within the 12 (Linux) or 6 (Windows) character limit for Node Pool names.
+func temporaryKubernetesClusterNodePoolName(name string, osType containerservice.OSType) string {
+	maxLength := 12
+	if osType == containerservice.OSTypeWindows {
+		maxLength = 6
+	}
+
+	var candidate string
+	for _, suffix := range []string{"tmp", "alt"} {
+		prefix := name
+		if len(prefix) > maxLength-len(suffix) {
+			prefix = prefix[:maxLength-len(suffix)]
+		}
+		candidate = prefix + suffix
+		if candidate != name {
+			break
+		}
+	}
+
+	return candidate
+}
+
+func isTemporaryKubernetesClusterNodePoolFor(pool containerservice.AgentPool, name string) bool {
+	if props := pool.ManagedClusterAgentPoolProfileProperties; props != nil {
+		if v, ok := props.Tags[nodePoolReplacementTag]; ok && v != nil {
+			return *v == name
+		}
+	}
+
+	return false
+}
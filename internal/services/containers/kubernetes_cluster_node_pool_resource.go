@@ -46,6 +46,10 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			0: migration.KubernetesClusterNodePoolV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			kubernetesClusterNodePoolReplacementCustomizeDiff,
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -73,7 +77,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"vm_size": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
@@ -81,7 +84,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"availability_zones": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
@@ -95,7 +97,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"enable_host_encryption": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
-				ForceNew: true,
 			},
 
 			"enable_node_public_ip": {
@@ -121,7 +122,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"fips_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
-				ForceNew: true,
 			},
 
 			"kubelet_disk_type": {
@@ -143,7 +143,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeInt,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 
 			"mode": {
@@ -166,7 +165,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"node_labels": {
 				Type:     pluginsdk.TypeMap,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
@@ -185,7 +183,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"node_taints": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
@@ -201,7 +198,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"os_disk_size_gb": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				ForceNew:     true,
 				Computed:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
@@ -209,7 +205,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"os_disk_type": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Default:  containerservice.OSDiskTypeManaged,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(containerservice.OSDiskTypeEphemeral),
@@ -220,7 +215,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"os_sku": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true, // defaults to Ubuntu if using Linux
 				ValidateFunc: validation.StringInSlice([]string{
 					string(containerservice.OSSKUUbuntu),
@@ -274,7 +268,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 
 			"ultra_ssd_enabled": {
 				Type:     pluginsdk.TypeBool,
-				ForceNew: true,
 				Default:  false,
 				Optional: true,
 			},
@@ -287,6 +280,14 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			},
 
 			"upgrade_settings": upgradeSettingsSchema(),
+
+			"replacement_strategy": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					nodePoolReplacementStrategyCreateBeforeDestroyWithDrain,
+				}, false),
+			},
 		},
 	}
 }
@@ -344,152 +345,20 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		return tf.ImportAsExistsError("azurerm_kubernetes_cluster_node_pool", *existing.ID)
 	}
 
-	count := d.Get("node_count").(int)
-	enableAutoScaling := d.Get("enable_auto_scaling").(bool)
-	evictionPolicy := d.Get("eviction_policy").(string)
-	mode := containerservice.AgentPoolMode(d.Get("mode").(string))
-	osType := d.Get("os_type").(string)
-	priority := d.Get("priority").(string)
-	spotMaxPrice := d.Get("spot_max_price").(float64)
-	t := d.Get("tags").(map[string]interface{})
-	vmSize := d.Get("vm_size").(string)
-	enableHostEncryption := d.Get("enable_host_encryption").(bool)
-
-	profile := containerservice.ManagedClusterAgentPoolProfileProperties{
-		OsType:                 containerservice.OSType(osType),
-		EnableAutoScaling:      utils.Bool(enableAutoScaling),
-		EnableFIPS:             utils.Bool(d.Get("fips_enabled").(bool)),
-		EnableUltraSSD:         utils.Bool(d.Get("ultra_ssd_enabled").(bool)),
-		EnableNodePublicIP:     utils.Bool(d.Get("enable_node_public_ip").(bool)),
-		KubeletDiskType:        containerservice.KubeletDiskType(d.Get("kubelet_disk_type").(string)),
-		Mode:                   mode,
-		ScaleSetPriority:       containerservice.ScaleSetPriority(priority),
-		Tags:                   tags.Expand(t),
-		Type:                   containerservice.AgentPoolTypeVirtualMachineScaleSets,
-		VMSize:                 utils.String(vmSize),
-		EnableEncryptionAtHost: utils.Bool(enableHostEncryption),
-		UpgradeSettings:        expandUpgradeSettings(d.Get("upgrade_settings").([]interface{})),
-
-		// this must always be sent during creation, but is optional for auto-scaled clusters during update
-		Count: utils.Int32(int32(count)),
-	}
-
-	if osSku := d.Get("os_sku").(string); osSku != "" {
-		profile.OsSKU = containerservice.OSSKU(osSku)
-	}
-
-	if priority == string(containerservice.ScaleSetPrioritySpot) {
-		profile.ScaleSetEvictionPolicy = containerservice.ScaleSetEvictionPolicy(evictionPolicy)
-		profile.SpotMaxPrice = utils.Float(spotMaxPrice)
-	} else {
-		if evictionPolicy != "" {
-			return fmt.Errorf("`eviction_policy` can only be set when `priority` is set to `Spot`")
-		}
-
-		if spotMaxPrice != -1.0 {
-			return fmt.Errorf("`spot_max_price` can only be set when `priority` is set to `Spot`")
-		}
+	profile, err := expandKubernetesClusterNodePoolProperties(d)
+	if err != nil {
+		return err
 	}
 
-	orchestratorVersion := d.Get("orchestrator_version").(string)
-	if orchestratorVersion != "" {
+	if orchestratorVersion := d.Get("orchestrator_version").(string); orchestratorVersion != "" {
 		if err := validateNodePoolSupportsVersion(ctx, containersClient, resourceGroup, clusterName, name, orchestratorVersion); err != nil {
 			return err
 		}
-
-		profile.OrchestratorVersion = utils.String(orchestratorVersion)
-	}
-
-	availabilityZonesRaw := d.Get("availability_zones").([]interface{})
-	if availabilityZones := utils.ExpandStringSlice(availabilityZonesRaw); len(*availabilityZones) > 0 {
-		profile.AvailabilityZones = availabilityZones
-	}
-
-	if maxPods := int32(d.Get("max_pods").(int)); maxPods > 0 {
-		profile.MaxPods = utils.Int32(maxPods)
-	}
-
-	nodeLabelsRaw := d.Get("node_labels").(map[string]interface{})
-	if nodeLabels := utils.ExpandMapStringPtrString(nodeLabelsRaw); len(nodeLabels) > 0 {
-		profile.NodeLabels = nodeLabels
-	}
-
-	if nodePublicIPPrefixID := d.Get("node_public_ip_prefix_id").(string); nodePublicIPPrefixID != "" {
-		profile.NodePublicIPPrefixID = utils.String(nodePublicIPPrefixID)
-	}
-
-	nodeTaintsRaw := d.Get("node_taints").([]interface{})
-	if nodeTaints := utils.ExpandStringSlice(nodeTaintsRaw); len(*nodeTaints) > 0 {
-		profile.NodeTaints = nodeTaints
-	}
-
-	if osDiskSizeGB := d.Get("os_disk_size_gb").(int); osDiskSizeGB > 0 {
-		profile.OsDiskSizeGB = utils.Int32(int32(osDiskSizeGB))
-	}
-
-	proximityPlacementGroupId := d.Get("proximity_placement_group_id").(string)
-	if proximityPlacementGroupId != "" {
-		profile.ProximityPlacementGroupID = &proximityPlacementGroupId
-	}
-
-	if osDiskType := d.Get("os_disk_type").(string); osDiskType != "" {
-		profile.OsDiskType = containerservice.OSDiskType(osDiskType)
-	}
-
-	if podSubnetID := d.Get("pod_subnet_id").(string); podSubnetID != "" {
-		profile.PodSubnetID = utils.String(podSubnetID)
-	}
-
-	if vnetSubnetID := d.Get("vnet_subnet_id").(string); vnetSubnetID != "" {
-		profile.VnetSubnetID = utils.String(vnetSubnetID)
-	}
-
-	maxCount := d.Get("max_count").(int)
-	minCount := d.Get("min_count").(int)
-
-	if enableAutoScaling {
-		// handle count being optional
-		if count == 0 {
-			profile.Count = utils.Int32(int32(minCount))
-		}
-
-		if maxCount >= 0 {
-			profile.MaxCount = utils.Int32(int32(maxCount))
-		} else {
-			return fmt.Errorf("`max_count` must be configured when `enable_auto_scaling` is set to `true`")
-		}
-
-		if minCount >= 0 {
-			profile.MinCount = utils.Int32(int32(minCount))
-		} else {
-			return fmt.Errorf("`min_count` must be configured when `enable_auto_scaling` is set to `true`")
-		}
-
-		if minCount > maxCount {
-			return fmt.Errorf("`max_count` must be >= `min_count`")
-		}
-	} else if minCount > 0 || maxCount > 0 {
-		return fmt.Errorf("`max_count` and `min_count` must be set to `null` when enable_auto_scaling is set to `false`")
-	}
-
-	if kubeletConfig := d.Get("kubelet_config").([]interface{}); len(kubeletConfig) > 0 {
-		profile.KubeletConfig = expandAgentPoolKubeletConfig(kubeletConfig)
-	}
-
-	if linuxOSConfig := d.Get("linux_os_config").([]interface{}); len(linuxOSConfig) > 0 {
-		if osType != string(containerservice.OSTypeLinux) {
-			return fmt.Errorf("`linux_os_config` can only be configured when `os_type` is set to `linux`")
-		}
-		linuxOSConfig, err := expandAgentPoolLinuxOSConfig(linuxOSConfig)
-		if err != nil {
-			return err
-		}
-		profile.LinuxOSConfig = linuxOSConfig
 	}
 
 	parameters := containerservice.AgentPool{
 		Name:                                     &name,
-		ManagedClusterAgentPoolProfileProperties: &profile,
+		ManagedClusterAgentPoolProfileProperties: profile,
 	}
 
 	future, err := poolsClient.CreateOrUpdate(ctx, resourceGroup, clusterName, name, parameters)
@@ -504,6 +373,13 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 	id := parse.NewNodePoolID(poolsClient.SubscriptionID, resourceGroup, clusterName, name)
 	d.SetId(id.ID())
 
+	// a replacement which failed after deleting the original Node Pool leaves the temporary Node Pool behind
+	if d.Get("replacement_strategy").(string) == nodePoolReplacementStrategyCreateBeforeDestroyWithDrain {
+		if err := removeTemporaryKubernetesClusterNodePool(ctx, containersClient, id, profile.OsType); err != nil {
+			return fmt.Errorf("removing the temporary Node Pool for %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+		}
+	}
+
 	return resourceKubernetesClusterNodePoolRead(d, meta)
 }
 
@@ -520,6 +396,17 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 
 	d.Partial(true)
 
+	// these fields can't be updated in-place, so when opted in we replace the Node Pool whilst keeping the ID
+	if d.Get("replacement_strategy").(string) == nodePoolReplacementStrategyCreateBeforeDestroyWithDrain && d.HasChanges(nodePoolReplacementFields...) {
+		if err := replaceKubernetesClusterNodePool(ctx, d, containersClient, *id); err != nil {
+			return err
+		}
+
+		d.Partial(false)
+
+		return resourceKubernetesClusterNodePoolRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Retrieving existing Node Pool %q (Kubernetes Cluster %q / Resource Group %q)..", id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup)
 	existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName)
 	if err != nil {
@@ -828,6 +715,148 @@ func resourceKubernetesClusterNodePoolDelete(d *pluginsdk.ResourceData, meta int
 	return nil
 }
 
+func expandKubernetesClusterNodePoolProperties(d *pluginsdk.ResourceData) (*containerservice.ManagedClusterAgentPoolProfileProperties, error) {
+	count := d.Get("node_count").(int)
+	enableAutoScaling := d.Get("enable_auto_scaling").(bool)
+	evictionPolicy := d.Get("eviction_policy").(string)
+	mode := containerservice.AgentPoolMode(d.Get("mode").(string))
+	osType := d.Get("os_type").(string)
+	priority := d.Get("priority").(string)
+	spotMaxPrice := d.Get("spot_max_price").(float64)
+	t := d.Get("tags").(map[string]interface{})
+	vmSize := d.Get("vm_size").(string)
+	enableHostEncryption := d.Get("enable_host_encryption").(bool)
+
+	profile := containerservice.ManagedClusterAgentPoolProfileProperties{
+		OsType:                 containerservice.OSType(osType),
+		EnableAutoScaling:      utils.Bool(enableAutoScaling),
+		EnableFIPS:             utils.Bool(d.Get("fips_enabled").(bool)),
+		EnableUltraSSD:         utils.Bool(d.Get("ultra_ssd_enabled").(bool)),
+		EnableNodePublicIP:     utils.Bool(d.Get("enable_node_public_ip").(bool)),
+		KubeletDiskType:        containerservice.KubeletDiskType(d.Get("kubelet_disk_type").(string)),
+		Mode:                   mode,
+		ScaleSetPriority:       containerservice.ScaleSetPriority(priority),
+		Tags:                   tags.Expand(t),
+		Type:                   containerservice.AgentPoolTypeVirtualMachineScaleSets,
+		VMSize:                 utils.String(vmSize),
+		EnableEncryptionAtHost: utils.Bool(enableHostEncryption),
+		UpgradeSettings:        expandUpgradeSettings(d.Get("upgrade_settings").([]interface{})),
+
+		// this must always be sent during creation, but is optional for auto-scaled clusters during update
+		Count: utils.Int32(int32(count)),
+	}
+
+	if osSku := d.Get("os_sku").(string); osSku != "" {
+		profile.OsSKU = containerservice.OSSKU(osSku)
+	}
+
+	if priority == string(containerservice.ScaleSetPrioritySpot) {
+		profile.ScaleSetEvictionPolicy = containerservice.ScaleSetEvictionPolicy(evictionPolicy)
+		profile.SpotMaxPrice = utils.Float(spotMaxPrice)
+	} else {
+		if evictionPolicy != "" {
+			return nil, fmt.Errorf("`eviction_policy` can only be set when `priority` is set to `Spot`")
+		}
+
+		if spotMaxPrice != -1.0 {
+			return nil, fmt.Errorf("`spot_max_price` can only be set when `priority` is set to `Spot`")
+		}
+	}
+
+	if orchestratorVersion := d.Get("orchestrator_version").(string); orchestratorVersion != "" {
+		profile.OrchestratorVersion = utils.String(orchestratorVersion)
+	}
+
+	availabilityZonesRaw := d.Get("availability_zones").([]interface{})
+	if availabilityZones := utils.ExpandStringSlice(availabilityZonesRaw); len(*availabilityZones) > 0 {
+		profile.AvailabilityZones = availabilityZones
+	}
+
+	if maxPods := int32(d.Get("max_pods").(int)); maxPods > 0 {
+		profile.MaxPods = utils.Int32(maxPods)
+	}
+
+	nodeLabelsRaw := d.Get("node_labels").(map[string]interface{})
+	if nodeLabels := utils.ExpandMapStringPtrString(nodeLabelsRaw); len(nodeLabels) > 0 {
+		profile.NodeLabels = nodeLabels
+	}
+
+	if nodePublicIPPrefixID := d.Get("node_public_ip_prefix_id").(string); nodePublicIPPrefixID != "" {
+		profile.NodePublicIPPrefixID = utils.String(nodePublicIPPrefixID)
+	}
+
+	nodeTaintsRaw := d.Get("node_taints").([]interface{})
+	if nodeTaints := utils.ExpandStringSlice(nodeTaintsRaw); len(*nodeTaints) > 0 {
+		profile.NodeTaints = nodeTaints
+	}
+
+	if osDiskSizeGB := d.Get("os_disk_size_gb").(int); osDiskSizeGB > 0 {
+		profile.OsDiskSizeGB = utils.Int32(int32(osDiskSizeGB))
+	}
+
+	proximityPlacementGroupId := d.Get("proximity_placement_group_id").(string)
+	if proximityPlacementGroupId != "" {
+		profile.ProximityPlacementGroupID = &proximityPlacementGroupId
+	}
+
+	if osDiskType := d.Get("os_disk_type").(string); osDiskType != "" {
+		profile.OsDiskType = containerservice.OSDiskType(osDiskType)
+	}
+
+	if podSubnetID := d.Get("pod_subnet_id").(string); podSubnetID != "" {
+		profile.PodSubnetID = utils.String(podSubnetID)
+	}
+
+	if vnetSubnetID := d.Get("vnet_subnet_id").(string); vnetSubnetID != "" {
+		profile.VnetSubnetID = utils.String(vnetSubnetID)
+	}
+
+	maxCount := d.Get("max_count").(int)
+	minCount := d.Get("min_count").(int)
+
+	if enableAutoScaling {
+		// handle count being optional
+		if count == 0 {
+			profile.Count = utils.Int32(int32(minCount))
+		}
+
+		if maxCount >= 0 {
+			profile.MaxCount = utils.Int32(int32(maxCount))
+		} else {
+			return nil, fmt.Errorf("`max_count` must be configured when `enable_auto_scaling` is set to `true`")
+		}
+
+		if minCount >= 0 {
+			profile.MinCount = utils.Int32(int32(minCount))
+		} else {
+			return nil, fmt.Errorf("`min_count` must be configured when `enable_auto_scaling` is set to `true`")
+		}
+
+		if minCount > maxCount {
+			return nil, fmt.Errorf("`max_count` must be >= `min_count`")
+		}
+	} else if minCount > 0 || maxCount > 0 {
+		return nil, fmt.Errorf("`max_count` and `min_count` must be set to `null` when enable_auto_scaling is set to `false`")
+	}
+
+	if kubeletConfig := d.Get("kubelet_config").([]interface{}); len(kubeletConfig) > 0 {
+		profile.KubeletConfig = expandAgentPoolKubeletConfig(kubeletConfig)
+	}
+
+	if linuxOSConfig := d.Get("linux_os_config").([]interface{}); len(linuxOSConfig) > 0 {
+		if osType != string(containerservice.OSTypeLinux) {
+			return nil, fmt.Errorf("`linux_os_config` can only be configured when `os_type` is set to `linux`")
+		}
+		linuxOSConfig, err := expandAgentPoolLinuxOSConfig(linuxOSConfig)
+		if err != nil {
+			return nil, err
+		}
+		profile.LinuxOSConfig = linuxOSConfig
+	}
+
+	return &profile, nil
+}
+
 func upgradeSettingsSchema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
@@ -745,6 +745,30 @@ func TestAccKubernetesClusterNodePool_turnOnEnableAutoScalingWithDefaultMaxMinCo
 	})
 }
 
+func TestAccKubernetesClusterNodePool_replacementStrategyCreateBeforeDestroyWithDrain(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.replacementStrategyConfig(data, "Standard_DS2_v2", "blue"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("replacement_strategy"),
+		{
+			Config: r.replacementStrategyConfig(data, "Standard_DS3_v2", "green"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vm_size").HasValue("Standard_DS3_v2"),
+				check.That(data.ResourceName).Key("node_labels.colour").HasValue("green"),
+			),
+		},
+		data.ImportStep("replacement_strategy"),
+	})
+}
+
 func (t KubernetesClusterNodePoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.NodePoolID(state.ID)
 	if err != nil {
@@ -1428,6 +1452,28 @@ resource "azurerm_kubernetes_cluster_node_pool" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (r KubernetesClusterNodePoolResource) replacementStrategyConfig(data acceptance.TestData, vmSize, colour string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  vm_size               = "%s"
+  node_count            = 1
+  replacement_strategy  = "create_before_destroy_with_drain"
+
+  node_labels = {
+    colour = "%s"
+  }
+}
+`, r.templateConfig(data), vmSize, colour)
+}
+
 func (r KubernetesClusterNodePoolResource) requiresImportConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -49,6 +49,7 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("service_principal.0.client_id", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old == "msi" || old == ""
 			}),
+			kubernetesClusterKubeLoginCustomizeDiff,
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -917,6 +918,66 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 
+			"kube_config_exec": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"host": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"cluster_ca_certificate": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"api_version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"command": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"args": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"kube_config_exec_raw": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"kubelogin": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"login_mode": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(kubernetes.LoginModeDeviceCode),
+							ValidateFunc: validation.StringInSlice(kubernetes.PossibleValuesForLoginMode(), false),
+						},
+						"client_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+
 			"http_proxy_config": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -1796,6 +1857,16 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("setting `kube_config`: %+v", err)
 	}
 
+	execOptions := expandKubernetesClusterKubeLogin(d.Get("kubelogin").([]interface{}), meta.(*clients.Client).Containers.Environment.Name)
+	kubeConfigExecRaw, kubeConfigExec, err := flattenKubernetesClusterKubeConfigExec(profile, execOptions)
+	if err != nil {
+		return fmt.Errorf("building `kube_config_exec`: %+v", err)
+	}
+	d.Set("kube_config_exec_raw", kubeConfigExecRaw)
+	if err := d.Set("kube_config_exec", kubeConfigExec); err != nil {
+		return fmt.Errorf("setting `kube_config_exec`: %+v", err)
+	}
+
 	maintenanceConfigurationsClient := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
 	configResp, _ := maintenanceConfigurationsClient.Get(ctx, id.ResourceGroup, id.ManagedClusterName, "default")
 	if props := configResp.MaintenanceConfigurationProperties; props != nil {
@@ -2518,6 +2589,88 @@ func flattenKubernetesClusterKubeConfigAAD(config kubernetes.KubeConfigAAD) []in
 	}
 }
 
+// kubernetesClusterKubeLoginCustomizeDiff validates the `kubelogin` block and marks `kube_config_exec` as
+// changing when it's updated, since the exec configuration is generated from it rather than returned by the API.
+func kubernetesClusterKubeLoginCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if raw := d.Get("kubelogin").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		v := raw[0].(map[string]interface{})
+		if v["login_mode"].(string) == string(kubernetes.LoginModeServicePrincipal) && v["client_id"].(string) == "" {
+			return fmt.Errorf("`kubelogin.0.client_id` must be specified when `kubelogin.0.login_mode` is `%s`", string(kubernetes.LoginModeServicePrincipal))
+		}
+	}
+
+	if d.Id() != "" && d.HasChange("kubelogin") {
+		if err := d.SetNewComputed("kube_config_exec"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("kube_config_exec_raw")
+	}
+
+	return nil
+}
+
+func expandKubernetesClusterKubeLogin(input []interface{}, environmentName string) kubernetes.ExecOptions {
+	options := kubernetes.ExecOptions{
+		LoginMode:   kubernetes.LoginModeDeviceCode,
+		Environment: environmentName,
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return options
+	}
+
+	v := input[0].(map[string]interface{})
+	if loginMode := v["login_mode"].(string); loginMode != "" {
+		options.LoginMode = kubernetes.LoginMode(loginMode)
+	}
+	options.ClientID = v["client_id"].(string)
+
+	return options
+}
+
+// flattenKubernetesClusterKubeConfigExec returns the kubeconfig using the kubelogin exec credential plugin,
+// which is only available for clusters using Azure Active Directory integration.
+func flattenKubernetesClusterKubeConfigExec(profile containerservice.ManagedClusterAccessProfile, options kubernetes.ExecOptions) (*string, []interface{}, error) {
+	accessProfile := profile.AccessProfile
+	if accessProfile == nil || accessProfile.KubeConfig == nil {
+		return nil, []interface{}{}, nil
+	}
+
+	rawConfig := string(*accessProfile.KubeConfig)
+	if !strings.Contains(rawConfig, "apiserver-id:") {
+		return nil, []interface{}{}, nil
+	}
+
+	kubeConfigAAD, err := kubernetes.ParseKubeConfigAAD(rawConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing kubeconfig for kube_config_exec: %+v", err)
+	}
+
+	kubeConfigExec, err := kubernetes.ConvertKubeConfigAADToExec(*kubeConfigAAD, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kubeConfigExecRaw, err := kubeConfigExec.Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// we don't size-check these since they're validated in the Parse method
+	cluster := kubeConfigExec.Clusters[0].Cluster
+	exec := kubeConfigExec.Users[0].User.Exec
+
+	return utils.String(kubeConfigExecRaw), []interface{}{
+		map[string]interface{}{
+			"host":                   cluster.Server,
+			"cluster_ca_certificate": cluster.ClusterAuthorityData,
+			"api_version":            exec.APIVersion,
+			"command":                exec.Command,
+			"args":                   utils.FlattenStringSlice(&exec.Args),
+		},
+	}, nil
+}
+
 func flattenKubernetesClusterManagedClusterIdentity(input *containerservice.ManagedClusterIdentity) ([]interface{}, error) {
 	// if it's none, omit the block
 	if input == nil || input.Type == containerservice.ResourceIdentityTypeNone {
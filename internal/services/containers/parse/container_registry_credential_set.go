@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ContainerRegistryCredentialSetId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	RegistryName      string
+	CredentialSetName string
+}
+
+func NewContainerRegistryCredentialSetID(subscriptionId, resourceGroup, registryName, credentialSetName string) ContainerRegistryCredentialSetId {
+	return ContainerRegistryCredentialSetId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		RegistryName:      registryName,
+		CredentialSetName: credentialSetName,
+	}
+}
+
+func (id ContainerRegistryCredentialSetId) String() string {
+	segments := []string{
+		fmt.Sprintf("Credential Set Name %q", id.CredentialSetName),
+		fmt.Sprintf("Registry Name %q", id.RegistryName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Container Registry Credential Set", segmentsStr)
+}
+
+func (id ContainerRegistryCredentialSetId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerRegistry/registries/%s/credentialSets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.CredentialSetName)
+}
+
+// ContainerRegistryCredentialSetID parses a ContainerRegistryCredentialSet ID into an ContainerRegistryCredentialSetId struct
+func ContainerRegistryCredentialSetID(input string) (*ContainerRegistryCredentialSetId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ContainerRegistryCredentialSetId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.RegistryName, err = id.PopSegment("registries"); err != nil {
+		return nil, err
+	}
+	if resourceId.CredentialSetName, err = id.PopSegment("credentialSets"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
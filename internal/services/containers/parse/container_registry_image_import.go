@@ -0,0 +1,57 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ContainerRegistryImageImportId represents an image which has been imported into a Container Registry,
+// since there's no Resource ID for this within Azure this is a composite of the Container Registry ID
+// and the reference of the source image (which includes the digest when one was specified)
+type ContainerRegistryImageImportId struct {
+	Registry    RegistryId
+	SourceImage string
+}
+
+func NewContainerRegistryImageImportID(registry RegistryId, sourceImage string) ContainerRegistryImageImportId {
+	return ContainerRegistryImageImportId{
+		Registry:    registry,
+		SourceImage: sourceImage,
+	}
+}
+
+func (id ContainerRegistryImageImportId) String() string {
+	segments := []string{
+		fmt.Sprintf("Source Image %q", id.SourceImage),
+		fmt.Sprintf("Registry Name %q", id.Registry.Name),
+		fmt.Sprintf("Resource Group %q", id.Registry.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Container Registry Image Import", segmentsStr)
+}
+
+func (id ContainerRegistryImageImportId) ID() string {
+	return fmt.Sprintf("%s|%s", id.Registry.ID(), id.SourceImage)
+}
+
+// ContainerRegistryImageImportID parses a ContainerRegistryImageImport ID into an ContainerRegistryImageImportId struct
+func ContainerRegistryImageImportID(input string) (*ContainerRegistryImageImportId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format `{containerRegistryID}|{sourceImage}` but got %q", input)
+	}
+
+	registryId, err := RegistryID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Container Registry ID %q: %+v", segments[0], err)
+	}
+
+	if segments[1] == "" {
+		return nil, fmt.Errorf("the Source Image was empty in %q", input)
+	}
+
+	return &ContainerRegistryImageImportId{
+		Registry:    *registryId,
+		SourceImage: segments[1],
+	}, nil
+}
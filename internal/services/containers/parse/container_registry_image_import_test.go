@@ -0,0 +1,91 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = ContainerRegistryImageImportId{}
+
+func TestContainerRegistryImageImportIDFormatter(t *testing.T) {
+	registryId := NewRegistryID("12345678-1234-9876-4563-123456789012", "group1", "registry1")
+	actual := NewContainerRegistryImageImportID(registryId, "library/hello-world@sha256:2498fce14358aa50ead0cc6c19990fc6ff866ce72aeb5546e1d59caac3d0d60f").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1|library/hello-world@sha256:2498fce14358aa50ead0cc6c19990fc6ff866ce72aeb5546e1d59caac3d0d60f"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestContainerRegistryImageImportID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ContainerRegistryImageImportId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// registry id only
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1",
+			Error: true,
+		},
+		{
+			// missing source image
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1|",
+			Error: true,
+		},
+		{
+			// invalid registry id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1|library/hello-world:latest",
+			Error: true,
+		},
+		{
+			// too many segments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1|library/hello-world:latest|extra",
+			Error: true,
+		},
+		{
+			// tag
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1|library/hello-world:latest",
+			Expected: &ContainerRegistryImageImportId{
+				Registry:    NewRegistryID("12345678-1234-9876-4563-123456789012", "group1", "registry1"),
+				SourceImage: "library/hello-world:latest",
+			},
+		},
+		{
+			// digest
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1|hello-world@sha256:2498fce14358aa50ead0cc6c19990fc6ff866ce72aeb5546e1d59caac3d0d60f",
+			Expected: &ContainerRegistryImageImportId{
+				Registry:    NewRegistryID("12345678-1234-9876-4563-123456789012", "group1", "registry1"),
+				SourceImage: "hello-world@sha256:2498fce14358aa50ead0cc6c19990fc6ff866ce72aeb5546e1d59caac3d0d60f",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ContainerRegistryImageImportID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Registry != v.Expected.Registry {
+			t.Fatalf("Expected %+v but got %+v for Registry", v.Expected.Registry, actual.Registry)
+		}
+		if actual.SourceImage != v.Expected.SourceImage {
+			t.Fatalf("Expected %q but got %q for SourceImage", v.Expected.SourceImage, actual.SourceImage)
+		}
+	}
+}
@@ -34,13 +34,14 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_container_group":              resourceContainerGroup(),
-		"azurerm_container_registry_webhook":   resourceContainerRegistryWebhook(),
-		"azurerm_container_registry":           resourceContainerRegistry(),
-		"azurerm_container_registry_token":     resourceContainerRegistryToken(),
-		"azurerm_container_registry_scope_map": resourceContainerRegistryScopeMap(),
-		"azurerm_kubernetes_cluster":           resourceKubernetesCluster(),
-		"azurerm_kubernetes_cluster_node_pool": resourceKubernetesClusterNodePool(),
+		"azurerm_container_group":                              resourceContainerGroup(),
+		"azurerm_container_registry_webhook":                   resourceContainerRegistryWebhook(),
+		"azurerm_container_registry":                           resourceContainerRegistry(),
+		"azurerm_container_registry_token":                     resourceContainerRegistryToken(),
+		"azurerm_container_registry_scope_map":                 resourceContainerRegistryScopeMap(),
+		"azurerm_kubernetes_cluster":                           resourceKubernetesCluster(),
+		"azurerm_kubernetes_cluster_node_pool":                 resourceKubernetesClusterNodePool(),
+		"azurerm_kubernetes_cluster_maintenance_configuration": resourceKubernetesClusterMaintenanceConfiguration(),
 	}
 }
 
@@ -50,6 +51,12 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ContainerConnectedRegistryResource{},
+		ContainerRegistryAgentPoolResource{},
+		ContainerRegistryCacheRuleResource{},
+		ContainerRegistryCredentialSetResource{},
+		ContainerRegistryImageImportResource{},
 		ContainerRegistryTaskResource{},
+		ContainerRegistryTaskScheduleRunNowResource{},
 	}
 }
@@ -0,0 +1,6 @@
+package maintenanceconfigurations
+
+type AbsoluteMonthlySchedule struct {
+	DayOfMonth     int64 `json:"dayOfMonth"`
+	IntervalMonths int64 `json:"intervalMonths"`
+}
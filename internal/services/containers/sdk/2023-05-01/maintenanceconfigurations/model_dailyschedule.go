@@ -0,0 +1,5 @@
+package maintenanceconfigurations
+
+type DailySchedule struct {
+	IntervalDays int64 `json:"intervalDays"`
+}
@@ -0,0 +1,10 @@
+package maintenanceconfigurations
+
+type MaintenanceWindow struct {
+	DurationHours   int64       `json:"durationHours"`
+	NotAllowedDates *[]DateSpan `json:"notAllowedDates,omitempty"`
+	Schedule        Schedule    `json:"schedule"`
+	StartDate       *string     `json:"startDate,omitempty"`
+	StartTime       string      `json:"startTime"`
+	UtcOffset       *string     `json:"utcOffset,omitempty"`
+}
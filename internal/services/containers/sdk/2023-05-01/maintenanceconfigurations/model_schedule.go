@@ -0,0 +1,8 @@
+package maintenanceconfigurations
+
+type Schedule struct {
+	AbsoluteMonthly *AbsoluteMonthlySchedule `json:"absoluteMonthly,omitempty"`
+	Daily           *DailySchedule           `json:"daily,omitempty"`
+	RelativeMonthly *RelativeMonthlySchedule `json:"relativeMonthly,omitempty"`
+	Weekly          *WeeklySchedule          `json:"weekly,omitempty"`
+}
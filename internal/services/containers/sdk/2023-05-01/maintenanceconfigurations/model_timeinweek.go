@@ -0,0 +1,6 @@
+package maintenanceconfigurations
+
+type TimeInWeek struct {
+	Day       *WeekDay `json:"day,omitempty"`
+	HourSlots *[]int64 `json:"hourSlots,omitempty"`
+}
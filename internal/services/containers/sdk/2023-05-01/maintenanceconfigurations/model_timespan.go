@@ -0,0 +1,6 @@
+package maintenanceconfigurations
+
+type TimeSpan struct {
+	End   *string `json:"end,omitempty"`
+	Start *string `json:"start,omitempty"`
+}
@@ -0,0 +1,6 @@
+package maintenanceconfigurations
+
+type WeeklySchedule struct {
+	DayOfWeek     WeekDay `json:"dayOfWeek"`
+	IntervalWeeks int64   `json:"intervalWeeks"`
+}
@@ -0,0 +1,96 @@
+package credentialsets
+
+import "strings"
+
+type CredentialHealthStatus string
+
+const (
+	CredentialHealthStatusHealthy   CredentialHealthStatus = "Healthy"
+	CredentialHealthStatusUnhealthy CredentialHealthStatus = "Unhealthy"
+)
+
+func PossibleValuesForCredentialHealthStatus() []string {
+	return []string{
+		string(CredentialHealthStatusHealthy),
+		string(CredentialHealthStatusUnhealthy),
+	}
+}
+
+func parseCredentialHealthStatus(input string) (*CredentialHealthStatus, error) {
+	vals := map[string]CredentialHealthStatus{
+		"healthy":   CredentialHealthStatusHealthy,
+		"unhealthy": CredentialHealthStatusUnhealthy,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CredentialHealthStatus(input)
+	return &out, nil
+}
+
+type CredentialName string
+
+const (
+	CredentialNameCredentialOne CredentialName = "Credential1"
+)
+
+func PossibleValuesForCredentialName() []string {
+	return []string{
+		string(CredentialNameCredentialOne),
+	}
+}
+
+func parseCredentialName(input string) (*CredentialName, error) {
+	vals := map[string]CredentialName{
+		"credential1": CredentialNameCredentialOne,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CredentialName(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,8 @@
+package credentialsets
+
+type AuthCredential struct {
+	CredentialHealth         *CredentialHealth `json:"credentialHealth,omitempty"`
+	Name                     *CredentialName   `json:"name,omitempty"`
+	PasswordSecretIdentifier *string           `json:"passwordSecretIdentifier,omitempty"`
+	UsernameSecretIdentifier *string           `json:"usernameSecretIdentifier,omitempty"`
+}
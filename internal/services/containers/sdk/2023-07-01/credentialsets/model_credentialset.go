@@ -0,0 +1,13 @@
+package credentialsets
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type CredentialSet struct {
+	Id         *string                  `json:"id,omitempty"`
+	Identity   *identity.SystemAssigned `json:"identity,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *CredentialSetProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package credentialsets
+
+import "fmt"
+
+const defaultApiVersion = "2023-07-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/credentialsets/%s", defaultApiVersion)
+}
@@ -0,0 +1,12 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func ContainerConnectedRegistryName(v interface{}, k string) (warnings []string, errors []error) {
+	return validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]{5,50}$`), fmt.Sprintf("only alpha numeric characters in length of 5 to 50 are allowed in %q", k))(v, k)
+}
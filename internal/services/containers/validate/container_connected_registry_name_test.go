@@ -0,0 +1,47 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerConnectedRegistryName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "four",
+			ErrCount: 1,
+		},
+		{
+			Value:    "edge1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "EdgeRegistry01",
+			ErrCount: 0,
+		},
+		{
+			Value:    "edge-registry",
+			ErrCount: 1,
+		},
+		{
+			Value:    "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			ErrCount: 0,
+		},
+		{
+			Value:    "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerConnectedRegistryName(tc.Value, "azurerm_container_connected_registry")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %q to trigger %d validation errors but got: %v", tc.Value, tc.ErrCount, errors)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+)
+
+// ContainerConnectedRegistryParentID validates that the parent of a Connected Registry is either a Container Registry or another Connected Registry
+func ContainerConnectedRegistryParentID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := parse.ContainerConnectedRegistryID(v); err == nil {
+		return
+	}
+
+	if _, err := parse.RegistryID(v); err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be either a Container Registry ID or a Connected Registry ID: %+v", key, err))
+	}
+
+	return
+}
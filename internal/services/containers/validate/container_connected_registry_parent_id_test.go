@@ -0,0 +1,43 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerConnectedRegistryParentID(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+			ErrCount: 1,
+		},
+		{
+			Value:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerRegistry/registries/registry1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerRegistry/registries/registry1/connectedRegistries/connectedRegistry1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerRegistry/registries/registry1/scopeMaps/scopeMap1",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerConnectedRegistryParentID(tc.Value, "parent_registry_id")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %q to trigger %d validation errors but got: %v", tc.Value, tc.ErrCount, errors)
+		}
+	}
+}
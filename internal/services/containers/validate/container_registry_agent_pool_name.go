@@ -0,0 +1,12 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func ContainerRegistryAgentPoolName(v interface{}, k string) (warnings []string, errors []error) {
+	return validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]{3,20}$`), fmt.Sprintf("only alpha numeric characters and hyphens in length of 3 to 20 are allowed in %q", k))(v, k)
+}
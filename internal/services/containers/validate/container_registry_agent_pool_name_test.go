@@ -0,0 +1,47 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerRegistryAgentPoolName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "ab",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abc",
+			ErrCount: 0,
+		},
+		{
+			Value:    "agent-pool-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "agent_pool",
+			ErrCount: 1,
+		},
+		{
+			Value:    "aaaaaaaaaaaaaaaaaaaa",
+			ErrCount: 0,
+		},
+		{
+			Value:    "aaaaaaaaaaaaaaaaaaaaa",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryAgentPoolName(tc.Value, "azurerm_container_registry_agent_pool")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %q to trigger %d validation errors but got: %v", tc.Value, tc.ErrCount, errors)
+		}
+	}
+}
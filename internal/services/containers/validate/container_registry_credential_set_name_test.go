@@ -0,0 +1,47 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerRegistryCredentialSetName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "four",
+			ErrCount: 1,
+		},
+		{
+			Value:    "dockerhub",
+			ErrCount: 0,
+		},
+		{
+			Value:    "docker-hub-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-hello",
+			ErrCount: 1,
+		},
+		{
+			Value:    "docker.hub",
+			ErrCount: 1,
+		},
+		{
+			Value:    "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryCredentialSetName(tc.Value, "azurerm_container_registry_credential_set")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %q to trigger %d validation errors but got: %v", tc.Value, tc.ErrCount, errors)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package validate
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 func KubernetesAdminUserName(i interface{}, k string) (warnings []string, errors []error) {
@@ -37,3 +38,22 @@ func KubernetesDNSPrefix(i interface{}, k string) (warnings []string, errors []e
 
 	return warnings, errors
 }
+
+func KubernetesMaintenanceConfigurationName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	if strings.EqualFold(v, "default") {
+		errors = append(errors, fmt.Errorf("%s cannot be %q - the `default` Maintenance Configuration is managed by the `maintenance_window` block of the `azurerm_kubernetes_cluster` resource", k, v))
+		return warnings, errors
+	}
+
+	if v != "aksManagedAutoUpgradeSchedule" && v != "aksManagedNodeOSUpgradeSchedule" {
+		errors = append(errors, fmt.Errorf("%s must be one of `aksManagedAutoUpgradeSchedule` or `aksManagedNodeOSUpgradeSchedule`. Got %q.", k, v))
+	}
+
+	return warnings, errors
+}
@@ -133,3 +133,45 @@ func TestKubernetesDNSPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestKubernetesMaintenanceConfigurationName(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Errors int
+	}{
+		{
+			Name:   "",
+			Errors: 1,
+		},
+		{
+			Name:   "default",
+			Errors: 1,
+		},
+		{
+			Name:   "Default",
+			Errors: 1,
+		},
+		{
+			Name:   "aksManagedAutoUpgradeSchedule",
+			Errors: 0,
+		},
+		{
+			Name:   "aksManagedNodeOSUpgradeSchedule",
+			Errors: 0,
+		},
+		{
+			Name:   "someOtherSchedule",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := KubernetesMaintenanceConfigurationName(tc.Name, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected MaintenanceConfigurationName to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
@@ -0,0 +1,763 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	dnsZoneRecordsModeAdditive      = "Additive"
+	dnsZoneRecordsModeAuthoritative = "Authoritative"
+
+	// dnsZoneRecordsDefaultTTL is used for records in a Zone File which don't specify a TTL (and where there's no $TTL)
+	dnsZoneRecordsDefaultTTL = 3600
+)
+
+func resourceDnsZoneRecords() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDnsZoneRecordsCreate,
+		Read:   resourceDnsZoneRecordsRead,
+		Update: resourceDnsZoneRecordsUpdate,
+		Delete: resourceDnsZoneRecordsDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DnsZoneRecordsID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"zone_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  dnsZoneRecordsModeAdditive,
+				ValidateFunc: validation.StringInSlice([]string{
+					dnsZoneRecordsModeAdditive,
+					dnsZoneRecordsModeAuthoritative,
+				}, false),
+			},
+
+			"zone_file": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"zone_file", "record_set"},
+			},
+
+			// when `zone_file` is specified this is computed from the parsed Zone File, so that changes are shown per record set
+			"record_set": {
+				Type:         pluginsdk.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"zone_file", "record_set"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(dns.A),
+								string(dns.AAAA),
+								string(dns.CAA),
+								string(dns.CNAME),
+								string(dns.MX),
+								string(dns.NS),
+								string(dns.PTR),
+								string(dns.SRV),
+								string(dns.TXT),
+							}, false),
+						},
+
+						"ttl": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 2147483647),
+						},
+
+						"records": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceDnsZoneRecordsCustomizeDiff),
+	}
+}
+
+func resourceDnsZoneRecordsCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	zonesClient := meta.(*clients.Client).Dns.ZonesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDnsZoneRecordsID(subscriptionId, d.Get("resource_group_name").(string), d.Get("zone_name").(string), "default")
+
+	zone, err := zonesClient.Get(ctx, id.ResourceGroup, id.DnszoneName)
+	if err != nil {
+		if utils.ResponseWasNotFound(zone.Response) {
+			return fmt.Errorf("DNS Zone %q (Resource Group %q) was not found", id.DnszoneName, id.ResourceGroup)
+		}
+		return fmt.Errorf("retrieving DNS Zone %q (Resource Group %q): %+v", id.DnszoneName, id.ResourceGroup, err)
+	}
+
+	desired := expandDnsZoneRecordSets(d.Get("record_set").(*pluginsdk.Set).List())
+	mode := d.Get("mode").(string)
+
+	// in Authoritative mode every record set in the zone is managed, however in Additive mode any existing record
+	// sets need to be imported, as with the other DNS Record resources
+	if mode == dnsZoneRecordsModeAdditive {
+		existing, aliases, err := listDnsZoneRecordSets(ctx, meta, id)
+		if err != nil {
+			return err
+		}
+		for _, v := range desired {
+			key := dnsZoneRecordSetKey(v.Name, v.Type)
+			if _, ok := existing[key]; ok {
+				return tf.ImportAsExistsError("azurerm_dns_zone_records", id.ID())
+			}
+			if _, ok := aliases[key]; ok {
+				return tf.ImportAsExistsError("azurerm_dns_zone_records", id.ID())
+			}
+		}
+	}
+
+	if err := reconcileDnsZoneRecordSets(ctx, meta, id, mode, desired, nil); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDnsZoneRecordsRead(d, meta)
+}
+
+func resourceDnsZoneRecordsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	zonesClient := meta.(*clients.Client).Dns.ZonesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsZoneRecordsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	zone, err := zonesClient.Get(ctx, id.ResourceGroup, id.DnszoneName)
+	if err != nil {
+		if utils.ResponseWasNotFound(zone.Response) {
+			log.Printf("[DEBUG] DNS Zone %q (Resource Group %q) was not found - removing %s from state", id.DnszoneName, id.ResourceGroup, id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving DNS Zone %q (Resource Group %q): %+v", id.DnszoneName, id.ResourceGroup, err)
+	}
+
+	existing, _, err := listDnsZoneRecordSets(ctx, meta, *id)
+	if err != nil {
+		return err
+	}
+
+	// record set names are case-insensitive, so the casing from the configuration is retained
+	previous := expandDnsZoneRecordSets(d.Get("record_set").(*pluginsdk.Set).List())
+	configuredNames := make(map[string]string)
+	for _, v := range previous {
+		configuredNames[dnsZoneRecordSetKey(v.Name, v.Type)] = v.Name
+	}
+
+	mode := d.Get("mode").(string)
+	if mode == "" {
+		// e.g. when importing, where only the record sets defined in the configuration will be managed
+		mode = dnsZoneRecordsModeAdditive
+	}
+
+	recordSets := make([]zonefile.RecordSet, 0)
+	if mode == dnsZoneRecordsModeAuthoritative {
+		for key, v := range existing {
+			if isDnsZoneRecordSetManagedByAzure(v.Name, v.Type) {
+				continue
+			}
+			if name, ok := configuredNames[key]; ok {
+				v.Name = name
+			}
+			recordSets = append(recordSets, v)
+		}
+	} else {
+		// only the record sets previously created by this resource are managed when in Additive mode
+		for _, v := range previous {
+			if recordSet, ok := existing[dnsZoneRecordSetKey(v.Name, v.Type)]; ok {
+				recordSet.Name = v.Name
+				recordSets = append(recordSets, recordSet)
+			}
+		}
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("zone_name", id.DnszoneName)
+	d.Set("mode", mode)
+
+	if err := d.Set("record_set", flattenDnsZoneRecordSets(recordSets)); err != nil {
+		return fmt.Errorf("setting `record_set`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceDnsZoneRecordsUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsZoneRecordsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	oldRaw, newRaw := d.GetChange("record_set")
+	previous := expandDnsZoneRecordSets(oldRaw.(*pluginsdk.Set).List())
+	desired := expandDnsZoneRecordSets(newRaw.(*pluginsdk.Set).List())
+
+	if err := reconcileDnsZoneRecordSets(ctx, meta, *id, d.Get("mode").(string), desired, previous); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return resourceDnsZoneRecordsRead(d, meta)
+}
+
+func resourceDnsZoneRecordsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsZoneRecordsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	recordSets := expandDnsZoneRecordSets(d.Get("record_set").(*pluginsdk.Set).List())
+
+	for _, v := range recordSets {
+		log.Printf("[DEBUG] Deleting the %s record set %q from %s..", v.Type, v.Name, id)
+		resp, err := client.Delete(ctx, id.ResourceGroup, id.DnszoneName, v.Name, dns.RecordType(v.Type), "")
+		if err != nil && resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("deleting the %s record set %q from %s: %+v", v.Type, v.Name, id, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceDnsZoneRecordsCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	zoneFile := d.Get("zone_file").(string)
+	if zoneFile == "" {
+		if !d.NewValueKnown("record_set") {
+			return nil
+		}
+
+		recordSets := expandDnsZoneRecordSets(d.Get("record_set").(*pluginsdk.Set).List())
+		return validateDnsZoneRecordSets(recordSets)
+	}
+
+	if !d.NewValueKnown("zone_file") || !d.NewValueKnown("zone_name") {
+		return nil
+	}
+
+	zoneName := d.Get("zone_name").(string)
+	records, err := zonefile.Parse(zoneFile, zoneName, dnsZoneRecordsDefaultTTL)
+	if err != nil {
+		return fmt.Errorf("parsing `zone_file`: %+v", err)
+	}
+
+	recordSets, err := zonefile.GroupRecordSets(records, zoneName)
+	if err != nil {
+		return fmt.Errorf("parsing `zone_file`: %+v", err)
+	}
+
+	// the SOA and the Name Servers for the apex of the zone are managed by Azure, so are ignored when importing a Zone File
+	filtered := make([]zonefile.RecordSet, 0)
+	for _, v := range recordSets {
+		if isDnsZoneRecordSetManagedByAzure(v.Name, v.Type) {
+			log.Printf("[DEBUG] Ignoring the %s record set %q from the Zone File since this is managed by Azure", v.Type, v.Name)
+			continue
+		}
+		filtered = append(filtered, v)
+	}
+
+	return d.SetNew("record_set", flattenDnsZoneRecordSets(filtered))
+}
+
+// reconcileDnsZoneRecordSets creates/updates each of the desired record sets which differ from the existing record
+// sets, and then deletes any record sets which are no longer desired - which in Authoritative mode is every record set
+// in the zone (other than those managed by Azure), and in Additive mode is those previously managed by this resource
+func reconcileDnsZoneRecordSets(ctx context.Context, meta interface{}, id parse.DnsZoneRecordsId, mode string, desired []zonefile.RecordSet, previous []zonefile.RecordSet) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+
+	if err := validateDnsZoneRecordSets(desired); err != nil {
+		return err
+	}
+
+	existing, aliases, err := listDnsZoneRecordSets(ctx, meta, id)
+	if err != nil {
+		return err
+	}
+
+	desiredKeys := make(map[string]struct{})
+	for _, v := range desired {
+		key := dnsZoneRecordSetKey(v.Name, v.Type)
+		desiredKeys[key] = struct{}{}
+
+		if _, ok := aliases[key]; ok {
+			return fmt.Errorf("the %s record set %q is an alias record set, which isn't supported by this resource", v.Type, v.Name)
+		}
+
+		current, exists := existing[key]
+		if exists && dnsZoneRecordSetsEqual(current, v) {
+			continue
+		}
+
+		properties, err := expandDnsZoneRecordSetProperties(v)
+		if err != nil {
+			return fmt.Errorf("expanding the %s record set %q: %+v", v.Type, v.Name, err)
+		}
+
+		log.Printf("[DEBUG] Creating/Updating the %s record set %q in %s..", v.Type, v.Name, id)
+		parameters := dns.RecordSet{
+			RecordSetProperties: properties,
+		}
+		// a Patch is used for existing record sets so that any Metadata is retained
+		if exists {
+			_, err = client.Update(ctx, id.ResourceGroup, id.DnszoneName, v.Name, dns.RecordType(v.Type), parameters, "")
+		} else {
+			_, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.DnszoneName, v.Name, dns.RecordType(v.Type), parameters, "", "")
+		}
+		if err != nil {
+			return fmt.Errorf("creating/updating the %s record set %q: %+v", v.Type, v.Name, err)
+		}
+	}
+
+	toDelete := make([]zonefile.RecordSet, 0)
+	if mode == dnsZoneRecordsModeAuthoritative {
+		for _, v := range existing {
+			toDelete = append(toDelete, v)
+		}
+	} else {
+		for _, v := range previous {
+			if recordSet, ok := existing[dnsZoneRecordSetKey(v.Name, v.Type)]; ok {
+				toDelete = append(toDelete, recordSet)
+			}
+		}
+	}
+
+	for _, v := range toDelete {
+		if _, ok := desiredKeys[dnsZoneRecordSetKey(v.Name, v.Type)]; ok || isDnsZoneRecordSetManagedByAzure(v.Name, v.Type) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting the %s record set %q from %s..", v.Type, v.Name, id)
+		resp, err := client.Delete(ctx, id.ResourceGroup, id.DnszoneName, v.Name, dns.RecordType(v.Type), "")
+		if err != nil && resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("deleting the %s record set %q: %+v", v.Type, v.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// listDnsZoneRecordSets returns all of the record sets within the DNS Zone, keyed by `dnsZoneRecordSetKey`. Alias
+// record sets (which point to an Azure Resource rather than containing records) aren't modelled by this resource, so
+// are returned separately to ensure they're never updated or deleted.
+func listDnsZoneRecordSets(ctx context.Context, meta interface{}, id parse.DnsZoneRecordsId) (map[string]zonefile.RecordSet, map[string]struct{}, error) {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+
+	recordSets := make(map[string]zonefile.RecordSet)
+	aliases := make(map[string]struct{})
+	iterator, err := client.ListAllByDNSZoneComplete(ctx, id.ResourceGroup, id.DnszoneName, nil, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing the record sets within DNS Zone %q (Resource Group %q): %+v", id.DnszoneName, id.ResourceGroup, err)
+	}
+
+	for iterator.NotDone() {
+		value := iterator.Value()
+		if props := value.RecordSetProperties; props != nil && props.TargetResource != nil && props.TargetResource.ID != nil && value.Name != nil && value.Type != nil {
+			log.Printf("[DEBUG] Ignoring the alias record set %q (%s) since alias record sets aren't supported", *value.Name, *value.Type)
+			aliases[dnsZoneRecordSetKey(*value.Name, dnsZoneRecordSetType(*value.Type))] = struct{}{}
+		} else {
+			recordSet, err := flattenDnsZoneRecordSet(value)
+			if err != nil {
+				return nil, nil, err
+			}
+			if recordSet != nil {
+				recordSets[dnsZoneRecordSetKey(recordSet.Name, recordSet.Type)] = *recordSet
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, nil, fmt.Errorf("listing the record sets within DNS Zone %q (Resource Group %q): %+v", id.DnszoneName, id.ResourceGroup, err)
+		}
+	}
+
+	return recordSets, aliases, nil
+}
+
+func validateDnsZoneRecordSets(input []zonefile.RecordSet) error {
+	keys := make(map[string]struct{})
+	for _, v := range input {
+		key := dnsZoneRecordSetKey(v.Name, v.Type)
+		if _, exists := keys[key]; exists {
+			return fmt.Errorf("the %s record set %q is specified more than once", v.Type, v.Name)
+		}
+		keys[key] = struct{}{}
+
+		if isDnsZoneRecordSetManagedByAzure(v.Name, v.Type) {
+			return fmt.Errorf("the %s record set %q is managed by Azure and cannot be specified", v.Type, v.Name)
+		}
+		if v.Type == string(dns.CNAME) && len(v.Records) > 1 {
+			return fmt.Errorf("the CNAME record set %q can only contain a single record", v.Name)
+		}
+
+		for _, record := range v.Records {
+			fields, err := zonefile.ParseRecordData(v.Type, record, "")
+			if err != nil {
+				return fmt.Errorf("parsing the record %q in the %s record set %q: %+v", record, v.Type, v.Name, err)
+			}
+
+			// records are compared using their canonical form, so that changes made outside of Terraform are detected
+			if canonical := zonefile.FormatRecordData(v.Type, fields); canonical != record {
+				return fmt.Errorf("the record %q in the %s record set %q must be specified in the canonical form %q", record, v.Type, v.Name, canonical)
+			}
+		}
+	}
+
+	return nil
+}
+
+func dnsZoneRecordSetsEqual(first zonefile.RecordSet, second zonefile.RecordSet) bool {
+	if first.TTL != second.TTL || len(first.Records) != len(second.Records) {
+		return false
+	}
+	for i := range first.Records {
+		if first.Records[i] != second.Records[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func isDnsZoneRecordSetManagedByAzure(name string, recordType string) bool {
+	return recordType == string(dns.SOA) || (name == "@" && recordType == string(dns.NS))
+}
+
+// dnsZoneRecordSetType returns the record type from the resource type, which is in the form `Microsoft.Network/dnszones/A`
+func dnsZoneRecordSetType(input string) string {
+	if i := strings.LastIndex(input, "/"); i >= 0 {
+		return input[i+1:]
+	}
+	return input
+}
+
+func dnsZoneRecordSetKey(name string, recordType string) string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(name), strings.ToUpper(recordType))
+}
+
+func expandDnsZoneRecordSets(input []interface{}) []zonefile.RecordSet {
+	results := make([]zonefile.RecordSet, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		records := make([]string, 0)
+		for _, record := range v["records"].(*pluginsdk.Set).List() {
+			records = append(records, record.(string))
+		}
+		sort.Strings(records)
+
+		results = append(results, zonefile.RecordSet{
+			Name:    v["name"].(string),
+			Type:    v["type"].(string),
+			TTL:     int64(v["ttl"].(int)),
+			Records: records,
+		})
+	}
+
+	return results
+}
+
+func flattenDnsZoneRecordSets(input []zonefile.RecordSet) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, v := range input {
+		records := make([]interface{}, 0)
+		for _, record := range v.Records {
+			records = append(records, record)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":    v.Name,
+			"type":    v.Type,
+			"ttl":     int(v.TTL),
+			"records": records,
+		})
+	}
+
+	return results
+}
+
+func expandDnsZoneRecordSetProperties(input zonefile.RecordSet) (*dns.RecordSetProperties, error) {
+	ttl := input.TTL
+	properties := dns.RecordSetProperties{
+		TTL: &ttl,
+	}
+
+	fields := make([][]string, 0)
+	for _, record := range input.Records {
+		v, err := zonefile.ParseRecordData(input.Type, record, "")
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, v)
+	}
+
+	parseInt32 := func(input string) *int32 {
+		v, _ := strconv.ParseInt(input, 10, 32)
+		return utils.Int32(int32(v))
+	}
+
+	switch dns.RecordType(input.Type) {
+	case dns.A:
+		records := make([]dns.ARecord, 0)
+		for _, v := range fields {
+			records = append(records, dns.ARecord{Ipv4Address: utils.String(v[0])})
+		}
+		properties.ARecords = &records
+
+	case dns.AAAA:
+		records := make([]dns.AaaaRecord, 0)
+		for _, v := range fields {
+			records = append(records, dns.AaaaRecord{Ipv6Address: utils.String(v[0])})
+		}
+		properties.AaaaRecords = &records
+
+	case dns.CAA:
+		records := make([]dns.CaaRecord, 0)
+		for _, v := range fields {
+			records = append(records, dns.CaaRecord{
+				Flags: parseInt32(v[0]),
+				Tag:   utils.String(v[1]),
+				Value: utils.String(v[2]),
+			})
+		}
+		properties.CaaRecords = &records
+
+	case dns.CNAME:
+		if len(fields) > 0 {
+			properties.CnameRecord = &dns.CnameRecord{Cname: utils.String(fields[0][0])}
+		}
+
+	case dns.MX:
+		records := make([]dns.MxRecord, 0)
+		for _, v := range fields {
+			records = append(records, dns.MxRecord{
+				Preference: parseInt32(v[0]),
+				Exchange:   utils.String(v[1]),
+			})
+		}
+		properties.MxRecords = &records
+
+	case dns.NS:
+		records := make([]dns.NsRecord, 0)
+		for _, v := range fields {
+			records = append(records, dns.NsRecord{Nsdname: utils.String(v[0])})
+		}
+		properties.NsRecords = &records
+
+	case dns.PTR:
+		records := make([]dns.PtrRecord, 0)
+		for _, v := range fields {
+			records = append(records, dns.PtrRecord{Ptrdname: utils.String(v[0])})
+		}
+		properties.PtrRecords = &records
+
+	case dns.SRV:
+		records := make([]dns.SrvRecord, 0)
+		for _, v := range fields {
+			records = append(records, dns.SrvRecord{
+				Priority: parseInt32(v[0]),
+				Weight:   parseInt32(v[1]),
+				Port:     parseInt32(v[2]),
+				Target:   utils.String(v[3]),
+			})
+		}
+		properties.SrvRecords = &records
+
+	case dns.TXT:
+		records := make([]dns.TxtRecord, 0)
+		for _, v := range fields {
+			value := v
+			records = append(records, dns.TxtRecord{Value: &value})
+		}
+		properties.TxtRecords = &records
+
+	default:
+		return nil, fmt.Errorf("unsupported record type %q", input.Type)
+	}
+
+	return &properties, nil
+}
+
+// flattenDnsZoneRecordSet returns the record set using the canonical form of each record, or nil if the record set
+// is of a type which isn't supported
+func flattenDnsZoneRecordSet(input dns.RecordSet) (*zonefile.RecordSet, error) {
+	if input.Name == nil || input.Type == nil || input.RecordSetProperties == nil {
+		return nil, nil
+	}
+
+	recordType := dnsZoneRecordSetType(*input.Type)
+
+	props := *input.RecordSetProperties
+	fields := make([][]string, 0)
+
+	formatInt32 := func(input *int32) string {
+		if input == nil {
+			return "0"
+		}
+		return strconv.Itoa(int(*input))
+	}
+
+	switch dns.RecordType(recordType) {
+	case dns.A:
+		if props.ARecords != nil {
+			for _, v := range *props.ARecords {
+				fields = append(fields, []string{utils.NormalizeNilableString(v.Ipv4Address)})
+			}
+		}
+
+	case dns.AAAA:
+		if props.AaaaRecords != nil {
+			for _, v := range *props.AaaaRecords {
+				fields = append(fields, []string{utils.NormalizeNilableString(v.Ipv6Address)})
+			}
+		}
+
+	case dns.CAA:
+		if props.CaaRecords != nil {
+			for _, v := range *props.CaaRecords {
+				fields = append(fields, []string{formatInt32(v.Flags), utils.NormalizeNilableString(v.Tag), utils.NormalizeNilableString(v.Value)})
+			}
+		}
+
+	case dns.CNAME:
+		if props.CnameRecord != nil && props.CnameRecord.Cname != nil {
+			fields = append(fields, []string{*props.CnameRecord.Cname})
+		}
+
+	case dns.MX:
+		if props.MxRecords != nil {
+			for _, v := range *props.MxRecords {
+				fields = append(fields, []string{formatInt32(v.Preference), utils.NormalizeNilableString(v.Exchange)})
+			}
+		}
+
+	case dns.NS:
+		if props.NsRecords != nil {
+			for _, v := range *props.NsRecords {
+				fields = append(fields, []string{utils.NormalizeNilableString(v.Nsdname)})
+			}
+		}
+
+	case dns.PTR:
+		if props.PtrRecords != nil {
+			for _, v := range *props.PtrRecords {
+				fields = append(fields, []string{utils.NormalizeNilableString(v.Ptrdname)})
+			}
+		}
+
+	case dns.SRV:
+		if props.SrvRecords != nil {
+			for _, v := range *props.SrvRecords {
+				fields = append(fields, []string{formatInt32(v.Priority), formatInt32(v.Weight), formatInt32(v.Port), utils.NormalizeNilableString(v.Target)})
+			}
+		}
+
+	case dns.TXT:
+		if props.TxtRecords != nil {
+			for _, v := range *props.TxtRecords {
+				if v.Value != nil {
+					fields = append(fields, *v.Value)
+				}
+			}
+		}
+
+	case dns.SOA:
+		// the SOA record is managed by Azure, so the records aren't needed
+
+	default:
+		log.Printf("[DEBUG] Ignoring the %s record set %q since this record type isn't supported", recordType, *input.Name)
+		return nil, nil
+	}
+
+	records := make([]string, 0)
+	for _, v := range fields {
+		record := zonefile.FormatRecordData(recordType, v)
+
+		// normalise the values returned from the API (e.g. removing any trailing dots) into their canonical form
+		parsed, err := zonefile.ParseRecordData(recordType, record, "")
+		if err != nil {
+			return nil, fmt.Errorf("parsing the record %q in the %s record set %q: %+v", record, recordType, *input.Name, err)
+		}
+		records = append(records, zonefile.FormatRecordData(recordType, parsed))
+	}
+	sort.Strings(records)
+
+	ttl := int64(0)
+	if props.TTL != nil {
+		ttl = *props.TTL
+	}
+
+	return &zonefile.RecordSet{
+		Name:    *input.Name,
+		Type:    recordType,
+		TTL:     ttl,
+		Records: records,
+	}, nil
+}
@@ -0,0 +1,401 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DnsZoneRecordsResource struct {
+}
+
+func TestAccDnsZoneRecords_recordSets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.recordSets(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("3"),
+			),
+		},
+		{
+			Config: r.recordSetsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccDnsZoneRecords_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.recordSets(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDnsZoneRecords_mixedCaseNames(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.mixedCaseNames(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccDnsZoneRecords_zoneFile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.zoneFile(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				// the SOA and apex NS records are ignored
+				check.That(data.ResourceName).Key("record_set.#").HasValue("7"),
+			),
+		},
+		{
+			Config: r.zoneFileUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("5"),
+			),
+		},
+	})
+}
+
+func TestAccDnsZoneRecords_additiveRetainsUnmanagedRecordSets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.recordSets(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.createUnmanagedRecordSet),
+			),
+		},
+		{
+			Config: r.recordSetsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("2"),
+				data.CheckWithClient(r.unmanagedRecordSetExists(true)),
+			),
+		},
+	})
+}
+
+func TestAccDnsZoneRecords_authoritative(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.authoritative(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("3"),
+				data.CheckWithClient(r.createUnmanagedRecordSet),
+			),
+			// the record set created outside of Terraform is detected and will be removed
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.authoritative(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("3"),
+				data.CheckWithClient(r.unmanagedRecordSetExists(false)),
+			),
+		},
+	})
+}
+
+func (DnsZoneRecordsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DnsZoneRecordsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the resource exists when each of the record sets exists
+	for key, name := range state.Attributes {
+		if !strings.HasPrefix(key, "record_set.") || !strings.HasSuffix(key, ".name") {
+			continue
+		}
+		recordType := state.Attributes[strings.TrimSuffix(key, ".name")+".type"]
+
+		resp, err := clients.Dns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.DnszoneName, name, dns.RecordType(recordType))
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving the %s record set %q in %s: %+v", recordType, name, id, err)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (DnsZoneRecordsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DnsZoneRecordsResource) recordSets(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+
+  record_set {
+    name    = "@"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1", "192.0.2.2"]
+  }
+
+  record_set {
+    name    = "@"
+    type    = "MX"
+    ttl     = 3600
+    records = ["10 mail1.example.com", "20 mail2.example.com"]
+  }
+
+  record_set {
+    name    = "www"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["example.com"]
+  }
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "import" {
+  resource_group_name = azurerm_dns_zone_records.test.resource_group_name
+  zone_name           = azurerm_dns_zone_records.test.zone_name
+
+  record_set {
+    name    = "www"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["example.com"]
+  }
+}
+`, r.recordSets(data))
+}
+
+func (r DnsZoneRecordsResource) mixedCaseNames(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+
+  record_set {
+    name    = "WWW"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["example.com"]
+  }
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) recordSetsUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+
+  record_set {
+    name    = "@"
+    type    = "A"
+    ttl     = 600
+    records = ["192.0.2.1"]
+  }
+
+  record_set {
+    name    = "@"
+    type    = "TXT"
+    ttl     = 3600
+    records = ["\"v=spf1 mx -all\""]
+  }
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) zoneFile(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+
+  zone_file = <<ZONE
+$ORIGIN ${azurerm_dns_zone.test.name}.
+$TTL 1h
+@          IN SOA   ns1.example.com. hostmaster.example.com. ( 2022010101 7200 3600 1209600 300 )
+@          IN NS    ns1.example.com.
+@          IN A     192.0.2.1
+@          IN MX    10 mail
+mail   300 IN A     192.0.2.2
+           IN AAAA  2001:db8::2
+www        IN CNAME @
+_sip._tcp  IN SRV   10 60 5060 sip.example.com.
+@          IN TXT   "v=spf1 mx -all"
+ZONE
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) zoneFileUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+
+  zone_file = <<ZONE
+$ORIGIN ${azurerm_dns_zone.test.name}.
+$TTL 2h
+@          IN A     192.0.2.1
+@          IN MX    10 mail
+mail   300 IN A     192.0.2.3
+           IN AAAA  2001:db8::3
+@          IN TXT   "v=spf1 mx -all"
+ZONE
+}
+`, r.template(data))
+}
+
+func (DnsZoneRecordsResource) createUnmanagedRecordSet(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	id, err := parse.DnsZoneRecordsID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	parameters := dns.RecordSet{
+		RecordSetProperties: &dns.RecordSetProperties{
+			TTL: utils.Int64(300),
+			ARecords: &[]dns.ARecord{
+				{
+					Ipv4Address: utils.String("192.0.2.10"),
+				},
+			},
+		},
+	}
+	if _, err := clients.Dns.RecordSetsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.DnszoneName, "unmanaged", dns.A, parameters, "", ""); err != nil {
+		return fmt.Errorf("creating the unmanaged A record set in %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func (DnsZoneRecordsResource) unmanagedRecordSetExists(shouldExist bool) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.DnsZoneRecordsID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := clients.Dns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.DnszoneName, "unmanaged", dns.A)
+		if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("retrieving the unmanaged A record set in %s: %+v", id, err)
+		}
+
+		if exists := !utils.ResponseWasNotFound(resp.Response); exists != shouldExist {
+			return fmt.Errorf("expected the unmanaged A record set in %s to exist: %t but got %t", id, shouldExist, exists)
+		}
+
+		return nil
+	}
+}
+
+func (r DnsZoneRecordsResource) authoritative(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  mode                = "Authoritative"
+
+  record_set {
+    name    = "@"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1", "192.0.2.2"]
+  }
+
+  record_set {
+    name    = "@"
+    type    = "MX"
+    ttl     = 3600
+    records = ["10 mail1.example.com", "20 mail2.example.com"]
+  }
+
+  record_set {
+    name    = "www"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["example.com"]
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,131 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DnsZoneRecordsId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnszoneName    string
+	RecordName     string
+}
+
+func NewDnsZoneRecordsID(subscriptionId, resourceGroup, dnszoneName, recordName string) DnsZoneRecordsId {
+	return DnsZoneRecordsId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnszoneName:    dnszoneName,
+		RecordName:     recordName,
+	}
+}
+
+func (id DnsZoneRecordsId) String() string {
+	segments := []string{
+		fmt.Sprintf("Record Name %q", id.RecordName),
+		fmt.Sprintf("Dnszone Name %q", id.DnszoneName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Dns Zone Records", segmentsStr)
+}
+
+func (id DnsZoneRecordsId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/records/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.RecordName)
+}
+
+// DnsZoneRecordsID parses a DnsZoneRecords ID into an DnsZoneRecordsId struct
+func DnsZoneRecordsID(input string) (*DnsZoneRecordsId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsZoneRecordsId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, err
+	}
+	if resourceId.RecordName, err = id.PopSegment("records"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// DnsZoneRecordsIDInsensitively parses an DnsZoneRecords ID into an DnsZoneRecordsId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DnsZoneRecordsID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DnsZoneRecordsIDInsensitively(input string) (*DnsZoneRecordsId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsZoneRecordsId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'dnszones' segment
+	dnszonesKey := "dnszones"
+	for key := range id.Path {
+		if strings.EqualFold(key, dnszonesKey) {
+			dnszonesKey = key
+			break
+		}
+	}
+	if resourceId.DnszoneName, err = id.PopSegment(dnszonesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'records' segment
+	recordsKey := "records"
+	for key := range id.Path {
+		if strings.EqualFold(key, recordsKey) {
+			recordsKey = key
+			break
+		}
+	}
+	if resourceId.RecordName, err = id.PopSegment(recordsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,155 @@
+package zonefile
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type token struct {
+	value  string
+	quoted bool
+}
+
+// entry is a single logical line of a zone file, which may span multiple physical lines using parentheses
+type entry struct {
+	line int
+
+	// blankOwner is set when the entry starts with whitespace, meaning the owner of the previous entry is used
+	blankOwner bool
+
+	tokens []token
+}
+
+// tokenise splits a zone file into entries, removing comments and joining entries spanning multiple lines
+func tokenise(input string) ([]entry, error) {
+	entries := make([]entry, 0)
+
+	line := 1
+	depth := 0
+	atLineStart := true
+	current := entry{line: line}
+
+	finishEntry := func() {
+		if len(current.tokens) > 0 {
+			entries = append(entries, current)
+		}
+		current = entry{line: line}
+	}
+
+	runes := []rune(input)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+
+		if atLineStart && depth == 0 {
+			current.line = line
+			current.blankOwner = c == ' ' || c == '\t'
+		}
+		atLineStart = false
+
+		switch {
+		case c == '\n':
+			line++
+			atLineStart = true
+			if depth == 0 {
+				finishEntry()
+			}
+
+		case c == ' ' || c == '\t' || c == '\r':
+			continue
+
+		case c == ';':
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+
+		case c == '(':
+			depth++
+
+		case c == ')':
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unexpected `)`", line)
+			}
+			depth--
+
+		case c == '"':
+			startLine := line
+			var value strings.Builder
+			closed := false
+			for i+1 < len(runes) {
+				i++
+				if runes[i] == '"' {
+					closed = true
+					break
+				}
+				if runes[i] == '\n' {
+					line++
+				}
+				if runes[i] == '\\' {
+					n, err := unescape(runes, i, &value)
+					if err != nil {
+						return nil, fmt.Errorf("line %d: %+v", line, err)
+					}
+					i += n
+					continue
+				}
+				value.WriteRune(runes[i])
+			}
+			if !closed {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", startLine)
+			}
+			current.tokens = append(current.tokens, token{value: value.String(), quoted: true})
+
+		default:
+			var value strings.Builder
+			for ; i < len(runes); i++ {
+				r := runes[i]
+				if r == ' ' || r == '\t' || r == '\r' || r == '\n' || r == ';' || r == '(' || r == ')' || r == '"' {
+					i--
+					break
+				}
+				if r == '\\' {
+					n, err := unescape(runes, i, &value)
+					if err != nil {
+						return nil, fmt.Errorf("line %d: %+v", line, err)
+					}
+					i += n
+					continue
+				}
+				value.WriteRune(r)
+			}
+			current.tokens = append(current.tokens, token{value: value.String()})
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unterminated `(`", current.line)
+	}
+	finishEntry()
+
+	return entries, nil
+}
+
+// unescape handles the escape sequence starting at `runes[i]` (a backslash), which is either `\X` for a literal
+// character or `\DDD` for a decimal octet - returning the number of runes consumed after the backslash
+func unescape(runes []rune, i int, out *strings.Builder) (int, error) {
+	if i+1 >= len(runes) {
+		return 0, fmt.Errorf("unterminated escape sequence")
+	}
+
+	if i+3 < len(runes) && isDigit(runes[i+1]) && isDigit(runes[i+2]) && isDigit(runes[i+3]) {
+		v, err := strconv.Atoi(string(runes[i+1 : i+4]))
+		if err != nil || v > 255 {
+			return 0, fmt.Errorf("invalid escape sequence `\\%s`", string(runes[i+1:i+4]))
+		}
+		out.WriteByte(byte(v))
+		return 3, nil
+	}
+
+	out.WriteRune(runes[i+1])
+	return 1, nil
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,220 @@
+package zonefile
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// SupportedRecordTypes are the Record Types which can be parsed from a Zone File
+var SupportedRecordTypes = []string{
+	"A",
+	"AAAA",
+	"CAA",
+	"CNAME",
+	"MX",
+	"NS",
+	"PTR",
+	"SOA",
+	"SRV",
+	"TXT",
+}
+
+// ParseRecordData parses the presentation format of the data for a single record (for example `10 mail.example.com`
+// for an MX record) into its canonical fields. Relative domain names are qualified using `origin`, when `origin` is
+// empty domain names are assumed to already be fully qualified.
+func ParseRecordData(recordType string, input string, origin string) ([]string, error) {
+	entries, err := tokenise(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) != 1 {
+		return nil, fmt.Errorf("expected the record data to be a single line but got %d", len(entries))
+	}
+
+	return parseRecordFields(strings.ToUpper(recordType), entries[0].tokens, origin)
+}
+
+// FormatRecordData returns the canonical presentation format for the specified record fields, such that
+// `ParseRecordData` followed by `FormatRecordData` returns the same value for equivalent inputs.
+func FormatRecordData(recordType string, fields []string) string {
+	switch strings.ToUpper(recordType) {
+	case "TXT":
+		quoted := make([]string, 0, len(fields))
+		for _, v := range fields {
+			quoted = append(quoted, quote(v))
+		}
+		return strings.Join(quoted, " ")
+
+	case "CAA":
+		if len(fields) == 3 {
+			return fmt.Sprintf("%s %s %s", fields[0], fields[1], quote(fields[2]))
+		}
+	}
+
+	return strings.Join(fields, " ")
+}
+
+func parseRecordFields(recordType string, tokens []token, origin string) ([]string, error) {
+	values := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		values = append(values, t.value)
+	}
+
+	expectFields := func(n int) error {
+		if len(values) != n {
+			return fmt.Errorf("expected %d fields for a %s record but got %d", n, recordType, len(values))
+		}
+		return nil
+	}
+
+	switch recordType {
+	case "A":
+		if err := expectFields(1); err != nil {
+			return nil, err
+		}
+		ip := net.ParseIP(values[0])
+		if ip == nil || ip.To4() == nil || strings.Contains(values[0], ":") {
+			return nil, fmt.Errorf("%q is not a valid IPv4 address", values[0])
+		}
+		return []string{ip.To4().String()}, nil
+
+	case "AAAA":
+		if err := expectFields(1); err != nil {
+			return nil, err
+		}
+		ip := net.ParseIP(values[0])
+		if ip == nil || !strings.Contains(values[0], ":") {
+			return nil, fmt.Errorf("%q is not a valid IPv6 address", values[0])
+		}
+		return []string{ip.String()}, nil
+
+	case "CNAME", "NS", "PTR":
+		if err := expectFields(1); err != nil {
+			return nil, err
+		}
+		return []string{qualifyName(values[0], origin)}, nil
+
+	case "MX":
+		if err := expectFields(2); err != nil {
+			return nil, err
+		}
+		preference, err := parseUint(values[0], 16, "preference")
+		if err != nil {
+			return nil, err
+		}
+		return []string{preference, qualifyName(values[1], origin)}, nil
+
+	case "SRV":
+		if err := expectFields(4); err != nil {
+			return nil, err
+		}
+		fields := make([]string, 0, 4)
+		for i, name := range []string{"priority", "weight", "port"} {
+			v, err := parseUint(values[i], 16, name)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, v)
+		}
+		return append(fields, qualifyName(values[3], origin)), nil
+
+	case "TXT":
+		if len(values) == 0 {
+			return nil, fmt.Errorf("expected at least one value for a TXT record")
+		}
+		for _, v := range values {
+			// whilst RFC 1035 limits each string to 255 characters, Azure DNS supports strings of up to 1024 characters
+			if len(v) > 1024 {
+				return nil, fmt.Errorf("TXT record strings must be at most 1024 characters but got %d - split longer values into multiple strings", len(v))
+			}
+		}
+		return values, nil
+
+	case "CAA":
+		if err := expectFields(3); err != nil {
+			return nil, err
+		}
+		flags, err := parseUint(values[0], 8, "flags")
+		if err != nil {
+			return nil, err
+		}
+		return []string{flags, strings.ToLower(values[1]), values[2]}, nil
+
+	case "SOA":
+		if err := expectFields(7); err != nil {
+			return nil, err
+		}
+		fields := []string{qualifyName(values[0], origin), qualifyName(values[1], origin)}
+		for i, name := range []string{"serial", "refresh", "retry", "expire", "minimum"} {
+			v := values[i+2]
+			if name != "serial" {
+				ttl, err := parseTTL(v)
+				if err != nil {
+					return nil, err
+				}
+				v = strconv.FormatInt(ttl, 10)
+			}
+			if _, err := parseUint(v, 32, name); err != nil {
+				return nil, err
+			}
+			fields = append(fields, v)
+		}
+		return fields, nil
+	}
+
+	return nil, fmt.Errorf("unsupported record type %q - supported types are %s", recordType, strings.Join(SupportedRecordTypes, ", "))
+}
+
+func parseUint(input string, bitSize int, name string) (string, error) {
+	v, err := strconv.ParseUint(input, 10, bitSize)
+	if err != nil {
+		return "", fmt.Errorf("%s %q must be an integer between 0 and %d", name, input, uint64(1)<<bitSize-1)
+	}
+	return strconv.FormatUint(v, 10), nil
+}
+
+// normaliseName returns the lower-cased form of `name` without the trailing dot
+func normaliseName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+// qualifyName returns the fully qualified, lower-cased, form of `name` without the trailing dot
+func qualifyName(name string, origin string) string {
+	origin = normaliseName(origin)
+	name = strings.ToLower(name)
+
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	case origin == "":
+		return name
+	}
+
+	return name + "." + origin
+}
+
+func quote(input string) string {
+	var out strings.Builder
+	out.WriteRune('"')
+	for i := 0; i < len(input); {
+		r, size := utf8.DecodeRuneInString(input[i:])
+		switch {
+		case r == '"' || r == '\\':
+			out.WriteByte('\\')
+			out.WriteRune(r)
+		case r < 0x20 || r == 0x7f || (r == utf8.RuneError && size == 1):
+			// control characters and bytes which aren't valid UTF-8 are escaped as `\DDD`
+			out.WriteString(fmt.Sprintf("\\%03d", input[i]))
+		default:
+			out.WriteRune(r)
+		}
+		i += size
+	}
+	out.WriteRune('"')
+	return out.String()
+}
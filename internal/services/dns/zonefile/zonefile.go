@@ -0,0 +1,268 @@
+// Package zonefile parses RFC 1035 Zone Files (as exported from BIND and most other DNS servers) into record sets.
+package zonefile
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Record struct {
+	// Name is the fully qualified, lower-cased, name of the record without the trailing dot
+	Name string
+
+	Type string
+	TTL  int64
+
+	// Fields are the canonical values for the record data, see `FormatRecordData`
+	Fields []string
+}
+
+// Data returns the canonical presentation format of the record data
+func (r Record) Data() string {
+	return FormatRecordData(r.Type, r.Fields)
+}
+
+type RecordSet struct {
+	// Name is the name of the record set relative to the zone, `@` being the apex of the zone
+	Name string
+
+	Type    string
+	TTL     int64
+	Records []string
+}
+
+// Parse parses the contents of a Zone File, using `origin` as the initial value for `$ORIGIN` and `defaultTTL` for
+// any records without a TTL when no `$TTL` directive is present (and no previous record specified a TTL).
+//
+// The `$ORIGIN` and `$TTL` directives, relative and `@` names, blank owners (which repeat the previous owner),
+// comments and parentheses are supported - `$INCLUDE` and `$GENERATE` are not.
+func Parse(input string, origin string, defaultTTL int64) ([]Record, error) {
+	entries, err := tokenise(input)
+	if err != nil {
+		return nil, err
+	}
+
+	origin = normaliseName(origin)
+	if origin == "" {
+		return nil, fmt.Errorf("an origin must be specified")
+	}
+
+	var zoneTTL *int64
+	var lastTTL *int64
+	lastOwner := ""
+
+	records := make([]Record, 0)
+	for _, e := range entries {
+		tokens := e.tokens
+
+		if !e.blankOwner && !tokens[0].quoted && strings.HasPrefix(tokens[0].value, "$") {
+			directive := strings.ToUpper(tokens[0].value)
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: expected `$ORIGIN <domain-name>`", e.line)
+				}
+				origin = qualifyName(tokens[1].value, origin)
+
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: expected `$TTL <ttl>`", e.line)
+				}
+				ttl, err := parseTTL(tokens[1].value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %+v", e.line, err)
+				}
+				zoneTTL = &ttl
+
+			default:
+				return nil, fmt.Errorf("line %d: the %s directive is not supported", e.line, directive)
+			}
+			continue
+		}
+
+		owner := lastOwner
+		if !e.blankOwner {
+			owner = qualifyName(tokens[0].value, origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: the first record must specify an owner name", e.line)
+		}
+		lastOwner = owner
+
+		// the TTL and Class are both optional and can be specified in either order
+		var ttl *int64
+		for i := 0; i < 2 && len(tokens) > 0 && !tokens[0].quoted; i++ {
+			v := tokens[0].value
+			if isClass(v) {
+				if !strings.EqualFold(v, "IN") {
+					return nil, fmt.Errorf("line %d: only the `IN` class is supported but got %q", e.line, v)
+				}
+				tokens = tokens[1:]
+				continue
+			}
+			if ttl == nil && len(v) > 0 && isDigit(rune(v[0])) {
+				parsed, err := parseTTL(v)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %+v", e.line, err)
+				}
+				ttl = &parsed
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: expected a record type", e.line)
+		}
+		recordType := strings.ToUpper(tokens[0].value)
+
+		fields, err := parseRecordFields(recordType, tokens[1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %+v", e.line, err)
+		}
+
+		if ttl != nil {
+			lastTTL = ttl
+		} else {
+			switch {
+			case zoneTTL != nil:
+				ttl = zoneTTL
+			case lastTTL != nil:
+				ttl = lastTTL
+			default:
+				ttl = &defaultTTL
+			}
+		}
+
+		records = append(records, Record{
+			Name:   owner,
+			Type:   recordType,
+			TTL:    *ttl,
+			Fields: fields,
+		})
+	}
+
+	return records, nil
+}
+
+// GroupRecordSets groups the records into record sets named relative to `zoneName`, returning an error if any of the
+// records are outside of the zone or if the records within a record set specify different TTLs.
+func GroupRecordSets(records []Record, zoneName string) ([]RecordSet, error) {
+	recordSets := make([]RecordSet, 0)
+	indexes := make(map[string]int)
+
+	for _, record := range records {
+		name, err := RelativeName(record.Name, zoneName)
+		if err != nil {
+			return nil, err
+		}
+
+		key := fmt.Sprintf("%s/%s", name, record.Type)
+		index, exists := indexes[key]
+		if !exists {
+			indexes[key] = len(recordSets)
+			recordSets = append(recordSets, RecordSet{
+				Name:    name,
+				Type:    record.Type,
+				TTL:     record.TTL,
+				Records: []string{record.Data()},
+			})
+			continue
+		}
+
+		recordSet := &recordSets[index]
+		if recordSet.TTL != record.TTL {
+			return nil, fmt.Errorf("the %s records for %q specify different TTLs (%d and %d) - all records within a record set must use the same TTL", record.Type, record.Name, recordSet.TTL, record.TTL)
+		}
+		if record.Type == "CNAME" || record.Type == "SOA" {
+			return nil, fmt.Errorf("only a single %s record can be specified for %q", record.Type, record.Name)
+		}
+
+		data := record.Data()
+		duplicate := false
+		for _, v := range recordSet.Records {
+			if v == data {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			recordSet.Records = append(recordSet.Records, data)
+		}
+	}
+
+	for _, recordSet := range recordSets {
+		sort.Strings(recordSet.Records)
+	}
+
+	return recordSets, nil
+}
+
+// RelativeName returns the name of the fully qualified domain name `fqdn` relative to `zoneName`, using `@` for the
+// apex of the zone
+func RelativeName(fqdn string, zoneName string) (string, error) {
+	name := normaliseName(fqdn)
+	zone := normaliseName(zoneName)
+
+	if name == zone {
+		return "@", nil
+	}
+	if strings.HasSuffix(name, "."+zone) {
+		return strings.TrimSuffix(name, "."+zone), nil
+	}
+
+	return "", fmt.Errorf("the record %q is not within the zone %q", fqdn, zoneName)
+}
+
+// parseTTL parses a TTL either in seconds, or using the BIND format of units (e.g. `1h30m` or `1W`)
+func parseTTL(input string) (int64, error) {
+	if v, err := strconv.ParseUint(input, 10, 31); err == nil {
+		return int64(v), nil
+	}
+
+	units := map[byte]int64{
+		's': 1,
+		'm': 60,
+		'h': 60 * 60,
+		'd': 24 * 60 * 60,
+		'w': 7 * 24 * 60 * 60,
+	}
+
+	total := int64(0)
+	current := ""
+	for _, c := range []byte(strings.ToLower(input)) {
+		if isDigit(rune(c)) {
+			current += string(c)
+			continue
+		}
+
+		multiplier, ok := units[c]
+		if !ok || current == "" {
+			return 0, fmt.Errorf("invalid TTL %q", input)
+		}
+		v, err := strconv.ParseInt(current, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid TTL %q", input)
+		}
+		total += v * multiplier
+		current = ""
+	}
+
+	if current != "" || total > 2147483647 {
+		return 0, fmt.Errorf("invalid TTL %q", input)
+	}
+
+	return total, nil
+}
+
+func isClass(input string) bool {
+	switch strings.ToUpper(input) {
+	case "IN", "CH", "CS", "HS":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,217 @@
+package zonefile
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	input := `
+$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. hostmaster.example.com. (
+			2022010101 ; serial
+			7200       ; refresh
+			3600       ; retry
+			1209600    ; expire
+			300 )      ; minimum
+@		IN	NS	ns1
+		IN	NS	ns2.example.net.
+@		IN	MX	10 mail
+@	300	IN	A	192.0.2.1
+www		IN	CNAME	@
+mail	IN	600	A	192.0.2.2
+		AAAA	2001:DB8::0:1
+_sip._tcp	SRV	10 60 5060 sip.example.com.
+@		TXT	"v=spf1 mx -all" ; a comment
+txt		TXT	( "part one;"
+			  "part \"two\"" )
+@		CAA	0 issue "letsencrypt.org"
+
+$ORIGIN sub
+host	2d	A	192.0.2.3
+HOST.sub.example.com.	A	192.0.2.4
+$ORIGIN example.com.
+absolute.example.com.	A	192.0.2.5
+`
+
+	expected := []Record{
+		{Name: "example.com", Type: "SOA", TTL: 3600, Fields: []string{"ns1.example.com", "hostmaster.example.com", "2022010101", "7200", "3600", "1209600", "300"}},
+		{Name: "example.com", Type: "NS", TTL: 3600, Fields: []string{"ns1.example.com"}},
+		{Name: "example.com", Type: "NS", TTL: 3600, Fields: []string{"ns2.example.net"}},
+		{Name: "example.com", Type: "MX", TTL: 3600, Fields: []string{"10", "mail.example.com"}},
+		{Name: "example.com", Type: "A", TTL: 300, Fields: []string{"192.0.2.1"}},
+		{Name: "www.example.com", Type: "CNAME", TTL: 3600, Fields: []string{"example.com"}},
+		{Name: "mail.example.com", Type: "A", TTL: 600, Fields: []string{"192.0.2.2"}},
+		{Name: "mail.example.com", Type: "AAAA", TTL: 3600, Fields: []string{"2001:db8::1"}},
+		{Name: "_sip._tcp.example.com", Type: "SRV", TTL: 3600, Fields: []string{"10", "60", "5060", "sip.example.com"}},
+		{Name: "example.com", Type: "TXT", TTL: 3600, Fields: []string{"v=spf1 mx -all"}},
+		{Name: "txt.example.com", Type: "TXT", TTL: 3600, Fields: []string{"part one;", `part "two"`}},
+		{Name: "example.com", Type: "CAA", TTL: 3600, Fields: []string{"0", "issue", "letsencrypt.org"}},
+		{Name: "host.sub.example.com", Type: "A", TTL: 172800, Fields: []string{"192.0.2.3"}},
+		{Name: "host.sub.example.com", Type: "A", TTL: 3600, Fields: []string{"192.0.2.4"}},
+		{Name: "absolute.example.com", Type: "A", TTL: 3600, Fields: []string{"192.0.2.5"}},
+	}
+
+	actual, err := Parse(input, "example.com", 300)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d records but got %d: %+v", len(expected), len(actual), actual)
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(actual[i], expected[i]) {
+			t.Fatalf("record %d: expected %+v but got %+v", i, expected[i], actual[i])
+		}
+	}
+}
+
+func TestParseTTLDefaults(t *testing.T) {
+	input := `
+a	A	192.0.2.1
+b	60	A	192.0.2.2
+c	A	192.0.2.3
+$TTL 120
+d	A	192.0.2.4
+`
+
+	actual, err := Parse(input, "example.com.", 3600)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	// without a `$TTL` the default is used until a record specifies a TTL, which is then used for subsequent records
+	expected := []int64{3600, 60, 60, 120}
+	for i, v := range expected {
+		if actual[i].TTL != v {
+			t.Fatalf("record %q: expected a TTL of %d but got %d", actual[i].Name, v, actual[i].TTL)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	cases := map[string]string{
+		"blank owner on the first record": "	A	192.0.2.1",
+		"unsupported directive":           "$INCLUDE other.zone",
+		"unsupported class":               "www	CH	A	192.0.2.1",
+		"unsupported type":                "www	HINFO	\"cpu\" \"os\"",
+		"invalid ipv4 address":            "www	A	2001:db8::1",
+		"invalid ipv6 address":            "www	AAAA	192.0.2.1",
+		"missing mx preference":           "@	MX	mail",
+		"unterminated quote":              "@	TXT	\"value",
+		"unterminated parentheses":        "@	TXT	( \"value\"",
+		"invalid ttl":                     "www	1x	A	192.0.2.1",
+	}
+
+	for name, input := range cases {
+		if _, err := Parse(input, "example.com", 3600); err == nil {
+			t.Fatalf("%s: expected an error but didn't get one", name)
+		}
+	}
+}
+
+func TestGroupRecordSets(t *testing.T) {
+	records := []Record{
+		{Name: "example.com", Type: "A", TTL: 300, Fields: []string{"192.0.2.2"}},
+		{Name: "example.com", Type: "A", TTL: 300, Fields: []string{"192.0.2.1"}},
+		{Name: "example.com", Type: "A", TTL: 300, Fields: []string{"192.0.2.1"}},
+		{Name: "www.example.com", Type: "CNAME", TTL: 60, Fields: []string{"example.com"}},
+		{Name: "example.com", Type: "TXT", TTL: 300, Fields: []string{`say "hi"`}},
+	}
+
+	actual, err := GroupRecordSets(records, "Example.com.")
+	if err != nil {
+		t.Fatalf("grouping: %+v", err)
+	}
+
+	expected := []RecordSet{
+		{Name: "@", Type: "A", TTL: 300, Records: []string{"192.0.2.1", "192.0.2.2"}},
+		{Name: "www", Type: "CNAME", TTL: 60, Records: []string{"example.com"}},
+		{Name: "@", Type: "TXT", TTL: 300, Records: []string{`"say \"hi\""`}},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestGroupRecordSetsInvalid(t *testing.T) {
+	cases := map[string][]Record{
+		"outside of the zone": {
+			{Name: "example.net", Type: "A", TTL: 300, Fields: []string{"192.0.2.1"}},
+		},
+		"differing ttls": {
+			{Name: "www.example.com", Type: "A", TTL: 300, Fields: []string{"192.0.2.1"}},
+			{Name: "www.example.com", Type: "A", TTL: 600, Fields: []string{"192.0.2.2"}},
+		},
+		"multiple cnames": {
+			{Name: "www.example.com", Type: "CNAME", TTL: 300, Fields: []string{"a.example.com"}},
+			{Name: "www.example.com", Type: "CNAME", TTL: 300, Fields: []string{"b.example.com"}},
+		},
+	}
+
+	for name, records := range cases {
+		if _, err := GroupRecordSets(records, "example.com"); err == nil {
+			t.Fatalf("%s: expected an error but didn't get one", name)
+		}
+	}
+}
+
+func TestRecordDataRoundTrip(t *testing.T) {
+	cases := []struct {
+		recordType string
+		input      string
+		expected   string
+	}{
+		{recordType: "A", input: "192.0.2.1", expected: "192.0.2.1"},
+		{recordType: "AAAA", input: "2001:DB8:0:0::1", expected: "2001:db8::1"},
+		{recordType: "CNAME", input: "Target.Example.com.", expected: "target.example.com"},
+		{recordType: "MX", input: "010   mail.example.com", expected: "10 mail.example.com"},
+		{recordType: "SRV", input: "1 2 443 svc.example.com.", expected: "1 2 443 svc.example.com"},
+		{recordType: "TXT", input: `"a\\b" "c\"d" "\233"`, expected: `"a\\b" "c\"d" "\233"`},
+		{recordType: "TXT", input: `unquoted`, expected: `"unquoted"`},
+		{recordType: "CAA", input: `0 ISSUE "ca.example.net; account=1"`, expected: `0 issue "ca.example.net; account=1"`},
+	}
+
+	for _, tc := range cases {
+		fields, err := ParseRecordData(tc.recordType, tc.input, "")
+		if err != nil {
+			t.Fatalf("%s %q: parsing: %+v", tc.recordType, tc.input, err)
+		}
+
+		actual := FormatRecordData(tc.recordType, fields)
+		if actual != tc.expected {
+			t.Fatalf("%s %q: expected %q but got %q", tc.recordType, tc.input, tc.expected, actual)
+		}
+
+		// the canonical form must be stable
+		fields, err = ParseRecordData(tc.recordType, actual, "")
+		if err != nil {
+			t.Fatalf("%s %q: parsing canonical form: %+v", tc.recordType, actual, err)
+		}
+		if again := FormatRecordData(tc.recordType, fields); again != actual {
+			t.Fatalf("%s %q: expected the canonical form to be stable but got %q", tc.recordType, actual, again)
+		}
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	cases := map[string]int64{
+		"0":     0,
+		"3600":  3600,
+		"1h30m": 5400,
+		"1W":    604800,
+		"2d12h": 216000,
+	}
+
+	for input, expected := range cases {
+		actual, err := parseTTL(input)
+		if err != nil {
+			t.Fatalf("%q: %+v", input, err)
+		}
+		if actual != expected {
+			t.Fatalf("%q: expected %d but got %d", input, expected, actual)
+		}
+	}
+}
@@ -3,11 +3,13 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2021-08-01/firewallpolicies"
 )
 
 type Client struct {
 	AzureFirewallsClient          *network.AzureFirewallsClient
 	FirewallPolicyClient          *network.FirewallPoliciesClient
+	FirewallPoliciesClient        *firewallpolicies.FirewallPoliciesClient
 	FirewallPolicyRuleGroupClient *network.FirewallPolicyRuleCollectionGroupsClient
 }
 
@@ -18,12 +20,16 @@ func NewClient(o *common.ClientOptions) *Client {
 	policyClient := network.NewFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&policyClient.Client, o.ResourceManagerAuthorizer)
 
+	policiesClient := firewallpolicies.NewFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&policiesClient.Client, o.ResourceManagerAuthorizer)
+
 	policyRuleGroupClient := network.NewFirewallPolicyRuleCollectionGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&policyRuleGroupClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
 		AzureFirewallsClient:          &firewallsClient,
 		FirewallPolicyClient:          &policyClient,
+		FirewallPoliciesClient:        &policiesClient,
 		FirewallPolicyRuleGroupClient: &policyRuleGroupClient,
 	}
 }
@@ -152,8 +152,9 @@ func resourceFirewallApplicationRuleCollectionCreateUpdate(d *pluginsdk.Resource
 		return fmt.Errorf("expanding Firewall Application Rules: %+v", err)
 	}
 
-	locks.ByName(firewallName, azureFirewallResourceName)
-	defer locks.UnlockByName(firewallName, azureFirewallResourceName)
+	firewallId := parse.NewFirewallID(meta.(*clients.Client).Account.SubscriptionId, resourceGroup, firewallName)
+	locks.ByID(firewallId.ID())
+	defer locks.UnlockByID(firewallId.ID())
 
 	firewall, err := client.Get(ctx, resourceGroup, firewallName)
 	if err != nil {
@@ -329,8 +330,9 @@ func resourceFirewallApplicationRuleCollectionDelete(d *pluginsdk.ResourceData,
 		return err
 	}
 
-	locks.ByName(id.AzureFirewallName, azureFirewallResourceName)
-	defer locks.UnlockByName(id.AzureFirewallName, azureFirewallResourceName)
+	firewallId := parse.NewFirewallID(id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName)
+	locks.ByID(firewallId.ID())
+	defer locks.UnlockByID(firewallId.ID())
 
 	firewall, err := client.Get(ctx, id.ResourceGroup, id.AzureFirewallName)
 	if err != nil {
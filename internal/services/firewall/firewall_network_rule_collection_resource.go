@@ -150,8 +150,9 @@ func resourceFirewallNetworkRuleCollectionCreateUpdate(d *pluginsdk.ResourceData
 	firewallName := d.Get("azure_firewall_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(firewallName, azureFirewallResourceName)
-	defer locks.UnlockByName(firewallName, azureFirewallResourceName)
+	firewallId := parse.NewFirewallID(meta.(*clients.Client).Account.SubscriptionId, resourceGroup, firewallName)
+	locks.ByID(firewallId.ID())
+	defer locks.UnlockByID(firewallId.ID())
 
 	firewall, err := client.Get(ctx, resourceGroup, firewallName)
 	if err != nil {
@@ -333,8 +334,9 @@ func resourceFirewallNetworkRuleCollectionDelete(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(id.AzureFirewallName, azureFirewallResourceName)
-	defer locks.UnlockByName(id.AzureFirewallName, azureFirewallResourceName)
+	firewallId := parse.NewFirewallID(id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName)
+	locks.ByID(firewallId.ID())
+	defer locks.UnlockByID(firewallId.ID())
 
 	firewall, err := client.Get(ctx, id.ResourceGroup, id.AzureFirewallName)
 	if err != nil {
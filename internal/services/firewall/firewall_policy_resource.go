@@ -1,6 +1,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -15,7 +16,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2021-08-01/firewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	logAnalytiscValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
 	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -25,8 +28,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-const azureFirewallPolicyResourceName = "azurerm_firewall_policy"
-
 func resourceFirewallPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceFirewallPolicyCreateUpdate,
@@ -46,6 +47,8 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(firewallPolicyCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -161,6 +164,17 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 							}, false),
 							Optional: true,
 						},
+						"private_ranges": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.Any(
+									validation.IsCIDR,
+									validation.IsIPv4Address,
+								),
+							},
+						},
 						"signature_overrides": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
@@ -291,12 +305,51 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"key_vault_secret_id": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 						},
 						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"explicit_proxy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"http_port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 64000),
+						},
+						"https_port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 64000),
+						},
+						"pac_file_port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 64000),
+							RequiredWith: []string{"explicit_proxy.0.pac_file"},
+						},
+						"pac_file": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							RequiredWith: []string{"explicit_proxy.0.pac_file_port"},
 						},
 					},
 				},
@@ -384,6 +437,7 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 
 func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	policiesClient := meta.(*clients.Client).Firewall.FirewallPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -405,12 +459,13 @@ func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 
 	props := network.FirewallPolicy{
 		FirewallPolicyPropertiesFormat: &network.FirewallPolicyPropertiesFormat{
-			ThreatIntelMode:      network.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string)),
-			ThreatIntelWhitelist: expandFirewallPolicyThreatIntelWhitelist(d.Get("threat_intelligence_allowlist").([]interface{})),
-			DNSSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
-			IntrusionDetection:   expandFirewallPolicyIntrusionDetection(d.Get("intrusion_detection").([]interface{})),
-			TransportSecurity:    expandFirewallPolicyTransportSecurity(d.Get("tls_certificate").([]interface{})),
-			Insights:             expandFirewallPolicyInsights(d.Get("insights").([]interface{})),
+			ThreatIntelMode:       network.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string)),
+			ThreatIntelWhitelist:  expandFirewallPolicyThreatIntelWhitelist(d.Get("threat_intelligence_allowlist").([]interface{})),
+			DNSSettings:           expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
+			IntrusionDetection:    expandFirewallPolicyIntrusionDetection(d.Get("intrusion_detection").([]interface{})),
+			TransportSecurity:     expandFirewallPolicyTransportSecurity(d.Get("tls_certificate").([]interface{})),
+			ExplicitProxySettings: expandFirewallPolicyExplicitProxy(d.Get("explicit_proxy").([]interface{})),
+			Insights:              expandFirewallPolicyInsights(d.Get("insights").([]interface{})),
 		},
 		Identity: expandFirewallPolicyIdentity(d.Get("identity").([]interface{})),
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
@@ -433,10 +488,16 @@ func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
-	locks.ByName(name, azureFirewallPolicyResourceName)
-	defer locks.UnlockByName(name, azureFirewallPolicyResourceName)
+	id := parse.NewFirewallPolicyID(meta.(*clients.Client).Account.SubscriptionId, resourceGroup, name)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
-	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, props); err != nil {
+	// the private ranges used by Intrusion Detection are only available in a newer API version than the SDK
+	policy := firewallpolicies.FirewallPolicy{
+		FirewallPolicy:                  props,
+		IntrusionDetectionPrivateRanges: expandFirewallPolicyIntrusionDetectionPrivateRanges(d.Get("intrusion_detection").([]interface{})),
+	}
+	if err := policiesClient.CreateOrUpdateThenPoll(ctx, id, policy); err != nil {
 		return fmt.Errorf("creating Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
@@ -453,7 +514,7 @@ func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 }
 
 func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	client := meta.(*clients.Client).Firewall.FirewallPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -462,9 +523,9 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := client.Get(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] Firewall Policy %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
 			d.SetId("")
 			return nil
@@ -473,14 +534,19 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("retrieving Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
+	if resp.Model == nil {
+		return fmt.Errorf("retrieving Firewall Policy %q (Resource Group %q): model was nil", id.Name, id.ResourceGroup)
+	}
+	policy := *resp.Model
+
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("location", location.NormalizeNilable(policy.Location))
 
-	if prop := resp.FirewallPolicyPropertiesFormat; prop != nil {
+	if prop := policy.FirewallPolicyPropertiesFormat; prop != nil {
 		basePolicyID := ""
-		if resp.BasePolicy != nil && resp.BasePolicy.ID != nil {
-			basePolicyID = *resp.BasePolicy.ID
+		if policy.BasePolicy != nil && policy.BasePolicy.ID != nil {
+			basePolicyID = *policy.BasePolicy.ID
 		}
 		d.Set("base_policy_id", basePolicyID)
 
@@ -490,7 +556,7 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 			d.Set("sku", string(sku.Tier))
 		}
 
-		if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(resp.ThreatIntelWhitelist)); err != nil {
+		if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(policy.ThreatIntelWhitelist)); err != nil {
 			return fmt.Errorf(`setting "threat_intelligence_allowlist": %+v`, err)
 		}
 
@@ -498,7 +564,7 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf(`setting "dns": %+v`, err)
 		}
 
-		if err := d.Set("intrusion_detection", flattenFirewallPolicyIntrusionDetection(resp.IntrusionDetection)); err != nil {
+		if err := d.Set("intrusion_detection", flattenFirewallPolicyIntrusionDetection(prop.IntrusionDetection, policy.IntrusionDetectionPrivateRanges)); err != nil {
 			return fmt.Errorf(`setting "intrusion_detection": %+v`, err)
 		}
 
@@ -506,6 +572,10 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf(`setting "tls_certificate": %+v`, err)
 		}
 
+		if err := d.Set("explicit_proxy", flattenFirewallPolicyExplicitProxy(prop.ExplicitProxySettings)); err != nil {
+			return fmt.Errorf(`setting "explicit_proxy": %+v`, err)
+		}
+
 		if err := d.Set("child_policies", flattenNetworkSubResourceID(prop.ChildPolicies)); err != nil {
 			return fmt.Errorf(`setting "child_policies": %+v`, err)
 		}
@@ -531,12 +601,12 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	if err := d.Set("identity", flattenFirewallPolicyIdentity(resp.Identity)); err != nil {
+	if err := d.Set("identity", flattenFirewallPolicyIdentity(policy.Identity)); err != nil {
 		return fmt.Errorf("flattening identity on Firewall Policy %q (Resource Group %q): %+v",
 			id.Name, id.ResourceGroup, err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(d, policy.Tags)
 }
 
 func resourceFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -549,8 +619,8 @@ func resourceFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
-	locks.ByName(id.Name, azureFirewallPolicyResourceName)
-	defer locks.UnlockByName(id.Name, azureFirewallPolicyResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -565,6 +635,24 @@ func resourceFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	return nil
 }
 
+func firewallPolicyCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	// `sku` is empty rather than `Standard` when omitted from a new resource, so it has to be set explicitly
+	if diff.Get("sku").(string) != string(network.FirewallPolicySkuTierPremium) {
+		for _, key := range []string{"intrusion_detection", "tls_certificate"} {
+			if len(diff.Get(key).([]interface{})) > 0 {
+				return fmt.Errorf("`%s` can only be specified when `sku` is explicitly set to `%s`", key, string(network.FirewallPolicySkuTierPremium))
+			}
+		}
+	}
+
+	// the CA certificate used for TLS inspection is retrieved from the Key Vault using the User Assigned Identity
+	if len(diff.Get("tls_certificate").([]interface{})) > 0 && len(diff.Get("identity").([]interface{})) == 0 {
+		return fmt.Errorf("an `identity` block must be specified when `tls_certificate` is specified")
+	}
+
+	return nil
+}
+
 func expandFirewallPolicyThreatIntelWhitelist(input []interface{}) *network.FirewallPolicyThreatIntelWhitelist {
 	if len(input) == 0 || input[0] == nil {
 		return nil
@@ -634,6 +722,15 @@ func expandFirewallPolicyIntrusionDetection(input []interface{}) *network.Firewa
 	}
 }
 
+func expandFirewallPolicyIntrusionDetectionPrivateRanges(input []interface{}) *[]string {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return utils.ExpandStringSlice(raw["private_ranges"].([]interface{}))
+}
+
 func expandFirewallPolicyTransportSecurity(input []interface{}) *network.FirewallPolicyTransportSecurity {
 	if len(input) == 0 || input[0] == nil {
 		return nil
@@ -649,6 +746,35 @@ func expandFirewallPolicyTransportSecurity(input []interface{}) *network.Firewal
 	}
 }
 
+func expandFirewallPolicyExplicitProxy(input []interface{}) *network.ExplicitProxySettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := &network.ExplicitProxySettings{
+		EnableExplicitProxy: utils.Bool(raw["enabled"].(bool)),
+	}
+
+	if v := raw["http_port"].(int); v != 0 {
+		output.HTTPPort = utils.Int32(int32(v))
+	}
+
+	if v := raw["https_port"].(int); v != 0 {
+		output.HTTPSPort = utils.Int32(int32(v))
+	}
+
+	if v := raw["pac_file_port"].(int); v != 0 {
+		output.PacFilePort = utils.Int32(int32(v))
+	}
+
+	if v := raw["pac_file"].(string); v != "" {
+		output.PacFile = utils.String(v)
+	}
+
+	return output
+}
+
 func expandFirewallPolicyIdentity(input []interface{}) *network.ManagedServiceIdentity {
 	if len(input) == 0 {
 		return nil
@@ -746,7 +872,7 @@ func flattenFirewallPolicyDNSSetting(input *network.DNSSettings) []interface{} {
 	}
 }
 
-func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrusionDetection) []interface{} {
+func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrusionDetection, privateRanges *[]string) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
@@ -758,6 +884,7 @@ func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrus
 		return []interface{}{
 			map[string]interface{}{
 				"mode":                string(input.Mode),
+				"private_ranges":      utils.FlattenStringSlice(privateRanges),
 				"signature_overrides": signatureOverrides,
 				"traffic_bypass":      trafficBypass,
 			},
@@ -830,6 +957,7 @@ func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrus
 	return []interface{}{
 		map[string]interface{}{
 			"mode":                string(input.Mode),
+			"private_ranges":      utils.FlattenStringSlice(privateRanges),
 			"signature_overrides": signatureOverrides,
 			"traffic_bypass":      trafficBypass,
 		},
@@ -849,6 +977,47 @@ func flattenFirewallPolicyTransportSecurity(input *network.FirewallPolicyTranspo
 	}
 }
 
+func flattenFirewallPolicyExplicitProxy(input *network.ExplicitProxySettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if input.EnableExplicitProxy != nil {
+		enabled = *input.EnableExplicitProxy
+	}
+
+	httpPort := 0
+	if input.HTTPPort != nil {
+		httpPort = int(*input.HTTPPort)
+	}
+
+	httpsPort := 0
+	if input.HTTPSPort != nil {
+		httpsPort = int(*input.HTTPSPort)
+	}
+
+	pacFilePort := 0
+	if input.PacFilePort != nil {
+		pacFilePort = int(*input.PacFilePort)
+	}
+
+	pacFile := ""
+	if input.PacFile != nil {
+		pacFile = *input.PacFile
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":       enabled,
+			"http_port":     httpPort,
+			"https_port":    httpsPort,
+			"pac_file_port": pacFilePort,
+			"pac_file":      pacFile,
+		},
+	}
+}
+
 func flattenFirewallPolicyIdentity(identity *network.ManagedServiceIdentity) []interface{} {
 	if identity == nil {
 		return []interface{}{}
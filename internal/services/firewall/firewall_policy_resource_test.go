@@ -3,6 +3,7 @@ package firewall_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -149,6 +150,42 @@ func TestAccFirewallPolicy_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicy_explicitProxy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.explicitProxy(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("explicit_proxy.0.enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.explicitProxy(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("explicit_proxy.0.enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicy_premiumFeaturesWithStandardSku(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.premiumFeaturesWithStandardSku(data),
+			ExpectError: regexp.MustCompile("`intrusion_detection` can only be specified when `sku` is set to `Premium`"),
+		},
+	})
+}
+
 func TestAccFirewallPolicy_inherit(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
 	r := FirewallPolicyResource{}
@@ -279,7 +316,8 @@ resource "azurerm_firewall_policy" "test" {
     proxy_enabled = true
   }
   intrusion_detection {
-    mode = "Alert"
+    mode           = "Alert"
+    private_ranges = ["10.0.0.0/8", "172.16.0.0/12"]
     signature_overrides {
       state = "Alert"
       id    = "1"
@@ -307,6 +345,11 @@ resource "azurerm_firewall_policy" "test" {
     key_vault_secret_id = azurerm_key_vault_certificate.test.secret_id
     name                = azurerm_key_vault_certificate.test.name
   }
+  explicit_proxy {
+    enabled    = true
+    http_port  = 8087
+    https_port = 8088
+  }
   private_ip_ranges = ["172.16.0.0/12", "192.168.0.0/16"]
   tags = {
     env = "Test"
@@ -315,6 +358,42 @@ resource "azurerm_firewall_policy" "test" {
 `, template, data.RandomInteger)
 }
 
+func (FirewallPolicyResource) explicitProxy(data acceptance.TestData, enabled bool) string {
+	r := FirewallPolicyResource{}
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-networkfw-Policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Standard"
+  explicit_proxy {
+    enabled    = %t
+    http_port  = 8087
+    https_port = 8088
+  }
+}
+`, template, data.RandomInteger, enabled)
+}
+
+func (FirewallPolicyResource) premiumFeaturesWithStandardSku(data acceptance.TestData) string {
+	r := FirewallPolicyResource{}
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-networkfw-Policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Standard"
+  intrusion_detection {
+    mode = "Alert"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (FirewallPolicyResource) requiresImport(data acceptance.TestData) string {
 	r := FirewallPolicyResource{}
 	template := r.basic(data)
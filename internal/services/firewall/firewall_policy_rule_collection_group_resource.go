@@ -441,8 +441,8 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 		}
 	}
 
-	locks.ByName(policyId.Name, azureFirewallPolicyResourceName)
-	defer locks.UnlockByName(policyId.Name, azureFirewallPolicyResourceName)
+	locks.ByID(policyId.ID())
+	defer locks.UnlockByID(policyId.ID())
 
 	param := network.FirewallPolicyRuleCollectionGroup{
 		FirewallPolicyRuleCollectionGroupProperties: &network.FirewallPolicyRuleCollectionGroupProperties{
@@ -539,8 +539,9 @@ func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData,
 		return err
 	}
 
-	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
-	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	policyId := parse.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName)
+	locks.ByID(policyId.ID())
+	defer locks.UnlockByID(policyId.ID())
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
 	if err != nil {
@@ -22,8 +22,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-var azureFirewallResourceName = "azurerm_firewall"
-
 func resourceFirewall() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceFirewallCreateUpdate,
@@ -265,17 +263,17 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 
 	m := d.Get("management_ip_configuration").([]interface{})
 	if len(m) == 1 {
-		mgmtIPConfig, mgmtSubnetName, mgmtVirtualNetworkName, err := expandFirewallIPConfigurations(m)
+		mgmtIPConfig, mgmtSubnetID, mgmtVirtualNetworkID, err := expandFirewallIPConfigurations(m)
 		if err != nil {
 			return fmt.Errorf("parsing Azure Firewall Management IP Configurations: %+v", err)
 		}
 
-		if !utils.SliceContainsValue(*subnetToLock, (*mgmtSubnetName)[0]) {
-			*subnetToLock = append(*subnetToLock, (*mgmtSubnetName)[0])
+		if !utils.SliceContainsValue(*subnetToLock, (*mgmtSubnetID)[0]) {
+			*subnetToLock = append(*subnetToLock, (*mgmtSubnetID)[0])
 		}
 
-		if !utils.SliceContainsValue(*vnetToLock, (*mgmtVirtualNetworkName)[0]) {
-			*vnetToLock = append(*vnetToLock, (*mgmtVirtualNetworkName)[0])
+		if !utils.SliceContainsValue(*vnetToLock, (*mgmtVirtualNetworkID)[0]) {
+			*vnetToLock = append(*vnetToLock, (*mgmtVirtualNetworkID)[0])
 		}
 		if *mgmtIPConfig != nil {
 			parameters.ManagementIPConfiguration = &(*mgmtIPConfig)[0]
@@ -324,14 +322,16 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	locks.ByName(id.AzureFirewallName, azureFirewallResourceName)
-	defer locks.UnlockByName(id.AzureFirewallName, azureFirewallResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
-	locks.MultipleByName(vnetToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetToLock, VirtualNetworkResourceName)
+	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+		locks.MultipleByID(vnetToLock)
+		defer locks.UnlockMultipleByID(vnetToLock)
+	}
 
-	locks.MultipleByName(subnetToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetToLock, SubnetResourceName)
+	locks.MultipleByID(subnetToLock)
+	defer locks.UnlockMultipleByID(subnetToLock)
 
 	if !d.IsNewResource() {
 		exists, err2 := client.Get(ctx, id.ResourceGroup, id.AzureFirewallName)
@@ -456,8 +456,8 @@ func resourceFirewallDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("retrieving Firewall %s : %+v", *id, err)
 	}
 
-	subnetNamesToLock := make([]string, 0)
-	virtualNetworkNamesToLock := make([]string, 0)
+	subnetIDsToLock := make([]string, 0)
+	virtualNetworkIDsToLock := make([]string, 0)
 	if props := read.AzureFirewallPropertiesFormat; props != nil {
 		if configs := props.IPConfigurations; configs != nil {
 			for _, config := range *configs {
@@ -470,12 +470,13 @@ func resourceFirewallDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 					return err2
 				}
 
-				if !utils.SliceContainsValue(subnetNamesToLock, parsedSubnetID.Name) {
-					subnetNamesToLock = append(subnetNamesToLock, parsedSubnetID.Name)
+				if !utils.SliceContainsValue(subnetIDsToLock, parsedSubnetID.ID()) {
+					subnetIDsToLock = append(subnetIDsToLock, parsedSubnetID.ID())
 				}
 
-				if !utils.SliceContainsValue(virtualNetworkNamesToLock, parsedSubnetID.VirtualNetworkName) {
-					virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, parsedSubnetID.VirtualNetworkName)
+				virtualNetworkID := networkParse.NewVirtualNetworkID(parsedSubnetID.SubscriptionId, parsedSubnetID.ResourceGroup, parsedSubnetID.VirtualNetworkName).ID()
+				if !utils.SliceContainsValue(virtualNetworkIDsToLock, virtualNetworkID) {
+					virtualNetworkIDsToLock = append(virtualNetworkIDsToLock, virtualNetworkID)
 				}
 			}
 		}
@@ -487,25 +488,28 @@ func resourceFirewallDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 					return err2
 				}
 
-				if !utils.SliceContainsValue(subnetNamesToLock, parsedSubnetID.Name) {
-					subnetNamesToLock = append(subnetNamesToLock, parsedSubnetID.Name)
+				if !utils.SliceContainsValue(subnetIDsToLock, parsedSubnetID.ID()) {
+					subnetIDsToLock = append(subnetIDsToLock, parsedSubnetID.ID())
 				}
 
-				if !utils.SliceContainsValue(virtualNetworkNamesToLock, parsedSubnetID.VirtualNetworkName) {
-					virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, parsedSubnetID.VirtualNetworkName)
+				virtualNetworkID := networkParse.NewVirtualNetworkID(parsedSubnetID.SubscriptionId, parsedSubnetID.ResourceGroup, parsedSubnetID.VirtualNetworkName).ID()
+				if !utils.SliceContainsValue(virtualNetworkIDsToLock, virtualNetworkID) {
+					virtualNetworkIDsToLock = append(virtualNetworkIDsToLock, virtualNetworkID)
 				}
 			}
 		}
 	}
 
-	locks.ByName(id.AzureFirewallName, azureFirewallResourceName)
-	defer locks.UnlockByName(id.AzureFirewallName, azureFirewallResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
-	locks.MultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
+	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+		locks.MultipleByID(&virtualNetworkIDsToLock)
+		defer locks.UnlockMultipleByID(&virtualNetworkIDsToLock)
+	}
 
-	locks.MultipleByName(&subnetNamesToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(&subnetNamesToLock, SubnetResourceName)
+	locks.MultipleByID(&subnetIDsToLock)
+	defer locks.UnlockMultipleByID(&subnetIDsToLock)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.AzureFirewallName)
 	if err != nil {
@@ -521,8 +525,8 @@ func resourceFirewallDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 
 func expandFirewallIPConfigurations(configs []interface{}) (*[]network.AzureFirewallIPConfiguration, *[]string, *[]string, error) {
 	ipConfigs := make([]network.AzureFirewallIPConfiguration, 0)
-	subnetNamesToLock := make([]string, 0)
-	virtualNetworkNamesToLock := make([]string, 0)
+	subnetIDsToLock := make([]string, 0)
+	virtualNetworkIDsToLock := make([]string, 0)
 
 	for _, configRaw := range configs {
 		data := configRaw.(map[string]interface{})
@@ -545,12 +549,13 @@ func expandFirewallIPConfigurations(configs []interface{}) (*[]network.AzureFire
 				return nil, nil, nil, err
 			}
 
-			if !utils.SliceContainsValue(subnetNamesToLock, subnetID.Name) {
-				subnetNamesToLock = append(subnetNamesToLock, subnetID.Name)
+			if !utils.SliceContainsValue(subnetIDsToLock, subnetID.ID()) {
+				subnetIDsToLock = append(subnetIDsToLock, subnetID.ID())
 			}
 
-			if !utils.SliceContainsValue(virtualNetworkNamesToLock, subnetID.VirtualNetworkName) {
-				virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, subnetID.VirtualNetworkName)
+			virtualNetworkID := networkParse.NewVirtualNetworkID(subnetID.SubscriptionId, subnetID.ResourceGroup, subnetID.VirtualNetworkName).ID()
+			if !utils.SliceContainsValue(virtualNetworkIDsToLock, virtualNetworkID) {
+				virtualNetworkIDsToLock = append(virtualNetworkIDsToLock, virtualNetworkID)
 			}
 
 			ipConfig.AzureFirewallIPConfigurationPropertiesFormat.Subnet = &network.SubResource{
@@ -559,7 +564,7 @@ func expandFirewallIPConfigurations(configs []interface{}) (*[]network.AzureFire
 		}
 		ipConfigs = append(ipConfigs, ipConfig)
 	}
-	return &ipConfigs, &subnetNamesToLock, &virtualNetworkNamesToLock, nil
+	return &ipConfigs, &subnetIDsToLock, &virtualNetworkIDsToLock, nil
 }
 
 func flattenFirewallIPConfigurations(input *[]network.AzureFirewallIPConfiguration) []interface{} {
@@ -0,0 +1,101 @@
+package firewallpolicies
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// ResourceId is the ID of a Firewall Policy, such as the ID within the `parse` package
+type ResourceId interface {
+	ID() string
+}
+
+type FirewallPoliciesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFirewallPoliciesClientWithBaseURI(endpoint string) FirewallPoliciesClient {
+	return FirewallPoliciesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
+
+type GetResponse struct {
+	HttpResponse *http.Response
+	Model        *FirewallPolicy
+}
+
+// CreateOrUpdateThenPoll performs a PUT of `input` to the specified ID, then polls until the operation has completed
+func (c FirewallPoliciesClient) CreateOrUpdateThenPoll(ctx context.Context, id ResourceId, input FirewallPolicy) error {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// Get retrieves the Firewall Policy with the specified ID
+func (c FirewallPoliciesClient) Get(ctx context.Context, id ResourceId) (result GetResponse, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
@@ -0,0 +1,73 @@
+package firewallpolicies
+
+import (
+	"encoding/json"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+)
+
+// FirewallPolicy is the Firewall Policy from the 2021-05-01 SDK, extended with the fields which were introduced
+// in the 2021-08-01 API
+type FirewallPolicy struct {
+	network.FirewallPolicy
+
+	// IntrusionDetectionPrivateRanges is `properties.intrusionDetection.configuration.privateRanges`, the IP
+	// Address ranges which Intrusion Detection treats as private
+	IntrusionDetectionPrivateRanges *[]string
+}
+
+func (fp FirewallPolicy) MarshalJSON() ([]byte, error) {
+	body, err := json.Marshal(fp.FirewallPolicy)
+	if err != nil || fp.IntrusionDetectionPrivateRanges == nil {
+		return body, err
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	properties := childObject(raw, "properties")
+	intrusionDetection := childObject(properties, "intrusionDetection")
+	configuration := childObject(intrusionDetection, "configuration")
+	configuration["privateRanges"] = *fp.IntrusionDetectionPrivateRanges
+
+	return json.Marshal(raw)
+}
+
+func (fp *FirewallPolicy) UnmarshalJSON(body []byte) error {
+	if err := json.Unmarshal(body, &fp.FirewallPolicy); err != nil {
+		return err
+	}
+
+	var raw struct {
+		Properties *struct {
+			IntrusionDetection *struct {
+				Configuration *struct {
+					PrivateRanges *[]string `json:"privateRanges"`
+				} `json:"configuration"`
+			} `json:"intrusionDetection"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return err
+	}
+
+	fp.IntrusionDetectionPrivateRanges = nil
+	if props := raw.Properties; props != nil && props.IntrusionDetection != nil && props.IntrusionDetection.Configuration != nil {
+		fp.IntrusionDetectionPrivateRanges = props.IntrusionDetection.Configuration.PrivateRanges
+	}
+
+	return nil
+}
+
+// childObject returns the nested object with the specified key, creating it if it doesn't exist
+func childObject(input map[string]interface{}, key string) map[string]interface{} {
+	if v, ok := input[key].(map[string]interface{}); ok {
+		return v
+	}
+
+	output := make(map[string]interface{})
+	input[key] = output
+	return output
+}
@@ -0,0 +1,70 @@
+package firewallpolicies
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+)
+
+func TestFirewallPolicyMarshalPrivateRanges(t *testing.T) {
+	input := FirewallPolicy{
+		FirewallPolicy: network.FirewallPolicy{
+			FirewallPolicyPropertiesFormat: &network.FirewallPolicyPropertiesFormat{
+				IntrusionDetection: &network.FirewallPolicyIntrusionDetection{
+					Mode: network.FirewallPolicyIntrusionDetectionStateTypeAlert,
+				},
+			},
+		},
+		IntrusionDetectionPrivateRanges: &[]string{"10.0.0.0/8", "192.168.0.0/16"},
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+
+	var actual FirewallPolicy
+	if err := json.Unmarshal(body, &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if actual.IntrusionDetectionPrivateRanges == nil || !reflect.DeepEqual(*actual.IntrusionDetectionPrivateRanges, *input.IntrusionDetectionPrivateRanges) {
+		t.Fatalf("expected the private ranges %+v but got %+v", *input.IntrusionDetectionPrivateRanges, actual.IntrusionDetectionPrivateRanges)
+	}
+	if actual.FirewallPolicyPropertiesFormat == nil || actual.IntrusionDetection == nil || actual.IntrusionDetection.Mode != network.FirewallPolicyIntrusionDetectionStateTypeAlert {
+		t.Fatalf("expected the intrusion detection mode to be retained but got %s", string(body))
+	}
+}
+
+func TestFirewallPolicyMarshalWithoutPrivateRanges(t *testing.T) {
+	input := FirewallPolicy{
+		FirewallPolicy: network.FirewallPolicy{
+			FirewallPolicyPropertiesFormat: &network.FirewallPolicyPropertiesFormat{
+				ThreatIntelMode: network.AzureFirewallThreatIntelModeAlert,
+			},
+		},
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+
+	expected, err := json.Marshal(input.FirewallPolicy)
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+	if string(body) != string(expected) {
+		t.Fatalf("expected %s but got %s", string(expected), string(body))
+	}
+
+	var actual FirewallPolicy
+	if err := json.Unmarshal(body, &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+	if actual.IntrusionDetectionPrivateRanges != nil {
+		t.Fatalf("expected no private ranges but got %+v", *actual.IntrusionDetectionPrivateRanges)
+	}
+}
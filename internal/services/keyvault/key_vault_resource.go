@@ -22,7 +22,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -341,19 +340,20 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
-	virtualNetworkNames := make([]string, 0)
+	virtualNetworkIDs := make([]string, 0)
 	for _, v := range subnetIds {
 		id, err := networkParse.SubnetIDInsensitively(v)
 		if err != nil {
 			return err
 		}
-		if !utils.SliceContainsValue(virtualNetworkNames, id.VirtualNetworkName) {
-			virtualNetworkNames = append(virtualNetworkNames, id.VirtualNetworkName)
+		virtualNetworkId := networkParse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName).ID()
+		if !utils.SliceContainsValue(virtualNetworkIDs, virtualNetworkId) {
+			virtualNetworkIDs = append(virtualNetworkIDs, virtualNetworkId)
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	locks.MultipleByID(&virtualNetworkIDs)
+	defer locks.UnlockMultipleByID(&virtualNetworkIDs)
 
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -483,20 +483,21 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		networkAcls, subnetIds := expandKeyVaultNetworkAcls(networkAclsRaw)
 
 		// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
-		virtualNetworkNames := make([]string, 0)
+		virtualNetworkIDs := make([]string, 0)
 		for _, v := range subnetIds {
 			id, err := networkParse.SubnetIDInsensitively(v)
 			if err != nil {
 				return err
 			}
 
-			if !utils.SliceContainsValue(virtualNetworkNames, id.VirtualNetworkName) {
-				virtualNetworkNames = append(virtualNetworkNames, id.VirtualNetworkName)
+			virtualNetworkId := networkParse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName).ID()
+			if !utils.SliceContainsValue(virtualNetworkIDs, virtualNetworkId) {
+				virtualNetworkIDs = append(virtualNetworkIDs, virtualNetworkId)
 			}
 		}
 
-		locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-		defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+		locks.MultipleByID(&virtualNetworkIDs)
+		defer locks.UnlockMultipleByID(&virtualNetworkIDs)
 
 		update.Properties.NetworkAcls = networkAcls
 	}
@@ -728,7 +729,7 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	// ensure we lock on the latest network names, to ensure we handle Azure's networking layer being limited to one change at a time
-	virtualNetworkNames := make([]string, 0)
+	virtualNetworkIDs := make([]string, 0)
 	if props := read.Properties; props != nil {
 		if acls := props.NetworkAcls; acls != nil {
 			if rules := acls.VirtualNetworkRules; rules != nil {
@@ -742,16 +743,17 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 						return err
 					}
 
-					if !utils.SliceContainsValue(virtualNetworkNames, subnetId.VirtualNetworkName) {
-						virtualNetworkNames = append(virtualNetworkNames, subnetId.VirtualNetworkName)
+					virtualNetworkId := networkParse.NewVirtualNetworkID(subnetId.SubscriptionId, subnetId.ResourceGroup, subnetId.VirtualNetworkName).ID()
+					if !utils.SliceContainsValue(virtualNetworkIDs, virtualNetworkId) {
+						virtualNetworkIDs = append(virtualNetworkIDs, virtualNetworkId)
 					}
 				}
 			}
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	locks.MultipleByID(&virtualNetworkIDs)
+	defer locks.UnlockMultipleByID(&virtualNetworkIDs)
 
 	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
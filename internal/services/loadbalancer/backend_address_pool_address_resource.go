@@ -86,8 +86,8 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(poolId.BackendAddressPoolName, backendAddressPoolResourceName)
-			defer locks.UnlockByName(poolId.BackendAddressPoolName, backendAddressPoolResourceName)
+			locks.ByID(poolId.ID())
+			defer locks.UnlockByID(poolId.ID())
 
 			// Backend Addresses can not be created for Basic sku, so we have to check
 			lb, err := metadata.Client.LoadBalancers.LoadBalancersClient.Get(ctx, poolId.ResourceGroup, poolId.LoadBalancerName, "")
@@ -219,8 +219,8 @@ func (r BackendAddressPoolAddressResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
-			defer locks.UnlockByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
+			locks.ByID(parse.NewLoadBalancerBackendAddressPoolID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName).ID())
+			defer locks.UnlockByID(parse.NewLoadBalancerBackendAddressPoolID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName).ID())
 
 			pool, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
 			if err != nil {
@@ -274,8 +274,8 @@ func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
-			defer locks.UnlockByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
+			locks.ByID(parse.NewLoadBalancerBackendAddressPoolID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName).ID())
+			defer locks.UnlockByID(parse.NewLoadBalancerBackendAddressPoolID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName).ID())
 
 			var model BackendAddressPoolAddressModel
 			if err := metadata.Decode(&model); err != nil {
@@ -22,6 +22,24 @@ func TestAccDataSourceBackendAddressPool_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceBackendAddressPool_gatewaySku(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_lb_backend_address_pool", "test")
+	r := LoadBalancerBackendAddressPool{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.dataSourceGatewaySku(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("tunnel_interface.#").HasValue("1"),
+				check.That(data.ResourceName).Key("tunnel_interface.0.identifier").HasValue("900"),
+				check.That(data.ResourceName).Key("tunnel_interface.0.type").HasValue("Internal"),
+				check.That(data.ResourceName).Key("tunnel_interface.0.protocol").HasValue("VXLAN"),
+				check.That(data.ResourceName).Key("tunnel_interface.0.port").HasValue("15000"),
+			),
+		},
+	})
+}
+
 func (r LoadBalancerBackendAddressPool) dataSourceBasic(data acceptance.TestData) string {
 	resource := r.basicSkuBasic(data)
 	return fmt.Sprintf(`
@@ -33,3 +51,14 @@ data "azurerm_lb_backend_address_pool" "test" {
 }
 `, resource)
 }
+
+func (r LoadBalancerBackendAddressPool) dataSourceGatewaySku(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_lb_backend_address_pool" "test" {
+  name            = azurerm_lb_backend_address_pool.test.name
+  loadbalancer_id = azurerm_lb_backend_address_pool.test.loadbalancer_id
+}
+`, r.gatewaySkuBasic(data))
+}
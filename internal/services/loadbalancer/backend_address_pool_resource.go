@@ -20,8 +20,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-var backendAddressPoolResourceName = "azurerm_lb_backend_address_pool"
-
 func resourceArmLoadBalancerBackendAddressPool() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmLoadBalancerBackendAddressPoolCreateUpdate,
@@ -195,8 +193,8 @@ func resourceArmLoadBalancerBackendAddressPoolCreateUpdate(d *pluginsdk.Resource
 		}
 	}
 
-	locks.ByName(name, backendAddressPoolResourceName)
-	defer locks.UnlockByName(name, backendAddressPoolResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	locks.ByID(loadBalancerId.ID())
 	defer locks.UnlockByID(loadBalancerId.ID())
@@ -388,8 +386,8 @@ func resourceArmLoadBalancerBackendAddressPoolDelete(d *pluginsdk.ResourceData,
 	locks.ByID(loadBalancerID)
 	defer locks.UnlockByID(loadBalancerID)
 
-	locks.ByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
-	defer locks.UnlockByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	lb, err := lbClient.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
 	if err != nil {
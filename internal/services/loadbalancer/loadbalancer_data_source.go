@@ -68,6 +68,11 @@ func dataSourceArmLoadBalancer() *pluginsdk.Resource {
 							Computed: true,
 						},
 
+						"gateway_load_balancer_frontend_ip_configuration_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"private_ip_address_allocation": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -196,6 +201,10 @@ func flattenLoadBalancerDataSourceFrontendIpConfiguration(ipConfigs *[]network.F
 			if pip := props.PublicIPAddress; pip != nil && pip.ID != nil {
 				ipConfig["public_ip_address_id"] = *pip.ID
 			}
+
+			if gwlb := props.GatewayLoadBalancer; gwlb != nil && gwlb.ID != nil {
+				ipConfig["gateway_load_balancer_frontend_ip_configuration_id"] = *gwlb.ID
+			}
 		}
 
 		result = append(result, ipConfig)
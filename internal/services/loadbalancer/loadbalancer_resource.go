@@ -413,6 +413,9 @@ func expandAzureRmLoadBalancerFrontendIpConfigurations(d *pluginsdk.ResourceData
 		}
 
 		if v := data["gateway_load_balancer_frontend_ip_configuration_id"].(string); v != "" {
+			if !strings.EqualFold(sku, string(network.LoadBalancerSkuNameStandard)) {
+				return nil, fmt.Errorf("`gateway_load_balancer_frontend_ip_configuration_id` can only be specified for `Standard` SKU load balancers")
+			}
 			properties.GatewayLoadBalancer = &network.SubResource{
 				ID: utils.String(v),
 			}
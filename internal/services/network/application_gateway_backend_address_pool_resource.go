@@ -0,0 +1,269 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayBackendAddressPool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayBackendAddressPoolCreateUpdate,
+		Read:   resourceApplicationGatewayBackendAddressPoolRead,
+		Update: resourceApplicationGatewayBackendAddressPoolCreateUpdate,
+		Delete: resourceApplicationGatewayBackendAddressPoolDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackendAddressPoolID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.ApplicationGatewayID,
+			},
+
+			"fqdns": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.IPv4Address,
+				},
+			},
+		},
+	}
+}
+
+func resourceApplicationGatewayBackendAddressPoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applicationGatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBackendAddressPoolID(applicationGatewayId.SubscriptionId, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, d.Get("name").(string))
+
+	locks.ByID(applicationGatewayId.ID())
+	defer locks.UnlockByID(applicationGatewayId.ID())
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *applicationGatewayId, err)
+	}
+	if applicationGateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *applicationGatewayId)
+	}
+
+	pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+	if applicationGateway.BackendAddressPools != nil {
+		pools = *applicationGateway.BackendAddressPools
+	}
+
+	pool := expandApplicationGatewayBackendAddressPool(d)
+
+	_, index, exists := findApplicationGatewayBackendAddressPoolByName(&applicationGateway, id.Name)
+	if exists {
+		if d.IsNewResource() {
+			return tf.ImportAsExistsError("azurerm_application_gateway_backend_address_pool", id.ID())
+		}
+
+		pools[index] = pool
+	} else {
+		pools = append(pools, pool)
+	}
+	applicationGateway.BackendAddressPools = &pools
+
+	future, err := client.CreateOrUpdate(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, applicationGateway)
+	if err != nil {
+		return fmt.Errorf("updating %s for %s: %+v", *applicationGatewayId, id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s for %s: %+v", *applicationGatewayId, id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayBackendAddressPoolRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendAddressPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendAddressPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", applicationGatewayId, id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	pool, _, exists := findApplicationGatewayBackendAddressPoolByName(&applicationGateway, id.Name)
+	if !exists {
+		log.Printf("[DEBUG] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", applicationGatewayId.ID())
+
+	ipAddresses := make([]interface{}, 0)
+	fqdns := make([]interface{}, 0)
+	if props := pool.ApplicationGatewayBackendAddressPoolPropertiesFormat; props != nil && props.BackendAddresses != nil {
+		for _, address := range *props.BackendAddresses {
+			if address.IPAddress != nil {
+				ipAddresses = append(ipAddresses, *address.IPAddress)
+			} else if address.Fqdn != nil {
+				fqdns = append(fqdns, *address.Fqdn)
+			}
+		}
+	}
+
+	if err := d.Set("fqdns", fqdns); err != nil {
+		return fmt.Errorf("setting `fqdns`: %+v", err)
+	}
+
+	if err := d.Set("ip_addresses", ipAddresses); err != nil {
+		return fmt.Errorf("setting `ip_addresses`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceApplicationGatewayBackendAddressPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendAddressPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByID(applicationGatewayId.ID())
+	defer locks.UnlockByID(applicationGatewayId.ID())
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	_, index, exists := findApplicationGatewayBackendAddressPoolByName(&applicationGateway, id.Name)
+	if !exists {
+		return nil
+	}
+
+	pools := *applicationGateway.BackendAddressPools
+	pools = append(pools[:index], pools[index+1:]...)
+	applicationGateway.BackendAddressPools = &pools
+
+	future, err := client.CreateOrUpdate(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, applicationGateway)
+	if err != nil {
+		return fmt.Errorf("updating %s for deletion of %s: %+v", applicationGatewayId, *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s for deletion of %s: %+v", applicationGatewayId, *id, err)
+	}
+
+	return nil
+}
+
+func expandApplicationGatewayBackendAddressPool(d *pluginsdk.ResourceData) network.ApplicationGatewayBackendAddressPool {
+	backendAddresses := make([]network.ApplicationGatewayBackendAddress, 0)
+
+	for _, fqdn := range d.Get("fqdns").([]interface{}) {
+		backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+			Fqdn: utils.String(fqdn.(string)),
+		})
+	}
+
+	for _, ip := range d.Get("ip_addresses").([]interface{}) {
+		backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+			IPAddress: utils.String(ip.(string)),
+		})
+	}
+
+	return network.ApplicationGatewayBackendAddressPool{
+		Name: utils.String(d.Get("name").(string)),
+		ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+			BackendAddresses: &backendAddresses,
+		},
+	}
+}
+
+func findApplicationGatewayBackendAddressPoolByName(gateway *network.ApplicationGateway, name string) (*network.ApplicationGatewayBackendAddressPool, int, bool) {
+	if gateway == nil || gateway.ApplicationGatewayPropertiesFormat == nil || gateway.BackendAddressPools == nil {
+		return nil, -1, false
+	}
+
+	for i, pool := range *gateway.BackendAddressPools {
+		if pool.Name != nil && *pool.Name == name {
+			return &pool, i, true
+		}
+	}
+
+	return nil, -1, false
+}
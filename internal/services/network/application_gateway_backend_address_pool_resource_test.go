@@ -0,0 +1,135 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayBackendAddressPoolResource struct{}
+
+func TestAccApplicationGatewayBackendAddressPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool", "test")
+	r := ApplicationGatewayBackendAddressPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_application_gateway.test").Key("backend_address_pool.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayBackendAddressPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool", "test")
+	r := ApplicationGatewayBackendAddressPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayBackendAddressPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool", "test")
+	r := ApplicationGatewayBackendAddressPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_addresses.#").HasValue("2"),
+				check.That(data.ResourceName).Key("fqdns.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t ApplicationGatewayBackendAddressPoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackendAddressPoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Application Gateway %q (Resource Group %q): %+v", id.ApplicationGatewayName, id.ResourceGroup, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.BackendAddressPools != nil {
+		for _, v := range *props.BackendAddressPools {
+			if v.Name != nil && *v.Name == id.Name {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ApplicationGatewayBackendAddressPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool" "test" {
+  name                   = "acctest-beap-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+}
+`, ApplicationGatewayResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool" "import" {
+  name                   = azurerm_application_gateway_backend_address_pool.test.name
+  application_gateway_id = azurerm_application_gateway_backend_address_pool.test.application_gateway_id
+}
+`, r.basic(data))
+}
+
+func (ApplicationGatewayBackendAddressPoolResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool" "test" {
+  name                   = "acctest-beap-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  ip_addresses           = ["10.0.1.4", "10.0.1.5"]
+  fqdns                  = ["backend.example.com"]
+}
+`, ApplicationGatewayResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,446 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayBackendHTTPSettings() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayBackendHTTPSettingsCreateUpdate,
+		Read:   resourceApplicationGatewayBackendHTTPSettingsRead,
+		Update: resourceApplicationGatewayBackendHTTPSettingsCreateUpdate,
+		Delete: resourceApplicationGatewayBackendHTTPSettingsDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackendHttpSettingsCollectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.ApplicationGatewayID,
+			},
+
+			"port": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validate.PortNumber,
+			},
+
+			"protocol": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ProtocolHTTP),
+					string(network.ProtocolHTTPS),
+				}, true),
+			},
+
+			"cookie_based_affinity": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ApplicationGatewayCookieBasedAffinityEnabled),
+					string(network.ApplicationGatewayCookieBasedAffinityDisabled),
+				}, true),
+			},
+
+			"affinity_cookie_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"path": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"host_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"pick_host_name_from_backend_address": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"request_timeout": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 86400),
+			},
+
+			"authentication_certificate_names": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"trusted_root_certificate_names": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"connection_draining": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"drain_timeout_sec": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 3600),
+						},
+					},
+				},
+			},
+
+			"probe_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"probe_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applicationGatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBackendHttpSettingsCollectionID(applicationGatewayId.SubscriptionId, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, d.Get("name").(string))
+
+	if d.Get("host_name").(string) != "" && d.Get("pick_host_name_from_backend_address").(bool) {
+		return fmt.Errorf("only one of `host_name` or `pick_host_name_from_backend_address` can be set")
+	}
+
+	locks.ByID(applicationGatewayId.ID())
+	defer locks.UnlockByID(applicationGatewayId.ID())
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *applicationGatewayId, err)
+	}
+	if applicationGateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *applicationGatewayId)
+	}
+
+	settings := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
+	if applicationGateway.BackendHTTPSettingsCollection != nil {
+		settings = *applicationGateway.BackendHTTPSettingsCollection
+	}
+
+	setting := expandApplicationGatewayBackendHTTPSetting(d, applicationGatewayId.ID())
+
+	_, index, exists := findApplicationGatewayBackendHTTPSettingsByName(&applicationGateway, id.BackendHttpSettingsCollectionName)
+	if exists {
+		if d.IsNewResource() {
+			return tf.ImportAsExistsError("azurerm_application_gateway_backend_http_settings", id.ID())
+		}
+
+		settings[index] = setting
+	} else {
+		settings = append(settings, setting)
+	}
+	applicationGateway.BackendHTTPSettingsCollection = &settings
+
+	future, err := client.CreateOrUpdate(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, applicationGateway)
+	if err != nil {
+		return fmt.Errorf("updating %s for %s: %+v", *applicationGatewayId, id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s for %s: %+v", *applicationGatewayId, id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayBackendHTTPSettingsRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", applicationGatewayId, id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	setting, _, exists := findApplicationGatewayBackendHTTPSettingsByName(&applicationGateway, id.BackendHttpSettingsCollectionName)
+	if !exists {
+		log.Printf("[DEBUG] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.BackendHttpSettingsCollectionName)
+	d.Set("application_gateway_id", applicationGatewayId.ID())
+
+	if props := setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat; props != nil {
+		d.Set("cookie_based_affinity", string(props.CookieBasedAffinity))
+		d.Set("affinity_cookie_name", props.AffinityCookieName)
+		d.Set("path", props.Path)
+		d.Set("host_name", props.HostName)
+		d.Set("pick_host_name_from_backend_address", props.PickHostNameFromBackendAddress)
+		d.Set("protocol", string(props.Protocol))
+
+		port := 0
+		if props.Port != nil {
+			port = int(*props.Port)
+		}
+		d.Set("port", port)
+
+		requestTimeout := 0
+		if props.RequestTimeout != nil {
+			requestTimeout = int(*props.RequestTimeout)
+		}
+		d.Set("request_timeout", requestTimeout)
+
+		if err := d.Set("connection_draining", flattenApplicationGatewayConnectionDraining(props.ConnectionDraining)); err != nil {
+			return fmt.Errorf("setting `connection_draining`: %+v", err)
+		}
+
+		authenticationCertificateNames := make([]interface{}, 0)
+		if certs := props.AuthenticationCertificates; certs != nil {
+			for _, cert := range *certs {
+				if cert.ID == nil {
+					continue
+				}
+
+				certId, err := parse.AuthenticationCertificateID(*cert.ID)
+				if err != nil {
+					return err
+				}
+
+				authenticationCertificateNames = append(authenticationCertificateNames, certId.Name)
+			}
+		}
+		if err := d.Set("authentication_certificate_names", authenticationCertificateNames); err != nil {
+			return fmt.Errorf("setting `authentication_certificate_names`: %+v", err)
+		}
+
+		trustedRootCertificateNames := make([]interface{}, 0)
+		if certs := props.TrustedRootCertificates; certs != nil {
+			for _, cert := range *certs {
+				if cert.ID == nil {
+					continue
+				}
+
+				certId, err := parse.TrustedRootCertificateID(*cert.ID)
+				if err != nil {
+					return err
+				}
+
+				trustedRootCertificateNames = append(trustedRootCertificateNames, certId.Name)
+			}
+		}
+		if err := d.Set("trusted_root_certificate_names", trustedRootCertificateNames); err != nil {
+			return fmt.Errorf("setting `trusted_root_certificate_names`: %+v", err)
+		}
+
+		probeName := ""
+		probeId := ""
+		if props.Probe != nil && props.Probe.ID != nil {
+			parsed, err := parse.ProbeID(*props.Probe.ID)
+			if err != nil {
+				return err
+			}
+
+			probeName = parsed.Name
+			probeId = parsed.ID()
+		}
+		d.Set("probe_name", probeName)
+		d.Set("probe_id", probeId)
+	}
+
+	return nil
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByID(applicationGatewayId.ID())
+	defer locks.UnlockByID(applicationGatewayId.ID())
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	_, index, exists := findApplicationGatewayBackendHTTPSettingsByName(&applicationGateway, id.BackendHttpSettingsCollectionName)
+	if !exists {
+		return nil
+	}
+
+	settings := *applicationGateway.BackendHTTPSettingsCollection
+	settings = append(settings[:index], settings[index+1:]...)
+	applicationGateway.BackendHTTPSettingsCollection = &settings
+
+	future, err := client.CreateOrUpdate(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, applicationGateway)
+	if err != nil {
+		return fmt.Errorf("updating %s for deletion of %s: %+v", applicationGatewayId, *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s for deletion of %s: %+v", applicationGatewayId, *id, err)
+	}
+
+	return nil
+}
+
+func expandApplicationGatewayBackendHTTPSetting(d *pluginsdk.ResourceData, gatewayID string) network.ApplicationGatewayBackendHTTPSettings {
+	setting := network.ApplicationGatewayBackendHTTPSettings{
+		Name: utils.String(d.Get("name").(string)),
+		ApplicationGatewayBackendHTTPSettingsPropertiesFormat: &network.ApplicationGatewayBackendHTTPSettingsPropertiesFormat{
+			CookieBasedAffinity:            network.ApplicationGatewayCookieBasedAffinity(d.Get("cookie_based_affinity").(string)),
+			Path:                           utils.String(d.Get("path").(string)),
+			PickHostNameFromBackendAddress: utils.Bool(d.Get("pick_host_name_from_backend_address").(bool)),
+			Port:                           utils.Int32(int32(d.Get("port").(int))),
+			Protocol:                       network.ApplicationGatewayProtocol(d.Get("protocol").(string)),
+			RequestTimeout:                 utils.Int32(int32(d.Get("request_timeout").(int))),
+		},
+	}
+
+	if v := d.Get("connection_draining").([]interface{}); len(v) > 0 && v[0] != nil {
+		setting.ConnectionDraining = expandApplicationGatewayConnectionDraining(map[string]interface{}{
+			"connection_draining": v,
+		})
+	}
+
+	if hostName := d.Get("host_name").(string); hostName != "" {
+		setting.HostName = utils.String(hostName)
+	}
+
+	if affinityCookieName := d.Get("affinity_cookie_name").(string); affinityCookieName != "" {
+		setting.AffinityCookieName = utils.String(affinityCookieName)
+	}
+
+	authenticationCertificates := make([]network.SubResource, 0)
+	for _, name := range d.Get("authentication_certificate_names").([]interface{}) {
+		authenticationCertificates = append(authenticationCertificates, network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/authenticationCertificates/%s", gatewayID, name.(string))),
+		})
+	}
+	setting.AuthenticationCertificates = &authenticationCertificates
+
+	trustedRootCertificates := make([]network.SubResource, 0)
+	for _, name := range d.Get("trusted_root_certificate_names").([]interface{}) {
+		trustedRootCertificates = append(trustedRootCertificates, network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/trustedRootCertificates/%s", gatewayID, name.(string))),
+		})
+	}
+	setting.TrustedRootCertificates = &trustedRootCertificates
+
+	if probeName := d.Get("probe_name").(string); probeName != "" {
+		setting.Probe = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/probes/%s", gatewayID, probeName)),
+		}
+	}
+
+	return setting
+}
+
+func findApplicationGatewayBackendHTTPSettingsByName(gateway *network.ApplicationGateway, name string) (*network.ApplicationGatewayBackendHTTPSettings, int, bool) {
+	if gateway == nil || gateway.ApplicationGatewayPropertiesFormat == nil || gateway.BackendHTTPSettingsCollection == nil {
+		return nil, -1, false
+	}
+
+	for i, setting := range *gateway.BackendHTTPSettingsCollection {
+		if setting.Name != nil && *setting.Name == name {
+			return &setting, i, true
+		}
+	}
+
+	return nil, -1, false
+}
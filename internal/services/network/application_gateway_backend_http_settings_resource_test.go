@@ -0,0 +1,177 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayBackendHTTPSettingsResource struct{}
+
+func TestAccApplicationGatewayBackendHTTPSettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_application_gateway.test").Key("backend_http_settings.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("probe_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t ApplicationGatewayBackendHTTPSettingsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackendHttpSettingsCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Application Gateway %q (Resource Group %q): %+v", id.ApplicationGatewayName, id.ResourceGroup, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.BackendHTTPSettingsCollection != nil {
+		for _, v := range *props.BackendHTTPSettingsCollection {
+			if v.Name != nil && *v.Name == id.BackendHttpSettingsCollectionName {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ApplicationGatewayBackendHTTPSettingsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "test" {
+  name                   = "acctest-be-htst-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  cookie_based_affinity  = "Disabled"
+  port                   = 8080
+  protocol               = "Http"
+}
+`, ApplicationGatewayResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "import" {
+  name                   = azurerm_application_gateway_backend_http_settings.test.name
+  application_gateway_id = azurerm_application_gateway_backend_http_settings.test.application_gateway_id
+  cookie_based_affinity  = azurerm_application_gateway_backend_http_settings.test.cookie_based_affinity
+  port                   = azurerm_application_gateway_backend_http_settings.test.port
+  protocol               = azurerm_application_gateway_backend_http_settings.test.protocol
+}
+`, r.basic(data))
+}
+
+func (ApplicationGatewayBackendHTTPSettingsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "test" {
+  name                                      = "acctest-probe-%[2]d"
+  application_gateway_id                    = azurerm_application_gateway.test.id
+  protocol                                  = "Http"
+  path                                      = "/health"
+  interval                                  = 30
+  timeout                                   = 30
+  unhealthy_threshold                       = 3
+  pick_host_name_from_backend_http_settings = true
+}
+
+resource "azurerm_application_gateway_backend_http_settings" "test" {
+  name                                = "acctest-be-htst-%[2]d"
+  application_gateway_id              = azurerm_application_gateway.test.id
+  cookie_based_affinity               = "Enabled"
+  affinity_cookie_name                = "ApplicationGatewayAffinity"
+  path                                = "/path1/"
+  port                                = 8080
+  protocol                            = "Http"
+  request_timeout                     = 60
+  pick_host_name_from_backend_address = true
+  probe_name                          = azurerm_application_gateway_probe.test.name
+
+  connection_draining {
+    enabled           = true
+    drain_timeout_sec = 60
+  }
+}
+`, ApplicationGatewayResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,439 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayHTTPListener() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayHTTPListenerCreateUpdate,
+		Read:   resourceApplicationGatewayHTTPListenerRead,
+		Update: resourceApplicationGatewayHTTPListenerCreateUpdate,
+		Delete: resourceApplicationGatewayHTTPListenerDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.HttpListenerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.ApplicationGatewayID,
+			},
+
+			"frontend_ip_configuration_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"frontend_port_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"protocol": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ProtocolHTTP),
+					string(network.ProtocolHTTPS),
+				}, true),
+			},
+
+			"host_name": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"host_names"},
+			},
+
+			"host_names": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				ConflictsWith: []string{"host_name"},
+			},
+
+			"ssl_certificate_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"ssl_profile_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"require_sni": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"firewall_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"custom_error_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"status_code": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
+								string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
+							}, false),
+						},
+
+						"custom_error_page_url": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"frontend_ip_configuration_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"frontend_port_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"ssl_certificate_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"ssl_profile_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationGatewayHTTPListenerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applicationGatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewHttpListenerID(applicationGatewayId.SubscriptionId, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, d.Get("name").(string))
+
+	locks.ByID(applicationGatewayId.ID())
+	defer locks.UnlockByID(applicationGatewayId.ID())
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *applicationGatewayId, err)
+	}
+	if applicationGateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *applicationGatewayId)
+	}
+
+	listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+	if applicationGateway.HTTPListeners != nil {
+		listeners = *applicationGateway.HTTPListeners
+	}
+
+	listener := expandApplicationGatewayHTTPListener(d, applicationGatewayId.ID())
+
+	_, index, exists := findApplicationGatewayHTTPListenerByName(&applicationGateway, id.Name)
+	if exists {
+		if d.IsNewResource() {
+			return tf.ImportAsExistsError("azurerm_application_gateway_http_listener", id.ID())
+		}
+
+		listeners[index] = listener
+	} else {
+		listeners = append(listeners, listener)
+	}
+	applicationGateway.HTTPListeners = &listeners
+
+	future, err := client.CreateOrUpdate(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, applicationGateway)
+	if err != nil {
+		return fmt.Errorf("updating %s for %s: %+v", *applicationGatewayId, id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s for %s: %+v", *applicationGatewayId, id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayHTTPListenerRead(d, meta)
+}
+
+func resourceApplicationGatewayHTTPListenerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HttpListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", applicationGatewayId, id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	listener, _, exists := findApplicationGatewayHTTPListenerByName(&applicationGateway, id.Name)
+	if !exists {
+		log.Printf("[DEBUG] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", applicationGatewayId.ID())
+
+	if props := listener.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
+		d.Set("protocol", string(props.Protocol))
+		d.Set("host_name", props.HostName)
+		d.Set("require_sni", props.RequireServerNameIndication)
+
+		if err := d.Set("host_names", utils.FlattenStringSlice(props.HostNames)); err != nil {
+			return fmt.Errorf("setting `host_names`: %+v", err)
+		}
+
+		frontendIPConfigurationName := ""
+		frontendIPConfigurationId := ""
+		if props.FrontendIPConfiguration != nil && props.FrontendIPConfiguration.ID != nil {
+			parsed, err := parse.FrontendIPConfigurationID(*props.FrontendIPConfiguration.ID)
+			if err != nil {
+				return err
+			}
+
+			frontendIPConfigurationName = parsed.Name
+			frontendIPConfigurationId = parsed.ID()
+		}
+		d.Set("frontend_ip_configuration_name", frontendIPConfigurationName)
+		d.Set("frontend_ip_configuration_id", frontendIPConfigurationId)
+
+		frontendPortName := ""
+		frontendPortId := ""
+		if props.FrontendPort != nil && props.FrontendPort.ID != nil {
+			parsed, err := parse.FrontendPortID(*props.FrontendPort.ID)
+			if err != nil {
+				return err
+			}
+
+			frontendPortName = parsed.Name
+			frontendPortId = parsed.ID()
+		}
+		d.Set("frontend_port_name", frontendPortName)
+		d.Set("frontend_port_id", frontendPortId)
+
+		sslCertificateName := ""
+		sslCertificateId := ""
+		if props.SslCertificate != nil && props.SslCertificate.ID != nil {
+			parsed, err := parse.SslCertificateID(*props.SslCertificate.ID)
+			if err != nil {
+				return err
+			}
+
+			sslCertificateName = parsed.Name
+			sslCertificateId = parsed.ID()
+		}
+		d.Set("ssl_certificate_name", sslCertificateName)
+		d.Set("ssl_certificate_id", sslCertificateId)
+
+		sslProfileName := ""
+		sslProfileId := ""
+		if props.SslProfile != nil && props.SslProfile.ID != nil {
+			parsed, err := parse.SslProfileID(*props.SslProfile.ID)
+			if err != nil {
+				return err
+			}
+
+			sslProfileName = parsed.Name
+			sslProfileId = parsed.ID()
+		}
+		d.Set("ssl_profile_name", sslProfileName)
+		d.Set("ssl_profile_id", sslProfileId)
+
+		firewallPolicyId := ""
+		if props.FirewallPolicy != nil && props.FirewallPolicy.ID != nil {
+			firewallPolicyId = *props.FirewallPolicy.ID
+		}
+		d.Set("firewall_policy_id", firewallPolicyId)
+
+		if err := d.Set("custom_error_configuration", flattenApplicationGatewayCustomErrorConfigurations(props.CustomErrorConfigurations)); err != nil {
+			return fmt.Errorf("setting `custom_error_configuration`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceApplicationGatewayHTTPListenerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HttpListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByID(applicationGatewayId.ID())
+	defer locks.UnlockByID(applicationGatewayId.ID())
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	_, index, exists := findApplicationGatewayHTTPListenerByName(&applicationGateway, id.Name)
+	if !exists {
+		return nil
+	}
+
+	listeners := *applicationGateway.HTTPListeners
+	listeners = append(listeners[:index], listeners[index+1:]...)
+	applicationGateway.HTTPListeners = &listeners
+
+	future, err := client.CreateOrUpdate(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, applicationGateway)
+	if err != nil {
+		return fmt.Errorf("updating %s for deletion of %s: %+v", applicationGatewayId, *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s for deletion of %s: %+v", applicationGatewayId, *id, err)
+	}
+
+	return nil
+}
+
+func expandApplicationGatewayHTTPListener(d *pluginsdk.ResourceData, gatewayID string) network.ApplicationGatewayHTTPListener {
+	listener := network.ApplicationGatewayHTTPListener{
+		Name: utils.String(d.Get("name").(string)),
+		ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
+			FrontendIPConfiguration: &network.SubResource{
+				ID: utils.String(fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, d.Get("frontend_ip_configuration_name").(string))),
+			},
+			FrontendPort: &network.SubResource{
+				ID: utils.String(fmt.Sprintf("%s/frontendPorts/%s", gatewayID, d.Get("frontend_port_name").(string))),
+			},
+			Protocol:                    network.ApplicationGatewayProtocol(d.Get("protocol").(string)),
+			RequireServerNameIndication: utils.Bool(d.Get("require_sni").(bool)),
+			CustomErrorConfigurations:   expandApplicationGatewayCustomErrorConfigurations(d.Get("custom_error_configuration").([]interface{})),
+		},
+	}
+
+	if hostName := d.Get("host_name").(string); hostName != "" {
+		listener.HostName = utils.String(hostName)
+	}
+
+	if hostNames := d.Get("host_names").(*pluginsdk.Set).List(); len(hostNames) > 0 {
+		listener.HostNames = utils.ExpandStringSlice(hostNames)
+	}
+
+	if sslCertificateName := d.Get("ssl_certificate_name").(string); sslCertificateName != "" {
+		listener.SslCertificate = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertificateName)),
+		}
+	}
+
+	if sslProfileName := d.Get("ssl_profile_name").(string); sslProfileName != "" {
+		listener.SslProfile = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/sslProfiles/%s", gatewayID, sslProfileName)),
+		}
+	}
+
+	if firewallPolicyId := d.Get("firewall_policy_id").(string); firewallPolicyId != "" {
+		listener.FirewallPolicy = &network.SubResource{
+			ID: utils.String(firewallPolicyId),
+		}
+	}
+
+	return listener
+}
+
+func findApplicationGatewayHTTPListenerByName(gateway *network.ApplicationGateway, name string) (*network.ApplicationGatewayHTTPListener, int, bool) {
+	if gateway == nil || gateway.ApplicationGatewayPropertiesFormat == nil || gateway.HTTPListeners == nil {
+		return nil, -1, false
+	}
+
+	for i, listener := range *gateway.HTTPListeners {
+		if listener.Name != nil && *listener.Name == name {
+			return &listener, i, true
+		}
+	}
+
+	return nil, -1, false
+}
@@ -0,0 +1,259 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayHTTPListenerResource struct{}
+
+func TestAccApplicationGatewayHTTPListener_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener", "test")
+	r := ApplicationGatewayHTTPListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("frontend_ip_configuration_id").Exists(),
+				check.That(data.ResourceName).Key("frontend_port_id").Exists(),
+				check.That("azurerm_application_gateway.test").Key("http_listener.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayHTTPListener_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener", "test")
+	r := ApplicationGatewayHTTPListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayHTTPListener_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener", "test")
+	r := ApplicationGatewayHTTPListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("host_names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("custom_error_configuration.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayHTTPListener_applicationGatewayUpdated(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener", "test")
+	r := ApplicationGatewayHTTPListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// updating the Application Gateway shouldn't remove the HTTP Listener, since it's not managed by it
+			Config: r.applicationGatewayUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_application_gateway.test").Key("http_listener.#").HasValue("1"),
+				check.That("azurerm_application_gateway.test").Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t ApplicationGatewayHTTPListenerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.HttpListenerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Application Gateway %q (Resource Group %q): %+v", id.ApplicationGatewayName, id.ResourceGroup, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.HTTPListeners != nil {
+		for _, v := range *props.HTTPListeners {
+			if v.Name != nil && *v.Name == id.Name {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ApplicationGatewayHTTPListenerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-httplstn-%d"
+  application_gateway_id         = azurerm_application_gateway.test.id
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = local.frontend_port_name
+  protocol                       = "Http"
+  host_name                      = "site1.example.com"
+}
+`, ApplicationGatewayResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayHTTPListenerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "import" {
+  name                           = azurerm_application_gateway_http_listener.test.name
+  application_gateway_id         = azurerm_application_gateway_http_listener.test.application_gateway_id
+  frontend_ip_configuration_name = azurerm_application_gateway_http_listener.test.frontend_ip_configuration_name
+  frontend_port_name             = azurerm_application_gateway_http_listener.test.frontend_port_name
+  protocol                       = azurerm_application_gateway_http_listener.test.protocol
+  host_name                      = azurerm_application_gateway_http_listener.test.host_name
+}
+`, r.basic(data))
+}
+
+func (ApplicationGatewayHTTPListenerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-httplstn-%d"
+  application_gateway_id         = azurerm_application_gateway.test.id
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = local.frontend_port_name
+  protocol                       = "Http"
+  host_names                     = ["site1.example.com", "site2.example.com"]
+
+  custom_error_configuration {
+    status_code           = "HttpStatus403"
+    custom_error_page_url = "http://azure.com/error403_listener.html"
+  }
+}
+`, ApplicationGatewayResource{}.basic(data), data.RandomInteger)
+}
+
+func (ApplicationGatewayHTTPListenerResource) applicationGatewayUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+locals {
+  backend_address_pool_name      = "${azurerm_virtual_network.test.name}-beap"
+  frontend_port_name             = "${azurerm_virtual_network.test.name}-feport"
+  frontend_ip_configuration_name = "${azurerm_virtual_network.test.name}-feip"
+  http_setting_name              = "${azurerm_virtual_network.test.name}-be-htst"
+  listener_name                  = "${azurerm_virtual_network.test.name}-httplstn"
+  request_routing_rule_name      = "${azurerm_virtual_network.test.name}-rqrt"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestag-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "Standard_Small"
+    tier     = "Standard"
+    capacity = 2
+  }
+
+  gateway_ip_configuration {
+    name      = "my-gateway-ip-configuration"
+    subnet_id = azurerm_subnet.test.id
+  }
+
+  frontend_port {
+    name = local.frontend_port_name
+    port = 80
+  }
+
+  frontend_ip_configuration {
+    name                 = local.frontend_ip_configuration_name
+    public_ip_address_id = azurerm_public_ip.test.id
+  }
+
+  backend_address_pool {
+    name = local.backend_address_pool_name
+  }
+
+  backend_http_settings {
+    name                  = local.http_setting_name
+    cookie_based_affinity = "Disabled"
+    port                  = 80
+    protocol              = "Http"
+    request_timeout       = 1
+  }
+
+  http_listener {
+    name                           = local.listener_name
+    frontend_ip_configuration_name = local.frontend_ip_configuration_name
+    frontend_port_name             = local.frontend_port_name
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                       = local.request_routing_rule_name
+    rule_type                  = "Basic"
+    http_listener_name         = local.listener_name
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+
+  tags = {
+    environment = "Test"
+  }
+}
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-httplstn-%[2]d"
+  application_gateway_id         = azurerm_application_gateway.test.id
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = local.frontend_port_name
+  protocol                       = "Http"
+  host_name                      = "site1.example.com"
+}
+`, ApplicationGatewayResource{}.template(data), data.RandomInteger)
+}
@@ -0,0 +1,388 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayProbe() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayProbeCreateUpdate,
+		Read:   resourceApplicationGatewayProbeRead,
+		Update: resourceApplicationGatewayProbeCreateUpdate,
+		Delete: resourceApplicationGatewayProbeDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ProbeID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.ApplicationGatewayID,
+			},
+
+			"protocol": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ProtocolHTTP),
+					string(network.ProtocolHTTPS),
+				}, true),
+			},
+
+			"path": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"interval": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 86400),
+			},
+
+			"timeout": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 86400),
+			},
+
+			"unhealthy_threshold": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+
+			"host": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"pick_host_name_from_backend_http_settings": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"port": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.PortNumber,
+			},
+
+			"minimum_servers": {
+				Type:     pluginsdk.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+
+			//lintignore:XS003
+			"match": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"body": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"status_code": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceApplicationGatewayProbeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applicationGatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewProbeID(applicationGatewayId.SubscriptionId, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, d.Get("name").(string))
+
+	host := d.Get("host").(string)
+	pickHostName := d.Get("pick_host_name_from_backend_http_settings").(bool)
+	if host == "" && !pickHostName {
+		return fmt.Errorf("one of `host` or `pick_host_name_from_backend_http_settings` must be set")
+	}
+	if host != "" && pickHostName {
+		return fmt.Errorf("only one of `host` or `pick_host_name_from_backend_http_settings` can be set")
+	}
+
+	locks.ByID(applicationGatewayId.ID())
+	defer locks.UnlockByID(applicationGatewayId.ID())
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *applicationGatewayId, err)
+	}
+	if applicationGateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *applicationGatewayId)
+	}
+
+	probes := make([]network.ApplicationGatewayProbe, 0)
+	if applicationGateway.Probes != nil {
+		probes = *applicationGateway.Probes
+	}
+
+	probe := expandApplicationGatewayProbe(d)
+
+	_, index, exists := findApplicationGatewayProbeByName(&applicationGateway, id.Name)
+	if exists {
+		if d.IsNewResource() {
+			return tf.ImportAsExistsError("azurerm_application_gateway_probe", id.ID())
+		}
+
+		probes[index] = probe
+	} else {
+		probes = append(probes, probe)
+	}
+	applicationGateway.Probes = &probes
+
+	future, err := client.CreateOrUpdate(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, applicationGateway)
+	if err != nil {
+		return fmt.Errorf("updating %s for %s: %+v", *applicationGatewayId, id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s for %s: %+v", *applicationGatewayId, id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayProbeRead(d, meta)
+}
+
+func resourceApplicationGatewayProbeRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", applicationGatewayId, id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	probe, _, exists := findApplicationGatewayProbeByName(&applicationGateway, id.Name)
+	if !exists {
+		log.Printf("[DEBUG] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", applicationGatewayId.ID())
+
+	if props := probe.ApplicationGatewayProbePropertiesFormat; props != nil {
+		d.Set("protocol", string(props.Protocol))
+		d.Set("path", props.Path)
+		d.Set("host", props.Host)
+		d.Set("pick_host_name_from_backend_http_settings", props.PickHostNameFromBackendHTTPSettings)
+
+		interval := 0
+		if props.Interval != nil {
+			interval = int(*props.Interval)
+		}
+		d.Set("interval", interval)
+
+		timeout := 0
+		if props.Timeout != nil {
+			timeout = int(*props.Timeout)
+		}
+		d.Set("timeout", timeout)
+
+		unhealthyThreshold := 0
+		if props.UnhealthyThreshold != nil {
+			unhealthyThreshold = int(*props.UnhealthyThreshold)
+		}
+		d.Set("unhealthy_threshold", unhealthyThreshold)
+
+		port := 0
+		if props.Port != nil {
+			port = int(*props.Port)
+		}
+		d.Set("port", port)
+
+		minimumServers := 0
+		if props.MinServers != nil {
+			minimumServers = int(*props.MinServers)
+		}
+		d.Set("minimum_servers", minimumServers)
+
+		match := make([]interface{}, 0)
+		if props.Match != nil {
+			body := ""
+			if props.Match.Body != nil {
+				body = *props.Match.Body
+			}
+
+			match = append(match, map[string]interface{}{
+				"body":        body,
+				"status_code": utils.FlattenStringSlice(props.Match.StatusCodes),
+			})
+		}
+		if err := d.Set("match", match); err != nil {
+			return fmt.Errorf("setting `match`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceApplicationGatewayProbeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByID(applicationGatewayId.ID())
+	defer locks.UnlockByID(applicationGatewayId.ID())
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	_, index, exists := findApplicationGatewayProbeByName(&applicationGateway, id.Name)
+	if !exists {
+		return nil
+	}
+
+	probes := *applicationGateway.Probes
+	probes = append(probes[:index], probes[index+1:]...)
+	applicationGateway.Probes = &probes
+
+	future, err := client.CreateOrUpdate(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, applicationGateway)
+	if err != nil {
+		return fmt.Errorf("updating %s for deletion of %s: %+v", applicationGatewayId, *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s for deletion of %s: %+v", applicationGatewayId, *id, err)
+	}
+
+	return nil
+}
+
+func expandApplicationGatewayProbe(d *pluginsdk.ResourceData) network.ApplicationGatewayProbe {
+	probe := network.ApplicationGatewayProbe{
+		Name: utils.String(d.Get("name").(string)),
+		ApplicationGatewayProbePropertiesFormat: &network.ApplicationGatewayProbePropertiesFormat{
+			Host:                                utils.String(d.Get("host").(string)),
+			Interval:                            utils.Int32(int32(d.Get("interval").(int))),
+			MinServers:                          utils.Int32(int32(d.Get("minimum_servers").(int))),
+			Path:                                utils.String(d.Get("path").(string)),
+			Protocol:                            network.ApplicationGatewayProtocol(d.Get("protocol").(string)),
+			Timeout:                             utils.Int32(int32(d.Get("timeout").(int))),
+			UnhealthyThreshold:                  utils.Int32(int32(d.Get("unhealthy_threshold").(int))),
+			PickHostNameFromBackendHTTPSettings: utils.Bool(d.Get("pick_host_name_from_backend_http_settings").(bool)),
+		},
+	}
+
+	if port := d.Get("port").(int); port != 0 {
+		probe.Port = utils.Int32(int32(port))
+	}
+
+	if matches := d.Get("match").([]interface{}); len(matches) > 0 {
+		match := network.ApplicationGatewayProbeHealthResponseMatch{
+			Body: utils.String(""),
+		}
+
+		if matches[0] != nil {
+			v := matches[0].(map[string]interface{})
+			match.Body = utils.String(v["body"].(string))
+			match.StatusCodes = utils.ExpandStringSlice(v["status_code"].([]interface{}))
+		}
+
+		probe.Match = &match
+	}
+
+	return probe
+}
+
+func findApplicationGatewayProbeByName(gateway *network.ApplicationGateway, name string) (*network.ApplicationGatewayProbe, int, bool) {
+	if gateway == nil || gateway.ApplicationGatewayPropertiesFormat == nil || gateway.Probes == nil {
+		return nil, -1, false
+	}
+
+	for i, probe := range *gateway.Probes {
+		if probe.Name != nil && *probe.Name == name {
+			return &probe, i, true
+		}
+	}
+
+	return nil, -1, false
+}
@@ -0,0 +1,179 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayProbeResource struct{}
+
+func TestAccApplicationGatewayProbe_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_probe", "test")
+	r := ApplicationGatewayProbeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_application_gateway.test").Key("probe.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayProbe_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_probe", "test")
+	r := ApplicationGatewayProbeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayProbe_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_probe", "test")
+	r := ApplicationGatewayProbeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("match.0.status_code.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayProbe_hostNotSpecified(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_probe", "test")
+	r := ApplicationGatewayProbeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.hostNotSpecified(data),
+			ExpectError: regexp.MustCompile("one of `host` or `pick_host_name_from_backend_http_settings` must be set"),
+		},
+	})
+}
+
+func (t ApplicationGatewayProbeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ProbeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Application Gateway %q (Resource Group %q): %+v", id.ApplicationGatewayName, id.ResourceGroup, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.Probes != nil {
+		for _, v := range *props.Probes {
+			if v.Name != nil && *v.Name == id.Name {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ApplicationGatewayProbeResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "test" {
+  name                   = "acctest-probe-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  protocol               = "Http"
+  path                   = "/"
+  host                   = "backend.example.com"
+  interval               = 30
+  timeout                = 30
+  unhealthy_threshold    = 3
+}
+`, ApplicationGatewayResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayProbeResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "import" {
+  name                   = azurerm_application_gateway_probe.test.name
+  application_gateway_id = azurerm_application_gateway_probe.test.application_gateway_id
+  protocol               = azurerm_application_gateway_probe.test.protocol
+  path                   = azurerm_application_gateway_probe.test.path
+  host                   = azurerm_application_gateway_probe.test.host
+  interval               = azurerm_application_gateway_probe.test.interval
+  timeout                = azurerm_application_gateway_probe.test.timeout
+  unhealthy_threshold    = azurerm_application_gateway_probe.test.unhealthy_threshold
+}
+`, r.basic(data))
+}
+
+func (ApplicationGatewayProbeResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "test" {
+  name                   = "acctest-probe-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  protocol               = "Http"
+  path                   = "/health"
+  host                   = "backend.example.com"
+  port                   = 8080
+  interval               = 15
+  timeout                = 10
+  unhealthy_threshold    = 5
+  minimum_servers        = 1
+
+  match {
+    body        = "healthy"
+    status_code = ["200-399"]
+  }
+}
+`, ApplicationGatewayResource{}.basic(data), data.RandomInteger)
+}
+
+func (ApplicationGatewayProbeResource) hostNotSpecified(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "test" {
+  name                   = "acctest-probe-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  protocol               = "Http"
+  path                   = "/"
+  interval               = 30
+  timeout                = 30
+  unhealthy_threshold    = 3
+}
+`, ApplicationGatewayResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,432 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayRequestRoutingRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayRequestRoutingRuleCreateUpdate,
+		Read:   resourceApplicationGatewayRequestRoutingRuleRead,
+		Update: resourceApplicationGatewayRequestRoutingRuleCreateUpdate,
+		Delete: resourceApplicationGatewayRequestRoutingRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RequestRoutingRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.ApplicationGatewayID,
+			},
+
+			"rule_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ApplicationGatewayRequestRoutingRuleTypeBasic),
+					string(network.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
+				}, false),
+			},
+
+			"http_listener_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"backend_address_pool_name": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"redirect_configuration_name"},
+			},
+
+			"backend_http_settings_name": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"redirect_configuration_name"},
+			},
+
+			"redirect_configuration_name": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"backend_address_pool_name", "backend_http_settings_name"},
+			},
+
+			"url_path_map_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"rewrite_rule_set_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 20000),
+			},
+
+			"http_listener_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"backend_address_pool_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"backend_http_settings_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"redirect_configuration_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"url_path_map_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"rewrite_rule_set_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationGatewayRequestRoutingRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applicationGatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewRequestRoutingRuleID(applicationGatewayId.SubscriptionId, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, d.Get("name").(string))
+
+	locks.ByID(applicationGatewayId.ID())
+	defer locks.UnlockByID(applicationGatewayId.ID())
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *applicationGatewayId, err)
+	}
+	if applicationGateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *applicationGatewayId)
+	}
+
+	rules := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+	if applicationGateway.RequestRoutingRules != nil {
+		rules = *applicationGateway.RequestRoutingRules
+	}
+
+	rule := expandApplicationGatewayRequestRoutingRule(d, applicationGatewayId.ID())
+
+	_, index, exists := findApplicationGatewayRequestRoutingRuleByName(&applicationGateway, id.Name)
+	if exists {
+		if d.IsNewResource() {
+			return tf.ImportAsExistsError("azurerm_application_gateway_request_routing_rule", id.ID())
+		}
+
+		rules[index] = rule
+	} else {
+		rules = append(rules, rule)
+	}
+	applicationGateway.RequestRoutingRules = &rules
+
+	future, err := client.CreateOrUpdate(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, applicationGateway)
+	if err != nil {
+		return fmt.Errorf("updating %s for %s: %+v", *applicationGatewayId, id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s for %s: %+v", *applicationGatewayId, id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayRequestRoutingRuleRead(d, meta)
+}
+
+func resourceApplicationGatewayRequestRoutingRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", applicationGatewayId, id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	rule, _, exists := findApplicationGatewayRequestRoutingRuleByName(&applicationGateway, id.Name)
+	if !exists {
+		log.Printf("[DEBUG] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", applicationGatewayId.ID())
+
+	if props := rule.ApplicationGatewayRequestRoutingRulePropertiesFormat; props != nil {
+		d.Set("rule_type", string(props.RuleType))
+
+		priority := 0
+		if props.Priority != nil {
+			priority = int(*props.Priority)
+		}
+		d.Set("priority", priority)
+
+		httpListenerName := ""
+		httpListenerId := ""
+		if props.HTTPListener != nil && props.HTTPListener.ID != nil {
+			parsed, err := parse.HttpListenerID(*props.HTTPListener.ID)
+			if err != nil {
+				return err
+			}
+
+			httpListenerName = parsed.Name
+			httpListenerId = parsed.ID()
+		}
+		d.Set("http_listener_name", httpListenerName)
+		d.Set("http_listener_id", httpListenerId)
+
+		backendAddressPoolName := ""
+		backendAddressPoolId := ""
+		if props.BackendAddressPool != nil && props.BackendAddressPool.ID != nil {
+			parsed, err := parse.BackendAddressPoolID(*props.BackendAddressPool.ID)
+			if err != nil {
+				return err
+			}
+
+			backendAddressPoolName = parsed.Name
+			backendAddressPoolId = parsed.ID()
+		}
+		d.Set("backend_address_pool_name", backendAddressPoolName)
+		d.Set("backend_address_pool_id", backendAddressPoolId)
+
+		backendHTTPSettingsName := ""
+		backendHTTPSettingsId := ""
+		if props.BackendHTTPSettings != nil && props.BackendHTTPSettings.ID != nil {
+			parsed, err := parse.BackendHttpSettingsCollectionID(*props.BackendHTTPSettings.ID)
+			if err != nil {
+				return err
+			}
+
+			backendHTTPSettingsName = parsed.BackendHttpSettingsCollectionName
+			backendHTTPSettingsId = parsed.ID()
+		}
+		d.Set("backend_http_settings_name", backendHTTPSettingsName)
+		d.Set("backend_http_settings_id", backendHTTPSettingsId)
+
+		redirectConfigurationName := ""
+		redirectConfigurationId := ""
+		if props.RedirectConfiguration != nil && props.RedirectConfiguration.ID != nil {
+			parsed, err := parse.RedirectConfigurationsID(*props.RedirectConfiguration.ID)
+			if err != nil {
+				return err
+			}
+
+			redirectConfigurationName = parsed.RedirectConfigurationName
+			redirectConfigurationId = parsed.ID()
+		}
+		d.Set("redirect_configuration_name", redirectConfigurationName)
+		d.Set("redirect_configuration_id", redirectConfigurationId)
+
+		urlPathMapName := ""
+		urlPathMapId := ""
+		if props.URLPathMap != nil && props.URLPathMap.ID != nil {
+			parsed, err := parse.UrlPathMapID(*props.URLPathMap.ID)
+			if err != nil {
+				return err
+			}
+
+			urlPathMapName = parsed.Name
+			urlPathMapId = parsed.ID()
+		}
+		d.Set("url_path_map_name", urlPathMapName)
+		d.Set("url_path_map_id", urlPathMapId)
+
+		rewriteRuleSetName := ""
+		rewriteRuleSetId := ""
+		if props.RewriteRuleSet != nil && props.RewriteRuleSet.ID != nil {
+			parsed, err := parse.RewriteRuleSetID(*props.RewriteRuleSet.ID)
+			if err != nil {
+				return err
+			}
+
+			rewriteRuleSetName = parsed.Name
+			rewriteRuleSetId = parsed.ID()
+		}
+		d.Set("rewrite_rule_set_name", rewriteRuleSetName)
+		d.Set("rewrite_rule_set_id", rewriteRuleSetId)
+	}
+
+	return nil
+}
+
+func resourceApplicationGatewayRequestRoutingRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByID(applicationGatewayId.ID())
+	defer locks.UnlockByID(applicationGatewayId.ID())
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	_, index, exists := findApplicationGatewayRequestRoutingRuleByName(&applicationGateway, id.Name)
+	if !exists {
+		return nil
+	}
+
+	rules := *applicationGateway.RequestRoutingRules
+	rules = append(rules[:index], rules[index+1:]...)
+	applicationGateway.RequestRoutingRules = &rules
+
+	future, err := client.CreateOrUpdate(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, applicationGateway)
+	if err != nil {
+		return fmt.Errorf("updating %s for deletion of %s: %+v", applicationGatewayId, *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s for deletion of %s: %+v", applicationGatewayId, *id, err)
+	}
+
+	return nil
+}
+
+func expandApplicationGatewayRequestRoutingRule(d *pluginsdk.ResourceData, gatewayID string) network.ApplicationGatewayRequestRoutingRule {
+	rule := network.ApplicationGatewayRequestRoutingRule{
+		Name: utils.String(d.Get("name").(string)),
+		ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+			RuleType: network.ApplicationGatewayRequestRoutingRuleType(d.Get("rule_type").(string)),
+			HTTPListener: &network.SubResource{
+				ID: utils.String(fmt.Sprintf("%s/httpListeners/%s", gatewayID, d.Get("http_listener_name").(string))),
+			},
+		},
+	}
+
+	if name := d.Get("backend_address_pool_name").(string); name != "" {
+		rule.BackendAddressPool = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, name)),
+		}
+	}
+
+	if name := d.Get("backend_http_settings_name").(string); name != "" {
+		rule.BackendHTTPSettings = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, name)),
+		}
+	}
+
+	if name := d.Get("redirect_configuration_name").(string); name != "" {
+		rule.RedirectConfiguration = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, name)),
+		}
+	}
+
+	if name := d.Get("url_path_map_name").(string); name != "" {
+		rule.URLPathMap = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, name)),
+		}
+	}
+
+	if name := d.Get("rewrite_rule_set_name").(string); name != "" {
+		rule.RewriteRuleSet = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, name)),
+		}
+	}
+
+	if priority := d.Get("priority").(int); priority != 0 {
+		rule.Priority = utils.Int32(int32(priority))
+	}
+
+	return rule
+}
+
+func findApplicationGatewayRequestRoutingRuleByName(gateway *network.ApplicationGateway, name string) (*network.ApplicationGatewayRequestRoutingRule, int, bool) {
+	if gateway == nil || gateway.ApplicationGatewayPropertiesFormat == nil || gateway.RequestRoutingRules == nil {
+		return nil, -1, false
+	}
+
+	for i, rule := range *gateway.RequestRoutingRules {
+		if rule.Name != nil && *rule.Name == name {
+			return &rule, i, true
+		}
+	}
+
+	return nil, -1, false
+}
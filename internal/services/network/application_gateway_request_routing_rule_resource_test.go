@@ -0,0 +1,161 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayRequestRoutingRuleResource struct{}
+
+func TestAccApplicationGatewayRequestRoutingRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_request_routing_rule", "test")
+	r := ApplicationGatewayRequestRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("http_listener_id").Exists(),
+				check.That(data.ResourceName).Key("backend_address_pool_id").Exists(),
+				check.That(data.ResourceName).Key("backend_http_settings_id").Exists(),
+				check.That("azurerm_application_gateway.test").Key("request_routing_rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayRequestRoutingRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_request_routing_rule", "test")
+	r := ApplicationGatewayRequestRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayRequestRoutingRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_request_routing_rule", "test")
+	r := ApplicationGatewayRequestRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("backend_address_pool_name").HasValue(fmt.Sprintf("acctest-beap-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t ApplicationGatewayRequestRoutingRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RequestRoutingRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Application Gateway %q (Resource Group %q): %+v", id.ApplicationGatewayName, id.ResourceGroup, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.RequestRoutingRules != nil {
+		for _, v := range *props.RequestRoutingRules {
+			if v.Name != nil && *v.Name == id.Name {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ApplicationGatewayRequestRoutingRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-httplstn-%[2]d"
+  application_gateway_id         = azurerm_application_gateway.test.id
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = local.frontend_port_name
+  protocol                       = "Http"
+  host_name                      = "site1.example.com"
+}
+
+resource "azurerm_application_gateway_backend_address_pool" "test" {
+  name                   = "acctest-beap-%[2]d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  fqdns                  = ["backend.example.com"]
+}
+`, ApplicationGatewayResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_request_routing_rule" "test" {
+  name                       = "acctest-rqrt-%d"
+  application_gateway_id     = azurerm_application_gateway.test.id
+  rule_type                  = "Basic"
+  http_listener_name         = azurerm_application_gateway_http_listener.test.name
+  backend_address_pool_name  = local.backend_address_pool_name
+  backend_http_settings_name = local.http_setting_name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_request_routing_rule" "import" {
+  name                       = azurerm_application_gateway_request_routing_rule.test.name
+  application_gateway_id     = azurerm_application_gateway_request_routing_rule.test.application_gateway_id
+  rule_type                  = azurerm_application_gateway_request_routing_rule.test.rule_type
+  http_listener_name         = azurerm_application_gateway_request_routing_rule.test.http_listener_name
+  backend_address_pool_name  = azurerm_application_gateway_request_routing_rule.test.backend_address_pool_name
+  backend_http_settings_name = azurerm_application_gateway_request_routing_rule.test.backend_http_settings_name
+}
+`, r.basic(data))
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_request_routing_rule" "test" {
+  name                       = "acctest-rqrt-%d"
+  application_gateway_id     = azurerm_application_gateway.test.id
+  rule_type                  = "Basic"
+  http_listener_name         = azurerm_application_gateway_http_listener.test.name
+  backend_address_pool_name  = azurerm_application_gateway_backend_address_pool.test.name
+  backend_http_settings_name = local.http_setting_name
+}
+`, r.template(data), data.RandomInteger)
+}
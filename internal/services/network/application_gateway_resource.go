@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	msiParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
@@ -1532,6 +1533,12 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	log.Printf("[INFO] preparing arguments for Application Gateway creation.")
 
 	id := parse.NewApplicationGatewayID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	// the Backend Address Pools, Backend HTTP Settings, HTTP Listeners, Probes, Request Routing Rules and SSL Certificates
+	// can also be managed using their own resources, which lock on the Application Gateway ID too
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1681,6 +1688,19 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		// retain any items which are managed by their own resources, since these aren't defined within this resource
+		if props := existing.ApplicationGatewayPropertiesFormat; props != nil {
+			filterApplicationGatewayChildResources(d, props, false)
+			mergeApplicationGatewayChildResources(gateway.ApplicationGatewayPropertiesFormat, props)
+		}
+	}
+
 	if stopApplicationGateway {
 		future, err := client.Stop(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1737,6 +1757,12 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
+	// items which are managed by their own resources are ignored - unless this resource is being imported, in which
+	// case the `name` won't be set and all of the items are managed by this resource
+	if props := applicationGateway.ApplicationGatewayPropertiesFormat; props != nil && d.Get("name").(string) != "" {
+		filterApplicationGatewayChildResources(d, props, true)
+	}
+
 	d.Set("name", applicationGateway.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
 	if location := applicationGateway.Location; location != nil {
@@ -1895,6 +1921,9 @@ func resourceApplicationGatewayDelete(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -4410,6 +4439,135 @@ func checkSslPolicy(sslPolicy []interface{}) error {
 	return nil
 }
 
+// applicationGatewayOwnedNames returns the names of the items within the block `key` which are managed by this
+// resource, being those within either the prior state or the configuration
+func applicationGatewayOwnedNames(d *pluginsdk.ResourceData, key string) map[string]bool {
+	names := make(map[string]bool)
+
+	existing, configured := d.GetChange(key)
+	for _, raw := range []interface{}{existing, configured} {
+		var items []interface{}
+		switch v := raw.(type) {
+		case *pluginsdk.Set:
+			items = v.List()
+		case []interface{}:
+			items = v
+		}
+
+		for _, item := range items {
+			if v, ok := item.(map[string]interface{}); ok {
+				names[v["name"].(string)] = true
+			}
+		}
+	}
+
+	return names
+}
+
+// filterApplicationGatewayChildResources filters the items which can also be managed by their own resources (such as
+// `azurerm_application_gateway_http_listener`) to either those managed by this resource, or those which aren't
+func filterApplicationGatewayChildResources(d *pluginsdk.ResourceData, props *network.ApplicationGatewayPropertiesFormat, owned bool) {
+	if props.BackendAddressPools != nil {
+		names := applicationGatewayOwnedNames(d, "backend_address_pool")
+		results := make([]network.ApplicationGatewayBackendAddressPool, 0)
+		for _, v := range *props.BackendAddressPools {
+			if v.Name != nil && names[*v.Name] == owned {
+				results = append(results, v)
+			}
+		}
+		props.BackendAddressPools = &results
+	}
+
+	if props.BackendHTTPSettingsCollection != nil {
+		names := applicationGatewayOwnedNames(d, "backend_http_settings")
+		results := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
+		for _, v := range *props.BackendHTTPSettingsCollection {
+			if v.Name != nil && names[*v.Name] == owned {
+				results = append(results, v)
+			}
+		}
+		props.BackendHTTPSettingsCollection = &results
+	}
+
+	if props.HTTPListeners != nil {
+		names := applicationGatewayOwnedNames(d, "http_listener")
+		results := make([]network.ApplicationGatewayHTTPListener, 0)
+		for _, v := range *props.HTTPListeners {
+			if v.Name != nil && names[*v.Name] == owned {
+				results = append(results, v)
+			}
+		}
+		props.HTTPListeners = &results
+	}
+
+	if props.Probes != nil {
+		names := applicationGatewayOwnedNames(d, "probe")
+		results := make([]network.ApplicationGatewayProbe, 0)
+		for _, v := range *props.Probes {
+			if v.Name != nil && names[*v.Name] == owned {
+				results = append(results, v)
+			}
+		}
+		props.Probes = &results
+	}
+
+	if props.RequestRoutingRules != nil {
+		names := applicationGatewayOwnedNames(d, "request_routing_rule")
+		results := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+		for _, v := range *props.RequestRoutingRules {
+			if v.Name != nil && names[*v.Name] == owned {
+				results = append(results, v)
+			}
+		}
+		props.RequestRoutingRules = &results
+	}
+
+	if props.SslCertificates != nil {
+		names := applicationGatewayOwnedNames(d, "ssl_certificate")
+		results := make([]network.ApplicationGatewaySslCertificate, 0)
+		for _, v := range *props.SslCertificates {
+			if v.Name != nil && names[*v.Name] == owned {
+				results = append(results, v)
+			}
+		}
+		props.SslCertificates = &results
+	}
+}
+
+// mergeApplicationGatewayChildResources appends the items which are managed by their own resources from `existing`
+// to the items managed by this resource within `props`
+func mergeApplicationGatewayChildResources(props *network.ApplicationGatewayPropertiesFormat, existing *network.ApplicationGatewayPropertiesFormat) {
+	if existing.BackendAddressPools != nil && props.BackendAddressPools != nil {
+		results := append(*props.BackendAddressPools, *existing.BackendAddressPools...)
+		props.BackendAddressPools = &results
+	}
+
+	if existing.BackendHTTPSettingsCollection != nil && props.BackendHTTPSettingsCollection != nil {
+		results := append(*props.BackendHTTPSettingsCollection, *existing.BackendHTTPSettingsCollection...)
+		props.BackendHTTPSettingsCollection = &results
+	}
+
+	if existing.HTTPListeners != nil && props.HTTPListeners != nil {
+		results := append(*props.HTTPListeners, *existing.HTTPListeners...)
+		props.HTTPListeners = &results
+	}
+
+	if existing.Probes != nil && props.Probes != nil {
+		results := append(*props.Probes, *existing.Probes...)
+		props.Probes = &results
+	}
+
+	if existing.RequestRoutingRules != nil && props.RequestRoutingRules != nil {
+		results := append(*props.RequestRoutingRules, *existing.RequestRoutingRules...)
+		props.RequestRoutingRules = &results
+	}
+
+	if existing.SslCertificates != nil && props.SslCertificates != nil {
+		results := append(*props.SslCertificates, *existing.SslCertificates...)
+		props.SslCertificates = &results
+	}
+}
+
 func applicationGatewayCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
 	_, hasAutoscaleConfig := d.GetOk("autoscale_configuration.0")
 	capacity, hasCapacity := d.GetOk("sku.0.capacity")
@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-01-01/networkmanager"
 )
 
 type Client struct {
@@ -44,6 +45,7 @@ type Client struct {
 	NatGatewayClient                       *network.NatGatewaysClient
 	VirtualHubBgpConnectionClient          *network.VirtualHubBgpConnectionClient
 	VirtualHubIPClient                     *network.VirtualHubIPConfigurationClient
+	VirtualHubRoutingIntentClient          *network.RoutingIntentClient
 	VnetGatewayConnectionsClient           *network.VirtualNetworkGatewayConnectionsClient
 	VnetGatewayClient                      *network.VirtualNetworkGatewaysClient
 	VnetClient                             *network.VirtualNetworksClient
@@ -60,6 +62,14 @@ type Client struct {
 	PrivateLinkServiceClient               *network.PrivateLinkServicesClient
 	ServiceAssociationLinkClient           *network.ServiceAssociationLinksClient
 	ResourceNavigationLinkClient           *network.ResourceNavigationLinksClient
+
+	ManagerAdminRuleCollectionsClient        *networkmanager.AdminRuleCollectionsClient
+	ManagerAdminRulesClient                  *networkmanager.AdminRulesClient
+	ManagerConnectivityConfigurationsClient  *networkmanager.ConnectivityConfigurationsClient
+	ManagerNetworkGroupsClient               *networkmanager.NetworkGroupsClient
+	ManagerSecurityAdminConfigurationsClient *networkmanager.SecurityAdminConfigurationsClient
+	ManagerStaticMembersClient               *networkmanager.StaticMembersClient
+	ManagersClient                           *networkmanager.NetworkManagersClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -189,6 +199,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	VirtualHubIPClient := network.NewVirtualHubIPConfigurationClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VirtualHubIPClient.Client, o.ResourceManagerAuthorizer)
 
+	VirtualHubRoutingIntentClient := network.NewRoutingIntentClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualHubRoutingIntentClient.Client, o.ResourceManagerAuthorizer)
+
 	VnetGatewayClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VnetGatewayClient.Client, o.ResourceManagerAuthorizer)
 
@@ -225,6 +238,27 @@ func NewClient(o *common.ClientOptions) *Client {
 	ResourceNavigationLinkClient := network.NewResourceNavigationLinksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ResourceNavigationLinkClient.Client, o.ResourceManagerAuthorizer)
 
+	managerAdminRuleCollectionsClient := networkmanager.NewAdminRuleCollectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerAdminRuleCollectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	managerAdminRulesClient := networkmanager.NewAdminRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerAdminRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	managerConnectivityConfigurationsClient := networkmanager.NewConnectivityConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerConnectivityConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	managerNetworkGroupsClient := networkmanager.NewNetworkGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerNetworkGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	managerSecurityAdminConfigurationsClient := networkmanager.NewSecurityAdminConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerSecurityAdminConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	managerStaticMembersClient := networkmanager.NewStaticMembersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerStaticMembersClient.Client, o.ResourceManagerAuthorizer)
+
+	managersClient := networkmanager.NewNetworkManagersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managersClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ApplicationGatewaysClient:              &ApplicationGatewaysClient,
 		ApplicationSecurityGroupsClient:        &ApplicationSecurityGroupsClient,
@@ -264,6 +298,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		NatGatewayClient:                       &NatGatewayClient,
 		VirtualHubBgpConnectionClient:          &VirtualHubBgpConnectionClient,
 		VirtualHubIPClient:                     &VirtualHubIPClient,
+		VirtualHubRoutingIntentClient:          &VirtualHubRoutingIntentClient,
 		VnetGatewayConnectionsClient:           &VnetGatewayConnectionsClient,
 		VnetGatewayClient:                      &VnetGatewayClient,
 		VnetClient:                             &VnetClient,
@@ -280,5 +315,13 @@ func NewClient(o *common.ClientOptions) *Client {
 		PrivateLinkServiceClient:               &PrivateLinkServiceClient,
 		ServiceAssociationLinkClient:           &ServiceAssociationLinkClient,
 		ResourceNavigationLinkClient:           &ResourceNavigationLinkClient,
+
+		ManagerAdminRuleCollectionsClient:        &managerAdminRuleCollectionsClient,
+		ManagerAdminRulesClient:                  &managerAdminRulesClient,
+		ManagerConnectivityConfigurationsClient:  &managerConnectivityConfigurationsClient,
+		ManagerNetworkGroupsClient:               &managerNetworkGroupsClient,
+		ManagerSecurityAdminConfigurationsClient: &managerSecurityAdminConfigurationsClient,
+		ManagerStaticMembersClient:               &managerStaticMembersClient,
+		ManagersClient:                           &managersClient,
 	}
 }
@@ -69,8 +69,8 @@ func resourceExpressRouteCircuitAuthorizationCreate(d *pluginsdk.ResourceData, m
 
 	id := parse.NewExpressRouteCircuitAuthorizationID(subscriptionId, d.Get("resource_group_name").(string), d.Get("express_route_circuit_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
+	locks.ByID(parse.NewExpressRouteCircuitID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName).ID())
+	defer locks.UnlockByID(parse.NewExpressRouteCircuitID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName).ID())
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.ExpressRouteCircuitName, id.AuthorizationName)
@@ -144,8 +144,8 @@ func resourceExpressRouteCircuitAuthorizationDelete(d *pluginsdk.ResourceData, m
 		return err
 	}
 
-	locks.ByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
+	locks.ByID(parse.NewExpressRouteCircuitID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName).ID())
+	defer locks.UnlockByID(parse.NewExpressRouteCircuitID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName).ID())
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.ExpressRouteCircuitName, id.AuthorizationName)
 	if err != nil {
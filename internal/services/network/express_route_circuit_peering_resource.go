@@ -202,8 +202,8 @@ func resourceExpressRouteCircuitPeeringCreateUpdate(d *pluginsdk.ResourceData, m
 
 	id := parse.NewExpressRouteCircuitPeeringID(subscriptionId, d.Get("resource_group_name").(string), d.Get("express_route_circuit_name").(string), d.Get("peering_type").(string))
 
-	locks.ByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
+	locks.ByID(parse.NewExpressRouteCircuitID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName).ID())
+	defer locks.UnlockByID(parse.NewExpressRouteCircuitID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName).ID())
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.ExpressRouteCircuitName, id.PeeringName)
@@ -344,8 +344,8 @@ func resourceExpressRouteCircuitPeeringDelete(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	locks.ByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(id.ExpressRouteCircuitName, expressRouteCircuitResourceName)
+	locks.ByID(parse.NewExpressRouteCircuitID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName).ID())
+	defer locks.UnlockByID(parse.NewExpressRouteCircuitID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName).ID())
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.ExpressRouteCircuitName, id.PeeringName)
 	if err != nil {
@@ -21,8 +21,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-var expressRouteCircuitResourceName = "azurerm_express_route_circuit"
-
 func resourceExpressRouteCircuit() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceExpressRouteCircuitCreateUpdate,
@@ -163,8 +161,8 @@ func resourceExpressRouteCircuitCreateUpdate(d *pluginsdk.ResourceData, meta int
 
 	id := parse.NewExpressRouteCircuitID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.Name, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(id.Name, expressRouteCircuitResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
@@ -334,8 +332,8 @@ func resourceExpressRouteCircuitDelete(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("parsing Azure Resource ID -: %+v", err)
 	}
 
-	locks.ByName(id.Name, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(id.Name, expressRouteCircuitResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -65,8 +65,8 @@ func resourceNATGatewayPublicIpPrefixAssociationCreate(d *pluginsdk.ResourceData
 		return err
 	}
 
-	locks.ByName(parsedNatGatewayId.Name, natGatewayResourceName)
-	defer locks.UnlockByName(parsedNatGatewayId.Name, natGatewayResourceName)
+	locks.ByID(parsedNatGatewayId.ID())
+	defer locks.UnlockByID(parsedNatGatewayId.ID())
 
 	natGateway, err := client.Get(ctx, parsedNatGatewayId.ResourceGroup, parsedNatGatewayId.Name, "")
 	if err != nil {
@@ -176,8 +176,8 @@ func resourceNATGatewayPublicIpPrefixAssociationDelete(d *pluginsdk.ResourceData
 		return err
 	}
 
-	locks.ByName(id.NatGateway.Name, natGatewayResourceName)
-	defer locks.UnlockByName(id.NatGateway.Name, natGatewayResourceName)
+	locks.ByID(id.NatGateway.ID())
+	defer locks.UnlockByID(id.NatGateway.ID())
 
 	natGateway, err := client.Get(ctx, id.NatGateway.ResourceGroup, id.NatGateway.Name, "")
 	if err != nil {
@@ -19,8 +19,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-var natGatewayResourceName = "azurerm_nat_gateway"
-
 func resourceNatGateway() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceNatGatewayCreate,
@@ -112,8 +110,8 @@ func resourceNatGatewayCreate(d *pluginsdk.ResourceData, meta interface{}) error
 
 	id := parse.NewNatGatewayID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.Name, natGatewayResourceName)
-	defer locks.UnlockByName(id.Name, natGatewayResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
@@ -170,8 +168,8 @@ func resourceNatGatewayUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.Name, natGatewayResourceName)
-	defer locks.UnlockByName(id.Name, natGatewayResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
@@ -298,8 +296,8 @@ func resourceNatGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.Name, natGatewayResourceName)
-	defer locks.UnlockByName(id.Name, natGatewayResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
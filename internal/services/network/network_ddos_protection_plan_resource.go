@@ -17,8 +17,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-const azureNetworkDDoSProtectionPlanResourceName = "azurerm_network_ddos_protection_plan"
-
 func resourceNetworkDDoSProtectionPlan() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceNetworkDDoSProtectionPlanCreateUpdate,
@@ -88,16 +86,17 @@ func resourceNetworkDDoSProtectionPlanCreateUpdate(d *pluginsdk.ResourceData, me
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	vnetsToLock, err := expandNetworkDDoSProtectionPlanVnetNames(d)
+	vnetsToLock, err := expandNetworkDDoSProtectionPlanVnetIDs(d)
 	if err != nil {
-		return fmt.Errorf("extracting names of Virtual Network: %+v", err)
+		return fmt.Errorf("extracting IDs of Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureNetworkDDoSProtectionPlanResourceName)
-	defer locks.UnlockByName(name, azureNetworkDDoSProtectionPlanResourceName)
+	id := parse.NewDdosProtectionPlanID(meta.(*clients.Client).Account.SubscriptionId, resourceGroup, name)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	locks.MultipleByID(vnetsToLock)
+	defer locks.UnlockMultipleByID(vnetsToLock)
 
 	parameters := network.DdosProtectionPlan{
 		Location: &location,
@@ -185,16 +184,16 @@ func resourceNetworkDDoSProtectionPlanDelete(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	vnetsToLock, err := extractVnetNames(d)
+	vnetsToLock, err := extractVnetIDs(d)
 	if err != nil {
 		return fmt.Errorf("extracting names of Virtual Network: %+v", err)
 	}
 
-	locks.ByName(id.Name, azureNetworkDDoSProtectionPlanResourceName)
-	defer locks.UnlockByName(id.Name, azureNetworkDDoSProtectionPlanResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	locks.MultipleByID(vnetsToLock)
+	defer locks.UnlockMultipleByID(vnetsToLock)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -208,9 +207,9 @@ func resourceNetworkDDoSProtectionPlanDelete(d *pluginsdk.ResourceData, meta int
 	return err
 }
 
-func expandNetworkDDoSProtectionPlanVnetNames(d *pluginsdk.ResourceData) (*[]string, error) {
+func expandNetworkDDoSProtectionPlanVnetIDs(d *pluginsdk.ResourceData) (*[]string, error) {
 	vnetIDs := d.Get("virtual_network_ids").([]interface{})
-	vnetNames := make([]string, 0)
+	vnetIDsToLock := make([]string, 0)
 
 	for _, vnetID := range vnetIDs {
 		vnetResourceID, err := parse.VirtualNetworkID(vnetID.(string))
@@ -218,12 +217,12 @@ func expandNetworkDDoSProtectionPlanVnetNames(d *pluginsdk.ResourceData) (*[]str
 			return nil, err
 		}
 
-		if !utils.SliceContainsValue(vnetNames, vnetResourceID.Name) {
-			vnetNames = append(vnetNames, vnetResourceID.Name)
+		if !utils.SliceContainsValue(vnetIDsToLock, vnetResourceID.ID()) {
+			vnetIDsToLock = append(vnetIDsToLock, vnetResourceID.ID())
 		}
 	}
 
-	return &vnetNames, nil
+	return &vnetIDsToLock, nil
 }
 
 func flattenNetworkDDoSProtectionPlanVirtualNetworkIDs(input *[]network.SubResource) []string {
@@ -242,9 +241,9 @@ func flattenNetworkDDoSProtectionPlanVirtualNetworkIDs(input *[]network.SubResou
 	return vnetIDs
 }
 
-func extractVnetNames(d *pluginsdk.ResourceData) (*[]string, error) {
+func extractVnetIDs(d *pluginsdk.ResourceData) (*[]string, error) {
 	vnetIDs := d.Get("virtual_network_ids").([]interface{})
-	vnetNames := make([]string, 0)
+	vnetIDsToLock := make([]string, 0)
 
 	for _, vnetID := range vnetIDs {
 		vnetResourceID, err := parse.VirtualNetworkID(vnetID.(string))
@@ -252,10 +251,10 @@ func extractVnetNames(d *pluginsdk.ResourceData) (*[]string, error) {
 			return nil, err
 		}
 
-		if !utils.SliceContainsValue(vnetNames, vnetResourceID.Name) {
-			vnetNames = append(vnetNames, vnetResourceID.Name)
+		if !utils.SliceContainsValue(vnetIDsToLock, vnetResourceID.ID()) {
+			vnetIDsToLock = append(vnetIDsToLock, vnetResourceID.ID())
 		}
 	}
 
-	return &vnetNames, nil
+	return &vnetIDsToLock, nil
 }
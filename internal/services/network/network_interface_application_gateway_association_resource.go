@@ -83,8 +83,8 @@ func resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationCrea
 		return err
 	}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	read, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
@@ -246,8 +246,8 @@ func resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationDele
 
 	backendAddressPoolId := splitId[1]
 
-	locks.ByName(nicID.NetworkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(nicID.NetworkInterfaceName, networkInterfaceResourceName)
+	locks.ByID(parse.NewNetworkInterfaceID(nicID.SubscriptionId, nicID.ResourceGroup, nicID.NetworkInterfaceName).ID())
+	defer locks.UnlockByID(parse.NewNetworkInterfaceID(nicID.SubscriptionId, nicID.ResourceGroup, nicID.NetworkInterfaceName).ID())
 
 	read, err := client.Get(ctx, nicID.ResourceGroup, nicID.NetworkInterfaceName, "")
 	if err != nil {
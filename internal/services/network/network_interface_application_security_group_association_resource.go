@@ -78,8 +78,8 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *plugin
 		return err
 	}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	read, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
@@ -194,8 +194,8 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationDelete(d *plugin
 
 	applicationSecurityGroupId := splitId[1]
 
-	locks.ByName(nicID.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(nicID.Name, networkInterfaceResourceName)
+	locks.ByID(nicID.ID())
+	defer locks.UnlockByID(nicID.ID())
 
 	read, err := client.Get(ctx, nicID.ResourceGroup, nicID.Name, "")
 	if err != nil {
@@ -0,0 +1,271 @@
+package network
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceNetworkInterfaceEffectiveSecurityRules() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkInterfaceEffectiveSecurityRulesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"network_security_group": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"network_interface_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"subnet_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"security_rule": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"source_port_ranges": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+
+									"destination_port_ranges": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+
+									"source_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+
+									"destination_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+
+									"expanded_source_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+
+									"expanded_destination_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+
+									"access": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"priority": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"direction": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"service_tag": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkInterfaceEffectiveSecurityRulesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	future, err := client.ListEffectiveNetworkSecurityGroups(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing Effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the Effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving Effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("network_interface_id", id.ID())
+
+	if err := d.Set("network_security_group", flattenNetworkInterfaceEffectiveNetworkSecurityGroups(result.Value)); err != nil {
+		return fmt.Errorf("setting `network_security_group`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkInterfaceEffectiveNetworkSecurityGroups(input *[]network.EffectiveNetworkSecurityGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		networkSecurityGroupId := ""
+		if item.NetworkSecurityGroup != nil && item.NetworkSecurityGroup.ID != nil {
+			networkSecurityGroupId = *item.NetworkSecurityGroup.ID
+		}
+
+		networkInterfaceId := ""
+		subnetId := ""
+		if association := item.Association; association != nil {
+			if association.NetworkInterface != nil && association.NetworkInterface.ID != nil {
+				networkInterfaceId = *association.NetworkInterface.ID
+			}
+			if association.Subnet != nil && association.Subnet.ID != nil {
+				subnetId = *association.Subnet.ID
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                   networkSecurityGroupId,
+			"network_interface_id": networkInterfaceId,
+			"subnet_id":            subnetId,
+			"security_rule":        flattenNetworkInterfaceEffectiveSecurityRules(item.EffectiveSecurityRules),
+			"service_tag":          flattenNetworkInterfaceEffectiveServiceTags(item.TagMap),
+		})
+	}
+
+	return results
+}
+
+func flattenNetworkInterfaceEffectiveSecurityRules(input *[]network.EffectiveNetworkSecurityRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	// the API returns both the singular and plural forms of these fields, only one of which is populated
+	combine := func(single *string, multiple *[]string) []interface{} {
+		if single != nil && *single != "" {
+			return []interface{}{*single}
+		}
+		return utils.FlattenStringSlice(multiple)
+	}
+
+	for _, item := range *input {
+		priority := 0
+		if item.Priority != nil {
+			priority = int(*item.Priority)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                                  utils.NormalizeNilableString(item.Name),
+			"protocol":                              string(item.Protocol),
+			"source_port_ranges":                    combine(item.SourcePortRange, item.SourcePortRanges),
+			"destination_port_ranges":               combine(item.DestinationPortRange, item.DestinationPortRanges),
+			"source_address_prefixes":               combine(item.SourceAddressPrefix, item.SourceAddressPrefixes),
+			"destination_address_prefixes":          combine(item.DestinationAddressPrefix, item.DestinationAddressPrefixes),
+			"expanded_source_address_prefixes":      utils.FlattenStringSlice(item.ExpandedSourceAddressPrefix),
+			"expanded_destination_address_prefixes": utils.FlattenStringSlice(item.ExpandedDestinationAddressPrefix),
+			"access":                                string(item.Access),
+			"priority":                              priority,
+			"direction":                             string(item.Direction),
+		})
+	}
+
+	return results
+}
+
+func flattenNetworkInterfaceEffectiveServiceTags(input map[string][]string) []interface{} {
+	results := make([]interface{}, 0)
+
+	names := make([]string, 0)
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		prefixes := input[name]
+		results = append(results, map[string]interface{}{
+			"name":             name,
+			"address_prefixes": utils.FlattenStringSlice(&prefixes),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,159 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkInterfaceEffectiveSecurityRulesDataSource struct{}
+
+func TestAccDataSourceNetworkInterfaceEffectiveSecurityRules_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_interface_effective_security_rules", "test")
+	r := NetworkInterfaceEffectiveSecurityRulesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("network_security_group.#").HasValue("1"),
+				check.That(data.ResourceName).Key("network_security_group.0.id").Exists(),
+				check.That(data.ResourceName).Key("network_security_group.0.network_interface_id").Exists(),
+				check.That(data.ResourceName).Key("network_security_group.0.security_rule.#").Exists(),
+				check.That(data.ResourceName).Key("network_security_group.0.service_tag.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceNetworkInterfaceEffectiveSecurityRules_simulation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_security_flow_simulation", "test")
+	r := NetworkInterfaceEffectiveSecurityRulesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.simulation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("allowed").HasValue("true"),
+				check.That(data.ResourceName).Key("matched_rule_name").HasValue("AllowVnetInBound"),
+			),
+		},
+	})
+}
+
+func (NetworkInterfaceEffectiveSecurityRulesDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nic-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                       = "DenySSH"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Deny"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "22"
+    source_address_prefix      = "Internet"
+    destination_address_prefix = "*"
+  }
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_network_interface_security_group_association" "test" {
+  network_interface_id      = azurerm_network_interface.test.id
+  network_security_group_id = azurerm_network_security_group.test.id
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctestvm-%[1]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  size                            = "Standard_F2"
+  admin_username                  = "adminuser"
+  admin_password                  = "P@$$w0rd1234!"
+  disable_password_authentication = false
+  network_interface_ids           = [azurerm_network_interface.test.id]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  depends_on = [azurerm_network_interface_security_group_association.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r NetworkInterfaceEffectiveSecurityRulesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_interface_effective_security_rules" "test" {
+  network_interface_id = azurerm_linux_virtual_machine.test.network_interface_ids[0]
+}
+`, r.template(data))
+}
+
+func (r NetworkInterfaceEffectiveSecurityRulesDataSource) simulation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_security_flow_simulation" "test" {
+  direction           = "Inbound"
+  protocol            = "Tcp"
+  source_address      = "10.0.2.10"
+  source_port         = 50000
+  destination_address = azurerm_network_interface.test.private_ip_address
+  destination_port    = 22
+
+  security_rule = azurerm_network_security_group.test.security_rule
+  service_tag   = data.azurerm_network_interface_effective_security_rules.test.network_security_group.0.service_tag
+}
+`, r.basic(data))
+}
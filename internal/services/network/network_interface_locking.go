@@ -8,30 +8,33 @@ import (
 )
 
 type networkInterfaceIPConfigurationLockingDetails struct {
-	subnetNamesToLock         []string
-	virtualNetworkNamesToLock []string
+	subnetIDsToLock         []string
+	virtualNetworkIDsToLock []string
 }
 
+// the Virtual Networks are locked before the Subnets, matching the order used by the Subnet resources
 func (details networkInterfaceIPConfigurationLockingDetails) lock() {
-	locks.MultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.MultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+	locks.MultipleByID(&details.virtualNetworkIDsToLock)
+	locks.MultipleByID(&details.subnetIDsToLock)
 }
 
 func (details networkInterfaceIPConfigurationLockingDetails) unlock() {
-	locks.UnlockMultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.UnlockMultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+	locks.UnlockMultipleByID(&details.subnetIDsToLock)
+	locks.UnlockMultipleByID(&details.virtualNetworkIDsToLock)
 }
 
-func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPConfiguration) (*networkInterfaceIPConfigurationLockingDetails, error) {
+// determineResourcesToLockFromIPConfiguration returns the Subnets (and unless relaxed locking is enabled, the
+// Virtual Networks) used by the IP Configurations which need to be locked whilst the Network Interface is modified
+func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPConfiguration, relaxedLocking bool) (*networkInterfaceIPConfigurationLockingDetails, error) {
 	if input == nil {
 		return &networkInterfaceIPConfigurationLockingDetails{
-			subnetNamesToLock:         []string{},
-			virtualNetworkNamesToLock: []string{},
+			subnetIDsToLock:         []string{},
+			virtualNetworkIDsToLock: []string{},
 		}, nil
 	}
 
-	subnetNamesToLock := make([]string, 0)
-	virtualNetworkNamesToLock := make([]string, 0)
+	subnetIDsToLock := make([]string, 0)
+	virtualNetworkIDsToLock := make([]string, 0)
 
 	for _, config := range *input {
 		if config.Subnet == nil || config.Subnet.ID == nil {
@@ -43,20 +46,18 @@ func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPCon
 			return nil, err
 		}
 
-		virtualNetworkName := id.VirtualNetworkName
-		subnetName := id.Name
-
-		if !utils.SliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
-			virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
+		virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName).ID()
+		if !relaxedLocking && !utils.SliceContainsValue(virtualNetworkIDsToLock, virtualNetworkId) {
+			virtualNetworkIDsToLock = append(virtualNetworkIDsToLock, virtualNetworkId)
 		}
 
-		if !utils.SliceContainsValue(subnetNamesToLock, subnetName) {
-			subnetNamesToLock = append(subnetNamesToLock, subnetName)
+		if !utils.SliceContainsValue(subnetIDsToLock, id.ID()) {
+			subnetIDsToLock = append(subnetIDsToLock, id.ID())
 		}
 	}
 
 	return &networkInterfaceIPConfigurationLockingDetails{
-		subnetNamesToLock:         subnetNamesToLock,
-		virtualNetworkNamesToLock: virtualNetworkNamesToLock,
+		subnetIDsToLock:         subnetIDsToLock,
+		virtualNetworkIDsToLock: virtualNetworkIDsToLock,
 	}, nil
 }
@@ -74,16 +74,16 @@ func resourceNetworkInterfaceSecurityGroupAssociationCreate(d *pluginsdk.Resourc
 		return err
 	}
 
-	locks.ByName(nicId.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(nicId.Name, networkInterfaceResourceName)
+	locks.ByID(nicId.ID())
+	defer locks.UnlockByID(nicId.ID())
 
 	nsgId, err := parse.NetworkSecurityGroupID(networkSecurityGroupId)
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(nsgId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(nsgId.Name, networkSecurityGroupResourceName)
+	locks.ByID(nsgId.ID())
+	defer locks.UnlockByID(nsgId.ID())
 
 	read, err := client.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
 	if err != nil {
@@ -183,8 +183,8 @@ func resourceNetworkInterfaceSecurityGroupAssociationDelete(d *pluginsdk.Resourc
 		return err
 	}
 
-	locks.ByName(nicID.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(nicID.Name, networkInterfaceResourceName)
+	locks.ByID(nicID.ID())
+	defer locks.UnlockByID(nicID.ID())
 
 	read, err := client.Get(ctx, nicID.ResourceGroup, nicID.Name, "")
 	if err != nil {
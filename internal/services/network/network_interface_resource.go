@@ -21,8 +21,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-var networkInterfaceResourceName = "azurerm_network_interface"
-
 func resourceNetworkInterface() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceNetworkInterfaceCreate,
@@ -221,8 +219,8 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		EnableAcceleratedNetworking: &enableAcceleratedNetworking,
 	}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	dns, hasDns := d.GetOk("dns_servers")
 	nameLabel, hasNameLabel := d.GetOk("internal_dns_name_label")
@@ -247,7 +245,7 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 	if err != nil {
 		return fmt.Errorf("expanding `ip_configuration`: %+v", err)
 	}
-	lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs)
+	lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs, meta.(*clients.Client).Features.Network.RelaxedLocking)
 	if err != nil {
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
@@ -289,8 +287,8 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	// first get the existing one so that we can pull things as needed
 	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
@@ -342,7 +340,7 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		if err != nil {
 			return fmt.Errorf("expanding `ip_configuration`: %+v", err)
 		}
-		lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs)
+		lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs, meta.(*clients.Client).Features.Network.RelaxedLocking)
 		if err != nil {
 			return fmt.Errorf("determining locking details: %+v", err)
 		}
@@ -484,8 +482,8 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
@@ -503,7 +501,7 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 	}
 	props := *existing.InterfacePropertiesFormat
 
-	lockingDetails, err := determineResourcesToLockFromIPConfiguration(props.IPConfigurations)
+	lockingDetails, err := determineResourcesToLockFromIPConfiguration(props.IPConfigurations, meta.(*clients.Client).Features.Network.RelaxedLocking)
 	if err != nil {
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
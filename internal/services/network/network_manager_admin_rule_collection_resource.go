@@ -0,0 +1,173 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-01-01/networkmanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetworkManagerAdminRuleCollection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerAdminRuleCollectionCreateUpdate,
+		Read:   resourceNetworkManagerAdminRuleCollectionRead,
+		Update: resourceNetworkManagerAdminRuleCollectionCreateUpdate,
+		Delete: resourceNetworkManagerAdminRuleCollectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkManagerAdminRuleCollectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"security_admin_configuration_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkManagerSecurityAdminConfigurationID,
+			},
+
+			"network_group_ids": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.NetworkManagerNetworkGroupID,
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceNetworkManagerAdminRuleCollectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagerAdminRuleCollectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	configurationId, err := parse.NetworkManagerSecurityAdminConfigurationID(d.Get("security_admin_configuration_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkManagerAdminRuleCollectionID(configurationId.SubscriptionId, configurationId.ResourceGroup, configurationId.NetworkManagerName, configurationId.SecurityAdminConfigurationName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_network_manager_admin_rule_collection", id.ID())
+		}
+	}
+
+	groups := make([]networkmanager.NetworkManagerSecurityGroupItem, 0)
+	for _, v := range d.Get("network_group_ids").([]interface{}) {
+		groups = append(groups, networkmanager.NetworkManagerSecurityGroupItem{
+			NetworkGroupId: v.(string),
+		})
+	}
+
+	parameters := networkmanager.AdminRuleCollection{
+		Properties: networkmanager.AdminRuleCollectionProperties{
+			AppliesToGroups: groups,
+		},
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		parameters.Properties.Description = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceNetworkManagerAdminRuleCollectionRead(d, meta)
+}
+
+func resourceNetworkManagerAdminRuleCollectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagerAdminRuleCollectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerAdminRuleCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.RuleCollectionName)
+	d.Set("security_admin_configuration_id", parse.NewNetworkManagerSecurityAdminConfigurationID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.SecurityAdminConfigurationName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("description", utils.NormalizeNilableString(model.Properties.Description))
+
+		groupIds := make([]interface{}, 0)
+		for _, v := range model.Properties.AppliesToGroups {
+			groupIds = append(groupIds, v.NetworkGroupId)
+		}
+		if err := d.Set("network_group_ids", groupIds); err != nil {
+			return fmt.Errorf("setting `network_group_ids`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerAdminRuleCollectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagerAdminRuleCollectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerAdminRuleCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerAdminRuleCollectionResource struct{}
+
+func testAccNetworkManagerAdminRuleCollection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule_collection", "test")
+	r := NetworkManagerAdminRuleCollectionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerAdminRuleCollection_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule_collection", "test")
+	r := NetworkManagerAdminRuleCollectionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerAdminRuleCollection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule_collection", "test")
+	r := NetworkManagerAdminRuleCollectionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r NetworkManagerAdminRuleCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkManagerAdminRuleCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ManagerAdminRuleCollectionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r NetworkManagerAdminRuleCollectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_security_admin_configuration" "test" {
+  name               = "acctest-nmsac-%d"
+  network_manager_id = azurerm_network_manager.test.id
+}
+`, NetworkManagerNetworkGroupResource{}.basic(data), data.RandomInteger)
+}
+
+func (r NetworkManagerAdminRuleCollectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule_collection" "test" {
+  name                            = "acctest-nmarc-%d"
+  security_admin_configuration_id = azurerm_network_manager_security_admin_configuration.test.id
+  network_group_ids               = [azurerm_network_manager_network_group.test.id]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkManagerAdminRuleCollectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule_collection" "import" {
+  name                            = azurerm_network_manager_admin_rule_collection.test.name
+  security_admin_configuration_id = azurerm_network_manager_admin_rule_collection.test.security_admin_configuration_id
+  network_group_ids               = azurerm_network_manager_admin_rule_collection.test.network_group_ids
+}
+`, r.basic(data))
+}
+
+func (r NetworkManagerAdminRuleCollectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule_collection" "test" {
+  name                            = "acctest-nmarc-%d"
+  security_admin_configuration_id = azurerm_network_manager_security_admin_configuration.test.id
+  network_group_ids               = [azurerm_network_manager_network_group.test.id]
+  description                     = "acctest admin rule collection"
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,286 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-01-01/networkmanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetworkManagerAdminRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerAdminRuleCreateUpdate,
+		Read:   resourceNetworkManagerAdminRuleRead,
+		Update: resourceNetworkManagerAdminRuleCreateUpdate,
+		Delete: resourceNetworkManagerAdminRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkManagerAdminRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"admin_rule_collection_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkManagerAdminRuleCollectionID,
+			},
+
+			"action": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(networkmanager.PossibleValuesForSecurityConfigurationRuleAccess(), false),
+			},
+
+			"direction": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(networkmanager.PossibleValuesForSecurityConfigurationRuleDirection(), false),
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4096),
+			},
+
+			"protocol": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(networkmanager.PossibleValuesForSecurityConfigurationRuleProtocol(), false),
+			},
+
+			"source": networkManagerAdminRuleAddressPrefixSchema(),
+
+			"destination": networkManagerAdminRuleAddressPrefixSchema(),
+
+			"source_port_ranges": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"destination_port_ranges": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func networkManagerAdminRuleAddressPrefixSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"address_prefix": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"address_prefix_type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(networkmanager.PossibleValuesForAddressPrefixType(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkManagerAdminRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagerAdminRulesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	collectionId, err := parse.NetworkManagerAdminRuleCollectionID(d.Get("admin_rule_collection_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkManagerAdminRuleID(collectionId.SubscriptionId, collectionId.ResourceGroup, collectionId.NetworkManagerName, collectionId.SecurityAdminConfigurationName, collectionId.RuleCollectionName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_network_manager_admin_rule", id.ID())
+		}
+	}
+
+	parameters := networkmanager.AdminRule{
+		Kind: networkmanager.AdminRuleKindCustom,
+		Properties: networkmanager.AdminRuleProperties{
+			Access:                networkmanager.SecurityConfigurationRuleAccess(d.Get("action").(string)),
+			DestinationPortRanges: utils.ExpandStringSlice(d.Get("destination_port_ranges").([]interface{})),
+			Destinations:          expandNetworkManagerAddressPrefixItems(d.Get("destination").([]interface{})),
+			Direction:             networkmanager.SecurityConfigurationRuleDirection(d.Get("direction").(string)),
+			Priority:              int64(d.Get("priority").(int)),
+			Protocol:              networkmanager.SecurityConfigurationRuleProtocol(d.Get("protocol").(string)),
+			SourcePortRanges:      utils.ExpandStringSlice(d.Get("source_port_ranges").([]interface{})),
+			Sources:               expandNetworkManagerAddressPrefixItems(d.Get("source").([]interface{})),
+		},
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		parameters.Properties.Description = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceNetworkManagerAdminRuleRead(d, meta)
+}
+
+func resourceNetworkManagerAdminRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagerAdminRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerAdminRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.RuleName)
+	d.Set("admin_rule_collection_id", parse.NewNetworkManagerAdminRuleCollectionID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.SecurityAdminConfigurationName, id.RuleCollectionName).ID())
+
+	if model := resp.Model; model != nil {
+		if model.Kind != networkmanager.AdminRuleKindCustom {
+			return fmt.Errorf("retrieving %s: expected a rule of kind %q but got %q", id, string(networkmanager.AdminRuleKindCustom), string(model.Kind))
+		}
+
+		props := model.Properties
+		d.Set("action", string(props.Access))
+		d.Set("direction", string(props.Direction))
+		d.Set("priority", int(props.Priority))
+		d.Set("protocol", string(props.Protocol))
+		d.Set("description", utils.NormalizeNilableString(props.Description))
+
+		if err := d.Set("source", flattenNetworkManagerAddressPrefixItems(props.Sources)); err != nil {
+			return fmt.Errorf("setting `source`: %+v", err)
+		}
+
+		if err := d.Set("destination", flattenNetworkManagerAddressPrefixItems(props.Destinations)); err != nil {
+			return fmt.Errorf("setting `destination`: %+v", err)
+		}
+
+		if err := d.Set("source_port_ranges", utils.FlattenStringSlice(props.SourcePortRanges)); err != nil {
+			return fmt.Errorf("setting `source_port_ranges`: %+v", err)
+		}
+
+		if err := d.Set("destination_port_ranges", utils.FlattenStringSlice(props.DestinationPortRanges)); err != nil {
+			return fmt.Errorf("setting `destination_port_ranges`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerAdminRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagerAdminRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerAdminRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandNetworkManagerAddressPrefixItems(input []interface{}) *[]networkmanager.AddressPrefixItem {
+	results := make([]networkmanager.AddressPrefixItem, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		prefixType := networkmanager.AddressPrefixType(v["address_prefix_type"].(string))
+		results = append(results, networkmanager.AddressPrefixItem{
+			AddressPrefix:     utils.String(v["address_prefix"].(string)),
+			AddressPrefixType: &prefixType,
+		})
+	}
+
+	return &results
+}
+
+func flattenNetworkManagerAddressPrefixItems(input *[]networkmanager.AddressPrefixItem) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		prefixType := ""
+		if v.AddressPrefixType != nil {
+			prefixType = string(*v.AddressPrefixType)
+		}
+
+		results = append(results, map[string]interface{}{
+			"address_prefix":      utils.NormalizeNilableString(v.AddressPrefix),
+			"address_prefix_type": prefixType,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,328 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-01-01/networkmanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetworkManagerConnectivityConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerConnectivityConfigurationCreateUpdate,
+		Read:   resourceNetworkManagerConnectivityConfigurationRead,
+		Update: resourceNetworkManagerConnectivityConfigurationCreateUpdate,
+		Delete: resourceNetworkManagerConnectivityConfigurationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkManagerConnectivityConfigurationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"network_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkManagerID,
+			},
+
+			"connectivity_topology": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(networkmanager.PossibleValuesForConnectivityTopology(), false),
+			},
+
+			"applies_to_group": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"network_group_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NetworkManagerNetworkGroupID,
+						},
+
+						"group_connectivity": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(networkmanager.GroupConnectivityNone),
+							ValidateFunc: validation.StringInSlice(networkmanager.PossibleValuesForGroupConnectivity(), false),
+						},
+
+						"global_mesh_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"use_hub_gateway": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"hub": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"resource_type": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"delete_existing_peering_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"global_mesh_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceNetworkManagerConnectivityConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagerConnectivityConfigurationsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	networkManagerId, err := parse.NetworkManagerID(d.Get("network_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkManagerConnectivityConfigurationID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroup, networkManagerId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_network_manager_connectivity_configuration", id.ID())
+		}
+	}
+
+	topology := networkmanager.ConnectivityTopology(d.Get("connectivity_topology").(string))
+	hubs := expandNetworkManagerHubs(d.Get("hub").([]interface{}))
+	if topology == networkmanager.ConnectivityTopologyHubAndSpoke && len(*hubs) == 0 {
+		return fmt.Errorf("a `hub` block must be specified when `connectivity_topology` is `%s`", string(networkmanager.ConnectivityTopologyHubAndSpoke))
+	}
+
+	deleteExistingPeering := networkmanager.DeleteExistingPeeringFalse
+	if d.Get("delete_existing_peering_enabled").(bool) {
+		deleteExistingPeering = networkmanager.DeleteExistingPeeringTrue
+	}
+
+	parameters := networkmanager.ConnectivityConfiguration{
+		Properties: networkmanager.ConnectivityConfigurationProperties{
+			AppliesToGroups:       expandNetworkManagerConnectivityGroupItems(d.Get("applies_to_group").([]interface{})),
+			ConnectivityTopology:  topology,
+			DeleteExistingPeering: &deleteExistingPeering,
+			Hubs:                  hubs,
+			IsGlobal:              expandNetworkManagerIsGlobal(d.Get("global_mesh_enabled").(bool)),
+		},
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		parameters.Properties.Description = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceNetworkManagerConnectivityConfigurationRead(d, meta)
+}
+
+func resourceNetworkManagerConnectivityConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagerConnectivityConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerConnectivityConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.ConnectivityConfigurationName)
+	d.Set("network_manager_id", parse.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+		d.Set("connectivity_topology", string(props.ConnectivityTopology))
+		d.Set("delete_existing_peering_enabled", props.DeleteExistingPeering != nil && *props.DeleteExistingPeering == networkmanager.DeleteExistingPeeringTrue)
+		d.Set("global_mesh_enabled", flattenNetworkManagerIsGlobal(props.IsGlobal))
+		d.Set("description", utils.NormalizeNilableString(props.Description))
+
+		if err := d.Set("applies_to_group", flattenNetworkManagerConnectivityGroupItems(props.AppliesToGroups)); err != nil {
+			return fmt.Errorf("setting `applies_to_group`: %+v", err)
+		}
+
+		if err := d.Set("hub", flattenNetworkManagerHubs(props.Hubs)); err != nil {
+			return fmt.Errorf("setting `hub`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerConnectivityConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagerConnectivityConfigurationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerConnectivityConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandNetworkManagerConnectivityGroupItems(input []interface{}) []networkmanager.ConnectivityGroupItem {
+	results := make([]networkmanager.ConnectivityGroupItem, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		useHubGateway := networkmanager.UseHubGatewayFalse
+		if v["use_hub_gateway"].(bool) {
+			useHubGateway = networkmanager.UseHubGatewayTrue
+		}
+
+		results = append(results, networkmanager.ConnectivityGroupItem{
+			GroupConnectivity: networkmanager.GroupConnectivity(v["group_connectivity"].(string)),
+			IsGlobal:          expandNetworkManagerIsGlobal(v["global_mesh_enabled"].(bool)),
+			NetworkGroupId:    v["network_group_id"].(string),
+			UseHubGateway:     &useHubGateway,
+		})
+	}
+
+	return results
+}
+
+func flattenNetworkManagerConnectivityGroupItems(input []networkmanager.ConnectivityGroupItem) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, v := range input {
+		results = append(results, map[string]interface{}{
+			"network_group_id":    v.NetworkGroupId,
+			"group_connectivity":  string(v.GroupConnectivity),
+			"global_mesh_enabled": flattenNetworkManagerIsGlobal(v.IsGlobal),
+			"use_hub_gateway":     v.UseHubGateway != nil && *v.UseHubGateway == networkmanager.UseHubGatewayTrue,
+		})
+	}
+
+	return results
+}
+
+func expandNetworkManagerHubs(input []interface{}) *[]networkmanager.Hub {
+	results := make([]networkmanager.Hub, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, networkmanager.Hub{
+			ResourceId:   utils.String(v["resource_id"].(string)),
+			ResourceType: utils.String(v["resource_type"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenNetworkManagerHubs(input *[]networkmanager.Hub) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		results = append(results, map[string]interface{}{
+			"resource_id":   utils.NormalizeNilableString(v.ResourceId),
+			"resource_type": utils.NormalizeNilableString(v.ResourceType),
+		})
+	}
+
+	return results
+}
+
+func expandNetworkManagerIsGlobal(input bool) *networkmanager.IsGlobal {
+	isGlobal := networkmanager.IsGlobalFalse
+	if input {
+		isGlobal = networkmanager.IsGlobalTrue
+	}
+	return &isGlobal
+}
+
+func flattenNetworkManagerIsGlobal(input *networkmanager.IsGlobal) bool {
+	return input != nil && *input == networkmanager.IsGlobalTrue
+}
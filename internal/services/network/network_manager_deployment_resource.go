@@ -0,0 +1,266 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-01-01/networkmanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetworkManagerDeployment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerDeploymentCreateUpdate,
+		Read:   resourceNetworkManagerDeploymentRead,
+		Update: resourceNetworkManagerDeploymentCreateUpdate,
+		Delete: resourceNetworkManagerDeploymentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkManagerDeploymentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkManagerID,
+			},
+
+			"location": location.Schema(),
+
+			"scope_access": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(networkmanager.PossibleValuesForConfigurationType(), false),
+			},
+
+			"configuration_ids": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: pluginsdk.HashString,
+			},
+		},
+	}
+}
+
+func resourceNetworkManagerDeploymentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	networkManagerId, err := parse.NetworkManagerID(d.Get("network_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkManagerDeploymentID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroup, networkManagerId.Name, azure.NormalizeLocation(d.Get("location").(string)), d.Get("scope_access").(string))
+
+	// commits to the same Network Manager conflict with one another, so these need to be serialised
+	locks.ByID(networkManagerId.ID())
+	defer locks.UnlockByID(networkManagerId.ID())
+
+	if d.IsNewResource() {
+		existing, err := networkManagerDeploymentStatus(ctx, client, id)
+		if err != nil {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+
+		if existing != nil && existing.ConfigurationIds != nil && len(*existing.ConfigurationIds) > 0 {
+			return fmt.Errorf("configurations of type %q have already been deployed to %q by %s - to be managed via Terraform this needs to be imported into the State. Please see the resource documentation for %q for more information", id.ScopeAccess, id.Location, networkManagerId, "azurerm_network_manager_deployment")
+		}
+	}
+
+	configurationIds := make([]string, 0)
+	for _, v := range d.Get("configuration_ids").(*pluginsdk.Set).List() {
+		configurationIds = append(configurationIds, v.(string))
+	}
+
+	if err := commitNetworkManagerDeployment(ctx, client, id, configurationIds); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	return resourceNetworkManagerDeploymentRead(d, meta)
+}
+
+func resourceNetworkManagerDeploymentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	status, err := networkManagerDeploymentStatus(ctx, client, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if status == nil || status.ConfigurationIds == nil || len(*status.ConfigurationIds) == 0 {
+		log.Printf("[DEBUG] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_manager_id", id.NetworkManagerId().ID())
+	d.Set("location", location.Normalize(id.Location))
+	d.Set("scope_access", id.ScopeAccess)
+
+	if err := d.Set("configuration_ids", utils.FlattenStringSlice(status.ConfigurationIds)); err != nil {
+		return fmt.Errorf("setting `configuration_ids`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerDeploymentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.NetworkManagerId().ID())
+	defer locks.UnlockByID(id.NetworkManagerId().ID())
+
+	// committing an empty set of configurations removes those previously deployed to this region
+	return commitNetworkManagerDeployment(ctx, client, *id, []string{})
+}
+
+// commitNetworkManagerDeployment commits the specified configurations to the region and then waits for the
+// Network Manager to report that they've been deployed, since the commit itself completes asynchronously
+func commitNetworkManagerDeployment(ctx context.Context, client *networkmanager.NetworkManagersClient, id parse.NetworkManagerDeploymentId, configurationIds []string) error {
+	input := networkmanager.NetworkManagerCommit{
+		CommitType:       networkmanager.ConfigurationType(id.ScopeAccess),
+		ConfigurationIds: &configurationIds,
+		TargetLocations:  []string{id.Location},
+	}
+	if _, err := client.CommitThenPoll(ctx, id.NetworkManagerId(), input); err != nil {
+		return fmt.Errorf("committing %s: %+v", id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	log.Printf("[DEBUG] Waiting for %s to be deployed..", id)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(networkmanager.DeploymentStatusNotStarted), string(networkmanager.DeploymentStatusDeploying)},
+		Target:     []string{string(networkmanager.DeploymentStatusDeployed)},
+		Refresh:    networkManagerDeploymentRefreshFunc(ctx, client, id, configurationIds),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be deployed: %+v", id, err)
+	}
+
+	return nil
+}
+
+func networkManagerDeploymentRefreshFunc(ctx context.Context, client *networkmanager.NetworkManagersClient, id parse.NetworkManagerDeploymentId, configurationIds []string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		status, err := networkManagerDeploymentStatus(ctx, client, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("polling the deployment status of %s: %+v", id, err)
+		}
+
+		// the status of the previous commit is returned until the latest one has been picked up
+		if status == nil || status.DeploymentStatus == nil {
+			if len(configurationIds) == 0 {
+				return "", string(networkmanager.DeploymentStatusDeployed), nil
+			}
+			return "", string(networkmanager.DeploymentStatusNotStarted), nil
+		}
+
+		if !networkManagerConfigurationIdsMatch(status.ConfigurationIds, configurationIds) {
+			return status, string(networkmanager.DeploymentStatusNotStarted), nil
+		}
+
+		if *status.DeploymentStatus == networkmanager.DeploymentStatusFailed {
+			return status, string(*status.DeploymentStatus), fmt.Errorf("deployment failed: %s", utils.NormalizeNilableString(status.ErrorMessage))
+		}
+
+		return status, string(*status.DeploymentStatus), nil
+	}
+}
+
+// networkManagerDeploymentStatus returns the deployment status for the region and type of configuration
+// identified by `id`, or nil when nothing has been committed
+func networkManagerDeploymentStatus(ctx context.Context, client *networkmanager.NetworkManagersClient, id parse.NetworkManagerDeploymentId) (*networkmanager.NetworkManagerDeploymentStatus, error) {
+	input := networkmanager.NetworkManagerDeploymentStatusParameter{
+		DeploymentTypes: &[]networkmanager.ConfigurationType{networkmanager.ConfigurationType(id.ScopeAccess)},
+		Regions:         &[]string{id.Location},
+	}
+	statuses, err := client.ListDeploymentStatus(ctx, id.NetworkManagerId(), input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range statuses {
+		if v.Region == nil || !strings.EqualFold(location.Normalize(*v.Region), location.Normalize(id.Location)) {
+			continue
+		}
+		if v.DeploymentType == nil || !strings.EqualFold(string(*v.DeploymentType), id.ScopeAccess) {
+			continue
+		}
+
+		status := v
+		return &status, nil
+	}
+
+	return nil, nil
+}
+
+func networkManagerConfigurationIdsMatch(actual *[]string, expected []string) bool {
+	normalise := func(input []string) []string {
+		output := make([]string, 0)
+		for _, v := range input {
+			output = append(output, strings.ToLower(v))
+		}
+		sort.Strings(output)
+		return output
+	}
+
+	actualIds := make([]string, 0)
+	if actual != nil {
+		actualIds = *actual
+	}
+
+	return strings.Join(normalise(actualIds), ",") == strings.Join(normalise(expected), ",")
+}
@@ -0,0 +1,209 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-01-01/networkmanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerDeploymentResource struct{}
+
+func testAccNetworkManagerDeployment_connectivity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_deployment", "test")
+	r := NetworkManagerDeploymentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.connectivity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerDeployment_securityAdmin(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_deployment", "test")
+	r := NetworkManagerDeploymentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.securityAdmin(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerDeployment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_deployment", "test")
+	r := NetworkManagerDeploymentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.connectivity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("configuration_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.connectivityUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("configuration_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.connectivity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("configuration_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerDeployment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_deployment", "test")
+	r := NetworkManagerDeploymentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.connectivity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r NetworkManagerDeploymentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkManagerDeploymentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	input := networkmanager.NetworkManagerDeploymentStatusParameter{
+		DeploymentTypes: &[]networkmanager.ConfigurationType{networkmanager.ConfigurationType(id.ScopeAccess)},
+		Regions:         &[]string{id.Location},
+	}
+	statuses, err := clients.Network.ManagersClient.ListDeploymentStatus(ctx, id.NetworkManagerId(), input)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	for _, v := range statuses {
+		if v.Region == nil || !strings.EqualFold(location.Normalize(*v.Region), location.Normalize(id.Location)) {
+			continue
+		}
+		if v.DeploymentType == nil || !strings.EqualFold(string(*v.DeploymentType), id.ScopeAccess) {
+			continue
+		}
+
+		return utils.Bool(v.ConfigurationIds != nil && len(*v.ConfigurationIds) > 0), nil
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r NetworkManagerDeploymentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_connectivity_configuration" "test" {
+  name                  = "acctest-nmcc-%d"
+  network_manager_id    = azurerm_network_manager.test.id
+  connectivity_topology = "Mesh"
+
+  applies_to_group {
+    network_group_id   = azurerm_network_manager_network_group.test.id
+    group_connectivity = "DirectlyConnected"
+  }
+}
+
+resource "azurerm_network_manager_connectivity_configuration" "other" {
+  name                  = "acctest-nmcc-other-%d"
+  network_manager_id    = azurerm_network_manager.test.id
+  connectivity_topology = "Mesh"
+
+  applies_to_group {
+    network_group_id = azurerm_network_manager_network_group.test.id
+  }
+}
+`, NetworkManagerNetworkGroupResource{}.basic(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r NetworkManagerDeploymentResource) connectivity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_deployment" "test" {
+  network_manager_id = azurerm_network_manager.test.id
+  location           = azurerm_resource_group.test.location
+  scope_access       = "Connectivity"
+  configuration_ids  = [azurerm_network_manager_connectivity_configuration.test.id]
+}
+`, r.template(data))
+}
+
+func (r NetworkManagerDeploymentResource) connectivityUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_deployment" "test" {
+  network_manager_id = azurerm_network_manager.test.id
+  location           = azurerm_resource_group.test.location
+  scope_access       = "Connectivity"
+  configuration_ids = [
+    azurerm_network_manager_connectivity_configuration.test.id,
+    azurerm_network_manager_connectivity_configuration.other.id,
+  ]
+}
+`, r.template(data))
+}
+
+func (r NetworkManagerDeploymentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_deployment" "import" {
+  network_manager_id = azurerm_network_manager_deployment.test.network_manager_id
+  location           = azurerm_network_manager_deployment.test.location
+  scope_access       = azurerm_network_manager_deployment.test.scope_access
+  configuration_ids  = azurerm_network_manager_deployment.test.configuration_ids
+}
+`, r.connectivity(data))
+}
+
+func (r NetworkManagerDeploymentResource) securityAdmin(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_deployment" "test" {
+  network_manager_id = azurerm_network_manager.test.id
+  location           = azurerm_resource_group.test.location
+  scope_access       = "SecurityAdmin"
+  configuration_ids  = [azurerm_network_manager_security_admin_configuration.test.id]
+
+  depends_on = [azurerm_network_manager_admin_rule.test]
+}
+`, NetworkManagerAdminRuleResource{}.basic(data))
+}
@@ -0,0 +1,231 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-01-01/networkmanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetworkManager() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerCreateUpdate,
+		Read:   resourceNetworkManagerRead,
+		Update: resourceNetworkManagerCreateUpdate,
+		Delete: resourceNetworkManagerDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkManagerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": location.Schema(),
+
+			"scope": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"management_group_ids": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: commonids.ValidateManagementGroupID,
+							},
+							AtLeastOneOf: []string{"scope.0.management_group_ids", "scope.0.subscription_ids"},
+						},
+
+						"subscription_ids": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: commonids.ValidateSubscriptionID,
+							},
+							AtLeastOneOf: []string{"scope.0.management_group_ids", "scope.0.subscription_ids"},
+						},
+					},
+				},
+			},
+
+			"scope_accesses": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice(networkmanager.PossibleValuesForConfigurationType(), false),
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceNetworkManagerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagersClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewNetworkManagerID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_network_manager", id.ID())
+		}
+	}
+
+	scopeAccesses := make([]networkmanager.ConfigurationType, 0)
+	for _, v := range d.Get("scope_accesses").([]interface{}) {
+		scopeAccesses = append(scopeAccesses, networkmanager.ConfigurationType(v.(string)))
+	}
+
+	parameters := networkmanager.NetworkManager{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: networkmanager.NetworkManagerProperties{
+			NetworkManagerScopeAccesses: scopeAccesses,
+			NetworkManagerScopes:        expandNetworkManagerScope(d.Get("scope").([]interface{})),
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		parameters.Properties.Description = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceNetworkManagerRead(d, meta)
+}
+
+func resourceNetworkManagerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+		d.Set("description", utils.NormalizeNilableString(model.Properties.Description))
+
+		scopeAccesses := make([]interface{}, 0)
+		for _, v := range model.Properties.NetworkManagerScopeAccesses {
+			scopeAccesses = append(scopeAccesses, string(v))
+		}
+		if err := d.Set("scope_accesses", scopeAccesses); err != nil {
+			return fmt.Errorf("setting `scope_accesses`: %+v", err)
+		}
+
+		if err := d.Set("scope", flattenNetworkManagerScope(model.Properties.NetworkManagerScopes)); err != nil {
+			return fmt.Errorf("setting `scope`: %+v", err)
+		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandNetworkManagerScope(input []interface{}) networkmanager.NetworkManagerPropertiesScopes {
+	if len(input) == 0 || input[0] == nil {
+		return networkmanager.NetworkManagerPropertiesScopes{}
+	}
+
+	v := input[0].(map[string]interface{})
+	return networkmanager.NetworkManagerPropertiesScopes{
+		ManagementGroups: utils.ExpandStringSlice(v["management_group_ids"].([]interface{})),
+		Subscriptions:    utils.ExpandStringSlice(v["subscription_ids"].([]interface{})),
+	}
+}
+
+func flattenNetworkManagerScope(input networkmanager.NetworkManagerPropertiesScopes) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"management_group_ids": utils.FlattenStringSlice(input.ManagementGroups),
+			"subscription_ids":     utils.FlattenStringSlice(input.Subscriptions),
+		},
+	}
+}
@@ -0,0 +1,230 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerResource struct{}
+
+// Network Managers scoped to the same Subscription conflict with one another, so all of the
+// Network Manager resources are tested in sequence from this single entrypoint
+func TestAccNetworkManager(t *testing.T) {
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"Manager": {
+			"basic":          testAccNetworkManager_basic,
+			"complete":       testAccNetworkManager_complete,
+			"update":         testAccNetworkManager_update,
+			"requiresImport": testAccNetworkManager_requiresImport,
+		},
+		"NetworkGroup": {
+			"basic":          testAccNetworkManagerNetworkGroup_basic,
+			"complete":       testAccNetworkManagerNetworkGroup_complete,
+			"update":         testAccNetworkManagerNetworkGroup_update,
+			"requiresImport": testAccNetworkManagerNetworkGroup_requiresImport,
+		},
+		"StaticMember": {
+			"basic":          testAccNetworkManagerStaticMember_basic,
+			"requiresImport": testAccNetworkManagerStaticMember_requiresImport,
+		},
+		"ConnectivityConfiguration": {
+			"basic":          testAccNetworkManagerConnectivityConfiguration_basic,
+			"complete":       testAccNetworkManagerConnectivityConfiguration_complete,
+			"update":         testAccNetworkManagerConnectivityConfiguration_update,
+			"requiresImport": testAccNetworkManagerConnectivityConfiguration_requiresImport,
+		},
+		"SecurityAdminConfiguration": {
+			"basic":          testAccNetworkManagerSecurityAdminConfiguration_basic,
+			"complete":       testAccNetworkManagerSecurityAdminConfiguration_complete,
+			"requiresImport": testAccNetworkManagerSecurityAdminConfiguration_requiresImport,
+		},
+		"AdminRuleCollection": {
+			"basic":          testAccNetworkManagerAdminRuleCollection_basic,
+			"complete":       testAccNetworkManagerAdminRuleCollection_complete,
+			"requiresImport": testAccNetworkManagerAdminRuleCollection_requiresImport,
+		},
+		"AdminRule": {
+			"basic":          testAccNetworkManagerAdminRule_basic,
+			"complete":       testAccNetworkManagerAdminRule_complete,
+			"update":         testAccNetworkManagerAdminRule_update,
+			"requiresImport": testAccNetworkManagerAdminRule_requiresImport,
+		},
+		"Deployment": {
+			"connectivity":   testAccNetworkManagerDeployment_connectivity,
+			"securityAdmin":  testAccNetworkManagerDeployment_securityAdmin,
+			"update":         testAccNetworkManagerDeployment_update,
+			"requiresImport": testAccNetworkManagerDeployment_requiresImport,
+		},
+	})
+}
+
+func testAccNetworkManager_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager", "test")
+	r := NetworkManagerResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManager_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager", "test")
+	r := NetworkManagerResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManager_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager", "test")
+	r := NetworkManagerResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManager_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager", "test")
+	r := NetworkManagerResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r NetworkManagerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkManagerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ManagersClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r NetworkManagerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-networkmanager-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r NetworkManagerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager" "test" {
+  name                = "acctest-nm-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  scope_accesses      = ["Connectivity", "SecurityAdmin"]
+
+  scope {
+    subscription_ids = [data.azurerm_subscription.current.id]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkManagerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager" "import" {
+  name                = azurerm_network_manager.test.name
+  resource_group_name = azurerm_network_manager.test.resource_group_name
+  location            = azurerm_network_manager.test.location
+  scope_accesses      = azurerm_network_manager.test.scope_accesses
+
+  scope {
+    subscription_ids = azurerm_network_manager.test.scope.0.subscription_ids
+  }
+}
+`, r.basic(data))
+}
+
+func (r NetworkManagerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager" "test" {
+  name                = "acctest-nm-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  scope_accesses      = ["SecurityAdmin"]
+  description         = "acctest network manager"
+
+  scope {
+    subscription_ids = [data.azurerm_subscription.current.id]
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,114 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerSecurityAdminConfigurationResource struct{}
+
+func testAccNetworkManagerSecurityAdminConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_security_admin_configuration", "test")
+	r := NetworkManagerSecurityAdminConfigurationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerSecurityAdminConfiguration_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_security_admin_configuration", "test")
+	r := NetworkManagerSecurityAdminConfigurationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerSecurityAdminConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_security_admin_configuration", "test")
+	r := NetworkManagerSecurityAdminConfigurationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r NetworkManagerSecurityAdminConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkManagerSecurityAdminConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ManagerSecurityAdminConfigurationsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r NetworkManagerSecurityAdminConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_security_admin_configuration" "test" {
+  name               = "acctest-nmsac-%d"
+  network_manager_id = azurerm_network_manager.test.id
+}
+`, NetworkManagerResource{}.basic(data), data.RandomInteger)
+}
+
+func (r NetworkManagerSecurityAdminConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_security_admin_configuration" "import" {
+  name               = azurerm_network_manager_security_admin_configuration.test.name
+  network_manager_id = azurerm_network_manager_security_admin_configuration.test.network_manager_id
+}
+`, r.basic(data))
+}
+
+func (r NetworkManagerSecurityAdminConfigurationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_security_admin_configuration" "test" {
+  name                                          = "acctest-nmsac-%d"
+  network_manager_id                            = azurerm_network_manager.test.id
+  apply_on_network_intent_policy_based_services = ["None"]
+  description                                   = "acctest security admin configuration"
+}
+`, NetworkManagerResource{}.basic(data), data.RandomInteger)
+}
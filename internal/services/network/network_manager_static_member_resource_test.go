@@ -0,0 +1,102 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerStaticMemberResource struct{}
+
+func testAccNetworkManagerStaticMember_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_static_member", "test")
+	r := NetworkManagerStaticMemberResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("region").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerStaticMember_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_static_member", "test")
+	r := NetworkManagerStaticMemberResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r NetworkManagerStaticMemberResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkManagerStaticMemberID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ManagerStaticMembersClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r NetworkManagerStaticMemberResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_space       = ["10.0.0.0/16"]
+}
+`, NetworkManagerNetworkGroupResource{}.basic(data), data.RandomInteger)
+}
+
+func (r NetworkManagerStaticMemberResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_static_member" "test" {
+  name                      = "acctest-nmsm-%d"
+  network_group_id          = azurerm_network_manager_network_group.test.id
+  target_virtual_network_id = azurerm_virtual_network.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkManagerStaticMemberResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_static_member" "import" {
+  name                      = azurerm_network_manager_static_member.test.name
+  network_group_id          = azurerm_network_manager_static_member.test.network_group_id
+  target_virtual_network_id = azurerm_network_manager_static_member.test.target_virtual_network_id
+}
+`, r.basic(data))
+}
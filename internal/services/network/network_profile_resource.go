@@ -18,8 +18,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-const azureNetworkProfileResourceName = "azurerm_network_profile"
-
 func resourceNetworkProfile() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceNetworkProfileCreateUpdate,
@@ -122,19 +120,19 @@ func resourceNetworkProfileCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	subnetsToLock, vnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetNames(d)
+	subnetsToLock, vnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetIDs(d, meta.(*clients.Client).Features.Network.RelaxedLocking)
 	if err != nil {
 		return fmt.Errorf("extracting names of Subnet and Virtual Network: %+v", err)
 	}
 
-	locks.ByName(id.Name, azureNetworkProfileResourceName)
-	defer locks.UnlockByName(id.Name, azureNetworkProfileResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	locks.MultipleByID(vnetsToLock)
+	defer locks.UnlockMultipleByID(vnetsToLock)
 
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	locks.MultipleByID(subnetsToLock)
+	defer locks.UnlockMultipleByID(subnetsToLock)
 
 	parameters := network.Profile{
 		Location: &location,
@@ -216,19 +214,19 @@ func resourceNetworkProfileDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	subnetsToLock, vnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetNames(d)
+	subnetsToLock, vnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetIDs(d, meta.(*clients.Client).Features.Network.RelaxedLocking)
 	if err != nil {
 		return fmt.Errorf("extracting names of Subnet and Virtual Network: %+v", err)
 	}
 
-	locks.ByName(id.Name, azureNetworkProfileResourceName)
-	defer locks.UnlockByName(id.Name, azureNetworkProfileResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	locks.MultipleByID(vnetsToLock)
+	defer locks.UnlockMultipleByID(vnetsToLock)
 
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	locks.MultipleByID(subnetsToLock)
+	defer locks.UnlockMultipleByID(subnetsToLock)
 
 	if _, err = client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -277,10 +275,10 @@ func expandNetworkProfileContainerNetworkInterface(d *pluginsdk.ResourceData) *[
 	return &retCNIConfigs
 }
 
-func expandNetworkProfileVirtualNetworkSubnetNames(d *pluginsdk.ResourceData) (*[]string, *[]string, error) {
+func expandNetworkProfileVirtualNetworkSubnetIDs(d *pluginsdk.ResourceData, relaxedLocking bool) (*[]string, *[]string, error) {
 	cniConfigs := d.Get("container_network_interface").([]interface{})
-	subnetNames := make([]string, 0)
-	vnetNames := make([]string, 0)
+	subnetIDs := make([]string, 0)
+	vnetIDs := make([]string, 0)
 
 	for _, cniConfig := range cniConfigs {
 		nciData := cniConfig.(map[string]interface{})
@@ -295,17 +293,18 @@ func expandNetworkProfileVirtualNetworkSubnetNames(d *pluginsdk.ResourceData) (*
 				return nil, nil, err
 			}
 
-			if !utils.SliceContainsValue(subnetNames, subnetResourceID.Name) {
-				subnetNames = append(subnetNames, subnetResourceID.Name)
+			if !utils.SliceContainsValue(subnetIDs, subnetResourceID.ID()) {
+				subnetIDs = append(subnetIDs, subnetResourceID.ID())
 			}
 
-			if !utils.SliceContainsValue(vnetNames, subnetResourceID.VirtualNetworkName) {
-				vnetNames = append(vnetNames, subnetResourceID.VirtualNetworkName)
+			vnetID := parse.NewVirtualNetworkID(subnetResourceID.SubscriptionId, subnetResourceID.ResourceGroup, subnetResourceID.VirtualNetworkName).ID()
+			if !relaxedLocking && !utils.SliceContainsValue(vnetIDs, vnetID) {
+				vnetIDs = append(vnetIDs, vnetID)
 			}
 		}
 	}
 
-	return &subnetNames, &vnetNames, nil
+	return &subnetIDs, &vnetIDs, nil
 }
 
 func flattenNetworkProfileContainerNetworkInterface(input *[]network.ContainerNetworkInterfaceConfiguration) []interface{} {
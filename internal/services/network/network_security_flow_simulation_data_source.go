@@ -0,0 +1,365 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/securityrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// dataSourceNetworkSecurityFlowSimulation evaluates a flow against a set of Security Rules locally, without calling
+// the Azure API, so that connectivity can be asserted from the rules defined in a configuration
+func dataSourceNetworkSecurityFlowSimulation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkSecurityFlowSimulationRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"direction": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.SecurityRuleDirectionInbound),
+					string(network.SecurityRuleDirectionOutbound),
+				}, false),
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.SecurityRuleProtocolTCP),
+					string(network.SecurityRuleProtocolUDP),
+					string(network.SecurityRuleProtocolIcmp),
+					string(network.SecurityRuleProtocolAh),
+					string(network.SecurityRuleProtocolEsp),
+				}, false),
+			},
+
+			"source_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"source_port": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumberOrZero,
+			},
+
+			"destination_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"destination_port": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumberOrZero,
+			},
+
+			"source_application_security_group_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.ApplicationSecurityGroupID,
+				},
+			},
+
+			"destination_application_security_group_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.ApplicationSecurityGroupID,
+				},
+			},
+
+			// this matches the `security_rule` block within `azurerm_network_security_group`, so that the rules
+			// can be assigned directly from that resource
+			"security_rule": {
+				Type:       pluginsdk.TypeList,
+				ConfigMode: pluginsdk.SchemaConfigModeAttr,
+				Optional:   true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"description": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"protocol": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"source_port_range": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"source_port_ranges": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+
+						"destination_port_range": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"destination_port_ranges": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+
+						"source_address_prefix": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"source_address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+
+						"destination_address_prefix": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"destination_address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+
+						"source_application_security_group_ids": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+
+						"destination_application_security_group_ids": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+
+						"access": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.SecurityRuleAccessAllow),
+								string(network.SecurityRuleAccessDeny),
+							}, true),
+						},
+
+						"priority": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(100, 4096),
+						},
+
+						"direction": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.SecurityRuleDirectionInbound),
+								string(network.SecurityRuleDirectionOutbound),
+							}, true),
+						},
+					},
+				},
+			},
+
+			// this matches the `service_tag` block exported by `azurerm_network_interface_effective_security_rules`
+			"service_tag": {
+				Type:       pluginsdk.TypeList,
+				ConfigMode: pluginsdk.SchemaConfigModeAttr,
+				Optional:   true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"include_default_rules": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"allowed": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"access": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"matched_rule_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"matched_rule_priority": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkSecurityFlowSimulationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	// `GetOk` treats `0` as unset, so the raw config is used to determine whether the ports were specified
+	protocol := d.Get("protocol").(string)
+	if protocol == string(network.SecurityRuleProtocolTCP) || protocol == string(network.SecurityRuleProtocolUDP) {
+		config := d.GetRawConfig()
+		for _, key := range []string{"source_port", "destination_port"} {
+			if v := config.GetAttr(key); v.IsKnown() && v.IsNull() {
+				return fmt.Errorf("`%s` must be specified when `protocol` is `%s`", key, protocol)
+			}
+		}
+	}
+
+	flow := securityrules.Flow{
+		Direction:                              d.Get("direction").(string),
+		Protocol:                               protocol,
+		SourceAddress:                          d.Get("source_address").(string),
+		SourcePort:                             d.Get("source_port").(int),
+		DestinationAddress:                     d.Get("destination_address").(string),
+		DestinationPort:                        d.Get("destination_port").(int),
+		SourceApplicationSecurityGroupIds:      expandNetworkSecurityFlowSimulationStrings(d.Get("source_application_security_group_ids").([]interface{})),
+		DestinationApplicationSecurityGroupIds: expandNetworkSecurityFlowSimulationStrings(d.Get("destination_application_security_group_ids").([]interface{})),
+	}
+
+	evaluator := securityrules.Evaluator{
+		ServiceTags:         expandNetworkSecurityFlowSimulationServiceTags(d.Get("service_tag").([]interface{})),
+		IncludeDefaultRules: d.Get("include_default_rules").(bool),
+	}
+
+	rule, err := evaluator.Evaluate(ctx, flow, expandNetworkSecurityFlowSimulationRules(d.Get("security_rule").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("evaluating flow: %+v", err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	access := ""
+	ruleName := ""
+	rulePriority := 0
+	if rule != nil {
+		access = rule.Access
+		ruleName = rule.Name
+		rulePriority = rule.Priority
+	}
+
+	d.Set("allowed", strings.EqualFold(access, securityrules.AccessAllow))
+	d.Set("access", access)
+	d.Set("matched_rule_name", ruleName)
+	d.Set("matched_rule_priority", rulePriority)
+
+	return nil
+}
+
+func expandNetworkSecurityFlowSimulationRules(input []interface{}) []securityrules.Rule {
+	results := make([]securityrules.Rule, 0)
+
+	// the singular and plural forms are mutually exclusive within the API, so are combined here
+	combine := func(single string, multiple []interface{}) []string {
+		output := expandNetworkSecurityFlowSimulationStrings(multiple)
+		if single != "" {
+			output = append(output, single)
+		}
+		return output
+	}
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, securityrules.Rule{
+			Name:                                   v["name"].(string),
+			Priority:                               v["priority"].(int),
+			Direction:                              v["direction"].(string),
+			Access:                                 v["access"].(string),
+			Protocol:                               v["protocol"].(string),
+			SourceAddressPrefixes:                  combine(v["source_address_prefix"].(string), v["source_address_prefixes"].([]interface{})),
+			SourcePortRanges:                       combine(v["source_port_range"].(string), v["source_port_ranges"].([]interface{})),
+			SourceApplicationSecurityGroupIds:      expandNetworkSecurityFlowSimulationStrings(v["source_application_security_group_ids"].([]interface{})),
+			DestinationAddressPrefixes:             combine(v["destination_address_prefix"].(string), v["destination_address_prefixes"].([]interface{})),
+			DestinationPortRanges:                  combine(v["destination_port_range"].(string), v["destination_port_ranges"].([]interface{})),
+			DestinationApplicationSecurityGroupIds: expandNetworkSecurityFlowSimulationStrings(v["destination_application_security_group_ids"].([]interface{})),
+		})
+	}
+
+	return results
+}
+
+func expandNetworkSecurityFlowSimulationServiceTags(input []interface{}) map[string][]string {
+	results := make(map[string][]string)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		name := v["name"].(string)
+		results[name] = append(results[name], expandNetworkSecurityFlowSimulationStrings(v["address_prefixes"].([]interface{}))...)
+	}
+
+	return results
+}
+
+func expandNetworkSecurityFlowSimulationStrings(input []interface{}) []string {
+	results := make([]string, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+		results = append(results, v.(string))
+	}
+
+	return results
+}
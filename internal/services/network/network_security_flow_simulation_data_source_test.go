@@ -0,0 +1,174 @@
+package network_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkSecurityFlowSimulationDataSource struct{}
+
+func TestAccDataSourceNetworkSecurityFlowSimulation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_security_flow_simulation", "test")
+	r := NetworkSecurityFlowSimulationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("allowed").HasValue("true"),
+				check.That(data.ResourceName).Key("access").HasValue("Allow"),
+				check.That(data.ResourceName).Key("matched_rule_name").HasValue("AllowHttps"),
+				check.That(data.ResourceName).Key("matched_rule_priority").HasValue("100"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceNetworkSecurityFlowSimulation_defaultRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_security_flow_simulation", "test")
+	r := NetworkSecurityFlowSimulationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.defaultRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("allowed").HasValue("false"),
+				check.That(data.ResourceName).Key("access").HasValue("Deny"),
+				check.That(data.ResourceName).Key("matched_rule_name").HasValue("DenyAllInBound"),
+				check.That(data.ResourceName).Key("matched_rule_priority").HasValue("65500"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceNetworkSecurityFlowSimulation_unknownServiceTag(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_security_flow_simulation", "test")
+	r := NetworkSecurityFlowSimulationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      r.unknownServiceTag(),
+			ExpectError: regexp.MustCompile("the Service Tag \"Sql\" was not specified"),
+		},
+	})
+}
+
+func (NetworkSecurityFlowSimulationDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nsg-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                       = "AllowHttps"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_ranges    = ["443", "8443"]
+    source_address_prefix      = "Internet"
+    destination_address_prefix = "10.0.1.0/24"
+  }
+
+  security_rule {
+    name                       = "DenyStorage"
+    priority                   = 200
+    direction                  = "Outbound"
+    access                     = "Deny"
+    protocol                   = "*"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "*"
+    destination_address_prefix = "Storage"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r NetworkSecurityFlowSimulationDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_security_flow_simulation" "test" {
+  direction           = "Inbound"
+  protocol            = "Tcp"
+  source_address      = "198.51.100.10"
+  source_port         = 50000
+  destination_address = "10.0.1.4"
+  destination_port    = 8443
+
+  security_rule = azurerm_network_security_group.test.security_rule
+
+  service_tag {
+    name             = "VirtualNetwork"
+    address_prefixes = ["10.0.0.0/16"]
+  }
+}
+`, r.template(data))
+}
+
+func (r NetworkSecurityFlowSimulationDataSource) defaultRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_security_flow_simulation" "test" {
+  direction           = "Inbound"
+  protocol            = "Tcp"
+  source_address      = "198.51.100.10"
+  source_port         = 50000
+  destination_address = "10.0.1.4"
+  destination_port    = 22
+
+  security_rule = azurerm_network_security_group.test.security_rule
+
+  service_tag {
+    name             = "VirtualNetwork"
+    address_prefixes = ["10.0.0.0/16"]
+  }
+}
+`, r.template(data))
+}
+
+func (NetworkSecurityFlowSimulationDataSource) unknownServiceTag() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_network_security_flow_simulation" "test" {
+  direction           = "Outbound"
+  protocol            = "Tcp"
+  source_address      = "10.0.1.4"
+  source_port         = 50000
+  destination_address = "198.51.100.10"
+  destination_port    = 1433
+
+  security_rule {
+    name                       = "AllowSql"
+    priority                   = 100
+    direction                  = "Outbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "1433"
+    source_address_prefix      = "*"
+    destination_address_prefix = "Sql"
+  }
+}
+`
+}
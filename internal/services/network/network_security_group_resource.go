@@ -20,8 +20,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-var networkSecurityGroupResourceName = "azurerm_network_security_group"
-
 func resourceNetworkSecurityGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceNetworkSecurityGroupCreateUpdate,
@@ -209,8 +207,9 @@ func resourceNetworkSecurityGroupCreateUpdate(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("Building list of Network Security Group Rules: %+v", sgErr)
 	}
 
-	locks.ByName(name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(name, networkSecurityGroupResourceName)
+	id := parse.NewNetworkSecurityGroupID(meta.(*clients.Client).Account.SubscriptionId, resGroup, name)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	sg := network.SecurityGroup{
 		Name:     &name,
@@ -205,8 +205,9 @@ func resourceNetworkSecurityRuleCreateUpdate(d *pluginsdk.ResourceData, meta int
 	protocol := d.Get("protocol").(string)
 
 	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
-		locks.ByName(nsgName, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(nsgName, networkSecurityGroupResourceName)
+		networkSecurityGroupId := parse.NewNetworkSecurityGroupID(meta.(*clients.Client).Account.SubscriptionId, resGroup, nsgName)
+		locks.ByID(networkSecurityGroupId.ID())
+		defer locks.UnlockByID(networkSecurityGroupId.ID())
 	}
 
 	rule := network.SecurityRule{
@@ -373,8 +374,9 @@ func resourceNetworkSecurityRuleDelete(d *pluginsdk.ResourceData, meta interface
 	}
 
 	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
-		locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+		networkSecurityGroupId := parse.NewNetworkSecurityGroupID(id.SubscriptionId, id.ResourceGroup, id.NetworkSecurityGroupName)
+		locks.ByID(networkSecurityGroupId.ID())
+		defer locks.UnlockByID(networkSecurityGroupId.ID())
 	}
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.NetworkSecurityGroupName, id.Name)
@@ -0,0 +1,70 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NetworkManagerDeploymentId identifies the configurations of a given type (`Connectivity` or `SecurityAdmin`)
+// committed by a Network Manager to a single region - which isn't an ARM resource in its own right
+type NetworkManagerDeploymentId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	NetworkManagerName string
+	Location           string
+	ScopeAccess        string
+}
+
+func NewNetworkManagerDeploymentID(subscriptionId, resourceGroup, networkManagerName, location, scopeAccess string) NetworkManagerDeploymentId {
+	return NetworkManagerDeploymentId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		NetworkManagerName: networkManagerName,
+		Location:           location,
+		ScopeAccess:        scopeAccess,
+	}
+}
+
+func (id NetworkManagerDeploymentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Scope Access %q", id.ScopeAccess),
+		fmt.Sprintf("Location %q", id.Location),
+		fmt.Sprintf("Network Manager Name %q", id.NetworkManagerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Manager Deployment", segmentsStr)
+}
+
+func (id NetworkManagerDeploymentId) NetworkManagerId() NetworkManagerId {
+	return NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+}
+
+func (id NetworkManagerDeploymentId) ID() string {
+	return fmt.Sprintf("%s/commit|%s|%s", id.NetworkManagerId().ID(), id.Location, id.ScopeAccess)
+}
+
+// NetworkManagerDeploymentID parses a NetworkManagerDeployment ID in the format
+// `{networkManagerId}/commit|{location}|{scopeAccess}` into a NetworkManagerDeploymentId struct
+func NetworkManagerDeploymentID(input string) (*NetworkManagerDeploymentId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 3 || !strings.HasSuffix(segments[0], "/commit") {
+		return nil, fmt.Errorf("expected an ID in the format `{networkManagerId}/commit|{location}|{scopeAccess}` but got %q", input)
+	}
+
+	networkManagerId, err := NetworkManagerID(strings.TrimSuffix(segments[0], "/commit"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing Network Manager ID %q: %+v", segments[0], err)
+	}
+
+	if segments[1] == "" {
+		return nil, fmt.Errorf("ID was missing the location element")
+	}
+
+	if segments[2] == "" {
+		return nil, fmt.Errorf("ID was missing the scope access element")
+	}
+
+	id := NewNetworkManagerDeploymentID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroup, networkManagerId.Name, segments[1], segments[2])
+	return &id, nil
+}
@@ -0,0 +1,99 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = NetworkManagerDeploymentId{}
+
+func TestNetworkManagerDeploymentIDFormatter(t *testing.T) {
+	actual := NewNetworkManagerDeploymentID("12345678-1234-9876-4563-123456789012", "resGroup1", "manager1", "westeurope", "Connectivity").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/commit|westeurope|Connectivity"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestNetworkManagerDeploymentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *NetworkManagerDeploymentId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// network manager id only
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1",
+			Error: true,
+		},
+		{
+			// missing commit segment
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1|westeurope|Connectivity",
+			Error: true,
+		},
+		{
+			// missing scope access
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/commit|westeurope",
+			Error: true,
+		},
+		{
+			// empty location
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/commit||Connectivity",
+			Error: true,
+		},
+		{
+			// invalid network manager id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/commit|westeurope|Connectivity",
+			Error: true,
+		},
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/commit|westeurope|SecurityAdmin",
+			Expected: &NetworkManagerDeploymentId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "resGroup1",
+				NetworkManagerName: "manager1",
+				Location:           "westeurope",
+				ScopeAccess:        "SecurityAdmin",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := NetworkManagerDeploymentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.NetworkManagerName != v.Expected.NetworkManagerName {
+			t.Fatalf("Expected %q but got %q for NetworkManagerName", v.Expected.NetworkManagerName, actual.NetworkManagerName)
+		}
+		if actual.Location != v.Expected.Location {
+			t.Fatalf("Expected %q but got %q for Location", v.Expected.Location, actual.Location)
+		}
+		if actual.ScopeAccess != v.Expected.ScopeAccess {
+			t.Fatalf("Expected %q but got %q for ScopeAccess", v.Expected.ScopeAccess, actual.ScopeAccess)
+		}
+	}
+}
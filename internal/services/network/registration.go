@@ -21,57 +21,65 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_application_gateway":                       dataSourceApplicationGateway(),
-		"azurerm_application_security_group":                dataSourceApplicationSecurityGroup(),
-		"azurerm_express_route_circuit":                     dataSourceExpressRouteCircuit(),
-		"azurerm_ip_group":                                  dataSourceIpGroup(),
-		"azurerm_nat_gateway":                               dataSourceNatGateway(),
-		"azurerm_network_ddos_protection_plan":              dataSourceNetworkDDoSProtectionPlan(),
-		"azurerm_network_interface":                         dataSourceNetworkInterface(),
-		"azurerm_network_security_group":                    dataSourceNetworkSecurityGroup(),
-		"azurerm_network_watcher":                           dataSourceNetworkWatcher(),
-		"azurerm_private_endpoint_connection":               dataSourcePrivateEndpointConnection(),
-		"azurerm_private_link_service":                      dataSourcePrivateLinkService(),
-		"azurerm_private_link_service_endpoint_connections": dataSourcePrivateLinkServiceEndpointConnections(),
-		"azurerm_public_ip":                                 dataSourcePublicIP(),
-		"azurerm_public_ips":                                dataSourcePublicIPs(),
-		"azurerm_public_ip_prefix":                          dataSourcePublicIpPrefix(),
-		"azurerm_route_filter":                              dataSourceRouteFilter(),
-		"azurerm_route_table":                               dataSourceRouteTable(),
-		"azurerm_network_service_tags":                      dataSourceNetworkServiceTags(),
-		"azurerm_subnet":                                    dataSourceSubnet(),
-		"azurerm_virtual_hub":                               dataSourceVirtualHub(),
-		"azurerm_virtual_network_gateway":                   dataSourceVirtualNetworkGateway(),
-		"azurerm_virtual_network_gateway_connection":        dataSourceVirtualNetworkGatewayConnection(),
-		"azurerm_virtual_network":                           dataSourceVirtualNetwork(),
-		"azurerm_web_application_firewall_policy":           dataWebApplicationFirewallPolicy(),
-		"azurerm_virtual_wan":                               dataSourceVirtualWan(),
-		"azurerm_local_network_gateway":                     dataSourceLocalNetworkGateway(),
-		"azurerm_vpn_gateway":                               dataSourceVPNGateway(),
+		"azurerm_application_gateway":                        dataSourceApplicationGateway(),
+		"azurerm_application_security_group":                 dataSourceApplicationSecurityGroup(),
+		"azurerm_express_route_circuit":                      dataSourceExpressRouteCircuit(),
+		"azurerm_ip_group":                                   dataSourceIpGroup(),
+		"azurerm_nat_gateway":                                dataSourceNatGateway(),
+		"azurerm_network_ddos_protection_plan":               dataSourceNetworkDDoSProtectionPlan(),
+		"azurerm_network_interface":                          dataSourceNetworkInterface(),
+		"azurerm_network_interface_effective_security_rules": dataSourceNetworkInterfaceEffectiveSecurityRules(),
+		"azurerm_network_security_group":                     dataSourceNetworkSecurityGroup(),
+		"azurerm_network_security_flow_simulation":           dataSourceNetworkSecurityFlowSimulation(),
+		"azurerm_network_watcher":                            dataSourceNetworkWatcher(),
+		"azurerm_private_endpoint_connection":                dataSourcePrivateEndpointConnection(),
+		"azurerm_private_link_service":                       dataSourcePrivateLinkService(),
+		"azurerm_private_link_service_endpoint_connections":  dataSourcePrivateLinkServiceEndpointConnections(),
+		"azurerm_public_ip":                                  dataSourcePublicIP(),
+		"azurerm_public_ips":                                 dataSourcePublicIPs(),
+		"azurerm_public_ip_prefix":                           dataSourcePublicIpPrefix(),
+		"azurerm_route_filter":                               dataSourceRouteFilter(),
+		"azurerm_route_table":                                dataSourceRouteTable(),
+		"azurerm_network_service_tags":                       dataSourceNetworkServiceTags(),
+		"azurerm_subnet":                                     dataSourceSubnet(),
+		"azurerm_virtual_hub":                                dataSourceVirtualHub(),
+		"azurerm_virtual_network_gateway":                    dataSourceVirtualNetworkGateway(),
+		"azurerm_virtual_network_gateway_connection":         dataSourceVirtualNetworkGatewayConnection(),
+		"azurerm_virtual_network":                            dataSourceVirtualNetwork(),
+		"azurerm_web_application_firewall_policy":            dataWebApplicationFirewallPolicy(),
+		"azurerm_virtual_wan":                                dataSourceVirtualWan(),
+		"azurerm_local_network_gateway":                      dataSourceLocalNetworkGateway(),
+		"azurerm_vpn_gateway":                                dataSourceVPNGateway(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_application_gateway":                      resourceApplicationGateway(),
-		"azurerm_application_security_group":               resourceApplicationSecurityGroup(),
-		"azurerm_bastion_host":                             resourceBastionHost(),
-		"azurerm_express_route_circuit_connection":         resourceExpressRouteCircuitConnection(),
-		"azurerm_express_route_circuit_authorization":      resourceExpressRouteCircuitAuthorization(),
-		"azurerm_express_route_circuit_peering":            resourceExpressRouteCircuitPeering(),
-		"azurerm_express_route_circuit":                    resourceExpressRouteCircuit(),
-		"azurerm_express_route_connection":                 resourceExpressRouteConnection(),
-		"azurerm_express_route_gateway":                    resourceExpressRouteGateway(),
-		"azurerm_express_route_port":                       resourceArmExpressRoutePort(),
-		"azurerm_ip_group":                                 resourceIpGroup(),
-		"azurerm_local_network_gateway":                    resourceLocalNetworkGateway(),
-		"azurerm_nat_gateway":                              resourceNatGateway(),
-		"azurerm_nat_gateway_public_ip_association":        resourceNATGatewayPublicIpAssociation(),
-		"azurerm_nat_gateway_public_ip_prefix_association": resourceNATGatewayPublicIpPrefixAssociation(),
-		"azurerm_network_connection_monitor":               resourceNetworkConnectionMonitor(),
-		"azurerm_network_ddos_protection_plan":             resourceNetworkDDoSProtectionPlan(),
-		"azurerm_network_interface":                        resourceNetworkInterface(),
+		"azurerm_application_gateway":                       resourceApplicationGateway(),
+		"azurerm_application_gateway_backend_address_pool":  resourceApplicationGatewayBackendAddressPool(),
+		"azurerm_application_gateway_backend_http_settings": resourceApplicationGatewayBackendHTTPSettings(),
+		"azurerm_application_gateway_http_listener":         resourceApplicationGatewayHTTPListener(),
+		"azurerm_application_gateway_probe":                 resourceApplicationGatewayProbe(),
+		"azurerm_application_gateway_request_routing_rule":  resourceApplicationGatewayRequestRoutingRule(),
+		"azurerm_application_gateway_ssl_certificate":       resourceApplicationGatewaySslCertificate(),
+		"azurerm_application_security_group":                resourceApplicationSecurityGroup(),
+		"azurerm_bastion_host":                              resourceBastionHost(),
+		"azurerm_express_route_circuit_connection":          resourceExpressRouteCircuitConnection(),
+		"azurerm_express_route_circuit_authorization":       resourceExpressRouteCircuitAuthorization(),
+		"azurerm_express_route_circuit_peering":             resourceExpressRouteCircuitPeering(),
+		"azurerm_express_route_circuit":                     resourceExpressRouteCircuit(),
+		"azurerm_express_route_connection":                  resourceExpressRouteConnection(),
+		"azurerm_express_route_gateway":                     resourceExpressRouteGateway(),
+		"azurerm_express_route_port":                        resourceArmExpressRoutePort(),
+		"azurerm_ip_group":                                  resourceIpGroup(),
+		"azurerm_local_network_gateway":                     resourceLocalNetworkGateway(),
+		"azurerm_nat_gateway":                               resourceNatGateway(),
+		"azurerm_nat_gateway_public_ip_association":         resourceNATGatewayPublicIpAssociation(),
+		"azurerm_nat_gateway_public_ip_prefix_association":  resourceNATGatewayPublicIpPrefixAssociation(),
+		"azurerm_network_connection_monitor":                resourceNetworkConnectionMonitor(),
+		"azurerm_network_ddos_protection_plan":              resourceNetworkDDoSProtectionPlan(),
+		"azurerm_network_interface":                         resourceNetworkInterface(),
 
 		"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
 		"azurerm_network_interface_application_security_group_association":               resourceNetworkInterfaceApplicationSecurityGroupAssociation(),
@@ -79,6 +87,15 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_network_interface_nat_rule_association":                                 resourceNetworkInterfaceNatRuleAssociation(),
 		"azurerm_network_interface_security_group_association":                           resourceNetworkInterfaceSecurityGroupAssociation(),
 
+		"azurerm_network_manager":                              resourceNetworkManager(),
+		"azurerm_network_manager_admin_rule":                   resourceNetworkManagerAdminRule(),
+		"azurerm_network_manager_admin_rule_collection":        resourceNetworkManagerAdminRuleCollection(),
+		"azurerm_network_manager_connectivity_configuration":   resourceNetworkManagerConnectivityConfiguration(),
+		"azurerm_network_manager_deployment":                   resourceNetworkManagerDeployment(),
+		"azurerm_network_manager_network_group":                resourceNetworkManagerNetworkGroup(),
+		"azurerm_network_manager_security_admin_configuration": resourceNetworkManagerSecurityAdminConfiguration(),
+		"azurerm_network_manager_static_member":                resourceNetworkManagerStaticMember(),
+
 		"azurerm_network_packet_capture":                    resourceNetworkPacketCapture(),
 		"azurerm_network_profile":                           resourceNetworkProfile(),
 		"azurerm_packet_capture":                            resourcePacketCapture(),
@@ -106,6 +123,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_hub_ip":                            resourceVirtualHubIP(),
 		"azurerm_virtual_hub_route_table":                   resourceVirtualHubRouteTable(),
 		"azurerm_virtual_hub_route_table_route":             resourceVirtualHubRouteTableRoute(),
+		"azurerm_virtual_hub_routing_intent":                resourceVirtualHubRoutingIntent(),
 		"azurerm_virtual_network_dns_servers":               resourceVirtualNetworkDnsServers(),
 		"azurerm_virtual_network_gateway_connection":        resourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network_gateway":                   resourceVirtualNetworkGateway(),
@@ -105,8 +105,11 @@ func resourceRouteCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
-	locks.ByName(id.RouteTableName, routeTableResourceName)
-	defer locks.UnlockByName(id.RouteTableName, routeTableResourceName)
+	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+		routeTableId := parse.NewRouteTableID(id.SubscriptionId, id.ResourceGroup, id.RouteTableName)
+		locks.ByID(routeTableId.ID())
+		defer locks.UnlockByID(routeTableId.ID())
+	}
 
 	route := network.Route{
 		Name: utils.String(id.Name),
@@ -175,8 +178,11 @@ func resourceRouteDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.RouteTableName, routeTableResourceName)
-	defer locks.UnlockByName(id.RouteTableName, routeTableResourceName)
+	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+		routeTableId := parse.NewRouteTableID(id.SubscriptionId, id.ResourceGroup, id.RouteTableName)
+		locks.ByID(routeTableId.ID())
+		defer locks.UnlockByID(routeTableId.ID())
+	}
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.RouteTableName, id.Name)
 	if err != nil {
@@ -20,8 +20,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-var routeTableResourceName = "azurerm_route_table"
-
 func resourceRouteTable() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceRouteTableCreateUpdate,
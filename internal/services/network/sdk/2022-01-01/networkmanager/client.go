@@ -0,0 +1,181 @@
+package networkmanager
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// ResourceId is the ID of a resource within the Network Manager API, such as one of the IDs within the `parse` package
+type ResourceId interface {
+	ID() string
+}
+
+type baseClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func newBaseClient(endpoint string) baseClient {
+	return baseClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
+
+// createOrUpdate performs a PUT of `input` to the specified ID - the Network Manager API completes these synchronously
+func (c baseClient) createOrUpdate(ctx context.Context, clientName string, id ResourceId, input interface{}) (*http.Response, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, clientName, "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByClosing())
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return resp, nil
+}
+
+// get retrieves the specified ID, unmarshalling the response into `model`
+func (c baseClient) get(ctx context.Context, clientName string, id ResourceId, model interface{}) (*http.Response, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, clientName, "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(model),
+		autorest.ByClosing())
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "Get", resp, "Failure responding to request")
+	}
+
+	return resp, nil
+}
+
+// deleteThenPoll deletes the specified ID, polling until the operation has completed when it's long running
+func (c baseClient) deleteThenPoll(ctx context.Context, clientName string, id ResourceId) error {
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, clientName, "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, clientName, "Delete", resp, "Failure sending request")
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return autorest.Respond(resp, autorest.ByClosing())
+
+	case http.StatusAccepted:
+		poller, err := polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+		if err != nil {
+			return autorest.NewErrorWithError(err, clientName, "Delete", resp, "Failure sending request")
+		}
+
+		if err := poller.PollUntilDone(); err != nil {
+			return fmt.Errorf("polling after Delete: %+v", err)
+		}
+
+		return nil
+	}
+
+	return autorest.NewErrorWithResponse(clientName, "Delete", resp, "Unexpected status code %d", resp.StatusCode)
+}
+
+// post performs a POST of `input` to the specified path, polling until the operation has completed when it's long
+// running and otherwise unmarshalling the response into `model` (when specified)
+func (c baseClient) post(ctx context.Context, clientName, operation, path string, input interface{}, model interface{}) (*http.Response, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(path),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, clientName, operation, nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, operation, resp, "Failure sending request")
+	}
+
+	if resp.StatusCode == http.StatusAccepted && (resp.Header.Get("Azure-AsyncOperation") != "" || resp.Header.Get("Location") != "") {
+		poller, err := polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+		if err != nil {
+			return resp, autorest.NewErrorWithError(err, clientName, operation, resp, "Failure sending request")
+		}
+
+		if err := poller.PollUntilDone(); err != nil {
+			return resp, fmt.Errorf("polling after %s: %+v", operation, err)
+		}
+
+		return resp, nil
+	}
+
+	respondDecorators := []autorest.RespondDecorator{
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+	}
+	if model != nil {
+		respondDecorators = append(respondDecorators, autorest.ByUnmarshallingJSON(model))
+	}
+	respondDecorators = append(respondDecorators, autorest.ByClosing())
+
+	if err := autorest.Respond(resp, respondDecorators...); err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, operation, resp, "Failure responding to request")
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,37 @@
+package networkmanager
+
+import (
+	"context"
+	"net/http"
+)
+
+type AdminRuleCollectionsClient struct {
+	baseClient
+}
+
+func NewAdminRuleCollectionsClientWithBaseURI(endpoint string) AdminRuleCollectionsClient {
+	return AdminRuleCollectionsClient{
+		baseClient: newBaseClient(endpoint),
+	}
+}
+
+type GetAdminRuleCollectionResponse struct {
+	HttpResponse *http.Response
+	Model        *AdminRuleCollection
+}
+
+// CreateOrUpdate creates or updates the AdminRuleCollection
+func (c AdminRuleCollectionsClient) CreateOrUpdate(ctx context.Context, id ResourceId, input AdminRuleCollection) (*http.Response, error) {
+	return c.createOrUpdate(ctx, "networkmanager.AdminRuleCollectionsClient", id, input)
+}
+
+// Get retrieves the AdminRuleCollection
+func (c AdminRuleCollectionsClient) Get(ctx context.Context, id ResourceId) (result GetAdminRuleCollectionResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, "networkmanager.AdminRuleCollectionsClient", id, &result.Model)
+	return
+}
+
+// DeleteThenPoll deletes the AdminRuleCollection then polls until it's completed
+func (c AdminRuleCollectionsClient) DeleteThenPoll(ctx context.Context, id ResourceId) error {
+	return c.deleteThenPoll(ctx, "networkmanager.AdminRuleCollectionsClient", id)
+}
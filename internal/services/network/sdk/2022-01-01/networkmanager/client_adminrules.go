@@ -0,0 +1,37 @@
+package networkmanager
+
+import (
+	"context"
+	"net/http"
+)
+
+type AdminRulesClient struct {
+	baseClient
+}
+
+func NewAdminRulesClientWithBaseURI(endpoint string) AdminRulesClient {
+	return AdminRulesClient{
+		baseClient: newBaseClient(endpoint),
+	}
+}
+
+type GetAdminRuleResponse struct {
+	HttpResponse *http.Response
+	Model        *AdminRule
+}
+
+// CreateOrUpdate creates or updates the AdminRule
+func (c AdminRulesClient) CreateOrUpdate(ctx context.Context, id ResourceId, input AdminRule) (*http.Response, error) {
+	return c.createOrUpdate(ctx, "networkmanager.AdminRulesClient", id, input)
+}
+
+// Get retrieves the AdminRule
+func (c AdminRulesClient) Get(ctx context.Context, id ResourceId) (result GetAdminRuleResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, "networkmanager.AdminRulesClient", id, &result.Model)
+	return
+}
+
+// DeleteThenPoll deletes the AdminRule then polls until it's completed
+func (c AdminRulesClient) DeleteThenPoll(ctx context.Context, id ResourceId) error {
+	return c.deleteThenPoll(ctx, "networkmanager.AdminRulesClient", id)
+}
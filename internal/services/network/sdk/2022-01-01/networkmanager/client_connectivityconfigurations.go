@@ -0,0 +1,37 @@
+package networkmanager
+
+import (
+	"context"
+	"net/http"
+)
+
+type ConnectivityConfigurationsClient struct {
+	baseClient
+}
+
+func NewConnectivityConfigurationsClientWithBaseURI(endpoint string) ConnectivityConfigurationsClient {
+	return ConnectivityConfigurationsClient{
+		baseClient: newBaseClient(endpoint),
+	}
+}
+
+type GetConnectivityConfigurationResponse struct {
+	HttpResponse *http.Response
+	Model        *ConnectivityConfiguration
+}
+
+// CreateOrUpdate creates or updates the ConnectivityConfiguration
+func (c ConnectivityConfigurationsClient) CreateOrUpdate(ctx context.Context, id ResourceId, input ConnectivityConfiguration) (*http.Response, error) {
+	return c.createOrUpdate(ctx, "networkmanager.ConnectivityConfigurationsClient", id, input)
+}
+
+// Get retrieves the ConnectivityConfiguration
+func (c ConnectivityConfigurationsClient) Get(ctx context.Context, id ResourceId) (result GetConnectivityConfigurationResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, "networkmanager.ConnectivityConfigurationsClient", id, &result.Model)
+	return
+}
+
+// DeleteThenPoll deletes the ConnectivityConfiguration then polls until it's completed
+func (c ConnectivityConfigurationsClient) DeleteThenPoll(ctx context.Context, id ResourceId) error {
+	return c.deleteThenPoll(ctx, "networkmanager.ConnectivityConfigurationsClient", id)
+}
@@ -0,0 +1,37 @@
+package networkmanager
+
+import (
+	"context"
+	"net/http"
+)
+
+type NetworkGroupsClient struct {
+	baseClient
+}
+
+func NewNetworkGroupsClientWithBaseURI(endpoint string) NetworkGroupsClient {
+	return NetworkGroupsClient{
+		baseClient: newBaseClient(endpoint),
+	}
+}
+
+type GetNetworkGroupResponse struct {
+	HttpResponse *http.Response
+	Model        *NetworkGroup
+}
+
+// CreateOrUpdate creates or updates the NetworkGroup
+func (c NetworkGroupsClient) CreateOrUpdate(ctx context.Context, id ResourceId, input NetworkGroup) (*http.Response, error) {
+	return c.createOrUpdate(ctx, "networkmanager.NetworkGroupsClient", id, input)
+}
+
+// Get retrieves the NetworkGroup
+func (c NetworkGroupsClient) Get(ctx context.Context, id ResourceId) (result GetNetworkGroupResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, "networkmanager.NetworkGroupsClient", id, &result.Model)
+	return
+}
+
+// DeleteThenPoll deletes the NetworkGroup then polls until it's completed
+func (c NetworkGroupsClient) DeleteThenPoll(ctx context.Context, id ResourceId) error {
+	return c.deleteThenPoll(ctx, "networkmanager.NetworkGroupsClient", id)
+}
@@ -0,0 +1,63 @@
+package networkmanager
+
+import (
+	"context"
+	"net/http"
+)
+
+type NetworkManagersClient struct {
+	baseClient
+}
+
+func NewNetworkManagersClientWithBaseURI(endpoint string) NetworkManagersClient {
+	return NetworkManagersClient{
+		baseClient: newBaseClient(endpoint),
+	}
+}
+
+type GetNetworkManagerResponse struct {
+	HttpResponse *http.Response
+	Model        *NetworkManager
+}
+
+// CreateOrUpdate creates or updates the NetworkManager
+func (c NetworkManagersClient) CreateOrUpdate(ctx context.Context, id ResourceId, input NetworkManager) (*http.Response, error) {
+	return c.createOrUpdate(ctx, "networkmanager.NetworkManagersClient", id, input)
+}
+
+// Get retrieves the NetworkManager
+func (c NetworkManagersClient) Get(ctx context.Context, id ResourceId) (result GetNetworkManagerResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, "networkmanager.NetworkManagersClient", id, &result.Model)
+	return
+}
+
+// DeleteThenPoll deletes the NetworkManager then polls until it's completed
+func (c NetworkManagersClient) DeleteThenPoll(ctx context.Context, id ResourceId) error {
+	return c.deleteThenPoll(ctx, "networkmanager.NetworkManagersClient", id)
+}
+
+// CommitThenPoll commits the configurations specified in `input` to the target locations, polling until the
+// commit has been accepted - deployment of the configurations then happens asynchronously, see ListDeploymentStatus
+func (c NetworkManagersClient) CommitThenPoll(ctx context.Context, id ResourceId, input NetworkManagerCommit) (result NetworkManagerCommit, err error) {
+	_, err = c.post(ctx, "networkmanager.NetworkManagersClient", "Commit", id.ID()+"/commit", input, &result)
+	return
+}
+
+// ListDeploymentStatus retrieves the deployment status of the configurations committed by the Network Manager
+func (c NetworkManagersClient) ListDeploymentStatus(ctx context.Context, id ResourceId, input NetworkManagerDeploymentStatusParameter) (result []NetworkManagerDeploymentStatus, err error) {
+	for {
+		var page NetworkManagerDeploymentStatusListResult
+		if _, err = c.post(ctx, "networkmanager.NetworkManagersClient", "ListDeploymentStatus", id.ID()+"/listDeploymentStatus", input, &page); err != nil {
+			return nil, err
+		}
+
+		if page.Value != nil {
+			result = append(result, *page.Value...)
+		}
+
+		if page.SkipToken == nil || *page.SkipToken == "" {
+			return result, nil
+		}
+		input.SkipToken = page.SkipToken
+	}
+}
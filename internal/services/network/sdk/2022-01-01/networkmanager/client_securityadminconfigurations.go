@@ -0,0 +1,37 @@
+package networkmanager
+
+import (
+	"context"
+	"net/http"
+)
+
+type SecurityAdminConfigurationsClient struct {
+	baseClient
+}
+
+func NewSecurityAdminConfigurationsClientWithBaseURI(endpoint string) SecurityAdminConfigurationsClient {
+	return SecurityAdminConfigurationsClient{
+		baseClient: newBaseClient(endpoint),
+	}
+}
+
+type GetSecurityAdminConfigurationResponse struct {
+	HttpResponse *http.Response
+	Model        *SecurityAdminConfiguration
+}
+
+// CreateOrUpdate creates or updates the SecurityAdminConfiguration
+func (c SecurityAdminConfigurationsClient) CreateOrUpdate(ctx context.Context, id ResourceId, input SecurityAdminConfiguration) (*http.Response, error) {
+	return c.createOrUpdate(ctx, "networkmanager.SecurityAdminConfigurationsClient", id, input)
+}
+
+// Get retrieves the SecurityAdminConfiguration
+func (c SecurityAdminConfigurationsClient) Get(ctx context.Context, id ResourceId) (result GetSecurityAdminConfigurationResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, "networkmanager.SecurityAdminConfigurationsClient", id, &result.Model)
+	return
+}
+
+// DeleteThenPoll deletes the SecurityAdminConfiguration then polls until it's completed
+func (c SecurityAdminConfigurationsClient) DeleteThenPoll(ctx context.Context, id ResourceId) error {
+	return c.deleteThenPoll(ctx, "networkmanager.SecurityAdminConfigurationsClient", id)
+}
@@ -0,0 +1,37 @@
+package networkmanager
+
+import (
+	"context"
+	"net/http"
+)
+
+type StaticMembersClient struct {
+	baseClient
+}
+
+func NewStaticMembersClientWithBaseURI(endpoint string) StaticMembersClient {
+	return StaticMembersClient{
+		baseClient: newBaseClient(endpoint),
+	}
+}
+
+type GetStaticMemberResponse struct {
+	HttpResponse *http.Response
+	Model        *StaticMember
+}
+
+// CreateOrUpdate creates or updates the StaticMember
+func (c StaticMembersClient) CreateOrUpdate(ctx context.Context, id ResourceId, input StaticMember) (*http.Response, error) {
+	return c.createOrUpdate(ctx, "networkmanager.StaticMembersClient", id, input)
+}
+
+// Get retrieves the StaticMember
+func (c StaticMembersClient) Get(ctx context.Context, id ResourceId) (result GetStaticMemberResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, "networkmanager.StaticMembersClient", id, &result.Model)
+	return
+}
+
+// DeleteThenPoll deletes the StaticMember then polls until it's completed
+func (c StaticMembersClient) DeleteThenPoll(ctx context.Context, id ResourceId) error {
+	return c.deleteThenPoll(ctx, "networkmanager.StaticMembersClient", id)
+}
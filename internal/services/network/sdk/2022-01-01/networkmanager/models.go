@@ -0,0 +1,152 @@
+package networkmanager
+
+type NetworkManager struct {
+	Id         *string                  `json:"id,omitempty"`
+	Location   string                   `json:"location"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties NetworkManagerProperties `json:"properties"`
+	Tags       *map[string]string       `json:"tags,omitempty"`
+}
+
+type NetworkManagerProperties struct {
+	Description                 *string                        `json:"description,omitempty"`
+	NetworkManagerScopeAccesses []ConfigurationType            `json:"networkManagerScopeAccesses"`
+	NetworkManagerScopes        NetworkManagerPropertiesScopes `json:"networkManagerScopes"`
+	ProvisioningState           *ProvisioningState             `json:"provisioningState,omitempty"`
+}
+
+type NetworkManagerPropertiesScopes struct {
+	ManagementGroups *[]string `json:"managementGroups,omitempty"`
+	Subscriptions    *[]string `json:"subscriptions,omitempty"`
+}
+
+type NetworkGroup struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties NetworkGroupProperties `json:"properties"`
+}
+
+type NetworkGroupProperties struct {
+	Description       *string            `json:"description,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
+
+type StaticMember struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties StaticMemberProperties `json:"properties"`
+}
+
+type StaticMemberProperties struct {
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	Region            *string            `json:"region,omitempty"`
+	ResourceId        string             `json:"resourceId"`
+}
+
+type ConnectivityConfiguration struct {
+	Id         *string                             `json:"id,omitempty"`
+	Name       *string                             `json:"name,omitempty"`
+	Properties ConnectivityConfigurationProperties `json:"properties"`
+}
+
+type ConnectivityConfigurationProperties struct {
+	AppliesToGroups       []ConnectivityGroupItem `json:"appliesToGroups"`
+	ConnectivityTopology  ConnectivityTopology    `json:"connectivityTopology"`
+	DeleteExistingPeering *DeleteExistingPeering  `json:"deleteExistingPeering,omitempty"`
+	Description           *string                 `json:"description,omitempty"`
+	Hubs                  *[]Hub                  `json:"hubs,omitempty"`
+	IsGlobal              *IsGlobal               `json:"isGlobal,omitempty"`
+	ProvisioningState     *ProvisioningState      `json:"provisioningState,omitempty"`
+}
+
+type ConnectivityGroupItem struct {
+	GroupConnectivity GroupConnectivity `json:"groupConnectivity"`
+	IsGlobal          *IsGlobal         `json:"isGlobal,omitempty"`
+	NetworkGroupId    string            `json:"networkGroupId"`
+	UseHubGateway     *UseHubGateway    `json:"useHubGateway,omitempty"`
+}
+
+type Hub struct {
+	ResourceId   *string `json:"resourceId,omitempty"`
+	ResourceType *string `json:"resourceType,omitempty"`
+}
+
+type SecurityAdminConfiguration struct {
+	Id         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties SecurityAdminConfigurationProperties `json:"properties"`
+}
+
+type SecurityAdminConfigurationProperties struct {
+	ApplyOnNetworkIntentPolicyBasedServices *[]NetworkIntentPolicyBasedService `json:"applyOnNetworkIntentPolicyBasedServices,omitempty"`
+	Description                             *string                            `json:"description,omitempty"`
+	ProvisioningState                       *ProvisioningState                 `json:"provisioningState,omitempty"`
+}
+
+type AdminRuleCollection struct {
+	Id         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties AdminRuleCollectionProperties `json:"properties"`
+}
+
+type AdminRuleCollectionProperties struct {
+	AppliesToGroups   []NetworkManagerSecurityGroupItem `json:"appliesToGroups"`
+	Description       *string                           `json:"description,omitempty"`
+	ProvisioningState *ProvisioningState                `json:"provisioningState,omitempty"`
+}
+
+type NetworkManagerSecurityGroupItem struct {
+	NetworkGroupId string `json:"networkGroupId"`
+}
+
+type AdminRule struct {
+	Id         *string             `json:"id,omitempty"`
+	Kind       AdminRuleKind       `json:"kind"`
+	Name       *string             `json:"name,omitempty"`
+	Properties AdminRuleProperties `json:"properties"`
+}
+
+type AdminRuleProperties struct {
+	Access                SecurityConfigurationRuleAccess    `json:"access"`
+	Description           *string                            `json:"description,omitempty"`
+	DestinationPortRanges *[]string                          `json:"destinationPortRanges,omitempty"`
+	Destinations          *[]AddressPrefixItem               `json:"destinations,omitempty"`
+	Direction             SecurityConfigurationRuleDirection `json:"direction"`
+	Priority              int64                              `json:"priority"`
+	Protocol              SecurityConfigurationRuleProtocol  `json:"protocol"`
+	ProvisioningState     *ProvisioningState                 `json:"provisioningState,omitempty"`
+	SourcePortRanges      *[]string                          `json:"sourcePortRanges,omitempty"`
+	Sources               *[]AddressPrefixItem               `json:"sources,omitempty"`
+}
+
+type AddressPrefixItem struct {
+	AddressPrefix     *string            `json:"addressPrefix,omitempty"`
+	AddressPrefixType *AddressPrefixType `json:"addressPrefixType,omitempty"`
+}
+
+type NetworkManagerCommit struct {
+	CommitId         *string           `json:"commitId,omitempty"`
+	CommitType       ConfigurationType `json:"commitType"`
+	ConfigurationIds *[]string         `json:"configurationIds,omitempty"`
+	TargetLocations  []string          `json:"targetLocations"`
+}
+
+type NetworkManagerDeploymentStatusParameter struct {
+	DeploymentTypes *[]ConfigurationType `json:"deploymentTypes,omitempty"`
+	Regions         *[]string            `json:"regions,omitempty"`
+	SkipToken       *string              `json:"skipToken,omitempty"`
+}
+
+type NetworkManagerDeploymentStatusListResult struct {
+	SkipToken *string                           `json:"skipToken,omitempty"`
+	Value     *[]NetworkManagerDeploymentStatus `json:"value,omitempty"`
+}
+
+type NetworkManagerDeploymentStatus struct {
+	CommitTime       *string            `json:"commitTime,omitempty"`
+	ConfigurationIds *[]string          `json:"configurationIds,omitempty"`
+	DeploymentStatus *DeploymentStatus  `json:"deploymentStatus,omitempty"`
+	DeploymentType   *ConfigurationType `json:"deploymentType,omitempty"`
+	ErrorMessage     *string            `json:"errorMessage,omitempty"`
+	Region           *string            `json:"region,omitempty"`
+}
@@ -0,0 +1,9 @@
+package networkmanager
+
+import "fmt"
+
+const defaultApiVersion = "2022-01-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/networkmanager/%s", defaultApiVersion)
+}
@@ -0,0 +1,233 @@
+package securityrules
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// azureLoadBalancerAddress is the address which the Azure Load Balancer health probes originate from, which is used
+// for the `AzureLoadBalancer` Service Tag when it's not specified explicitly
+const azureLoadBalancerAddress = "168.63.129.16"
+
+// Evaluator determines which Security Rule applies to a Flow, without calling the Azure API
+type Evaluator struct {
+	// ServiceTags maps the name of a Service Tag (for example `Storage` or `VirtualNetwork`) to the address prefixes
+	// within it. When the `Internet` Service Tag isn't specified it matches any address outside of `VirtualNetwork`,
+	// and `AzureLoadBalancer` defaults to the address used by the Azure Load Balancer health probes.
+	ServiceTags map[string][]string
+
+	// IncludeDefaultRules specifies whether the default rules Azure adds to every Network Security Group should be
+	// evaluated after the specified rules.
+	IncludeDefaultRules bool
+}
+
+// Evaluate returns the Security Rule which applies to the Flow - being the matching rule with the lowest priority
+// value - or nil when no rule matches. An error is returned if the Flow is invalid, or when a rule which has to be
+// evaluated references a Service Tag which isn't known, or if the context is cancelled during evaluation.
+func (e Evaluator) Evaluate(ctx context.Context, flow Flow, rules []Rule) (*Rule, error) {
+	if !strings.EqualFold(flow.Direction, DirectionInbound) && !strings.EqualFold(flow.Direction, DirectionOutbound) {
+		return nil, fmt.Errorf("direction must be either %q or %q but got %q", DirectionInbound, DirectionOutbound, flow.Direction)
+	}
+
+	sourceAddress := net.ParseIP(flow.SourceAddress)
+	if sourceAddress == nil {
+		return nil, fmt.Errorf("source address %q is not a valid IP Address", flow.SourceAddress)
+	}
+
+	destinationAddress := net.ParseIP(flow.DestinationAddress)
+	if destinationAddress == nil {
+		return nil, fmt.Errorf("destination address %q is not a valid IP Address", flow.DestinationAddress)
+	}
+
+	if usesPorts(flow.Protocol) {
+		if flow.SourcePort < 0 || flow.SourcePort > 65535 {
+			return nil, fmt.Errorf("source port %d must be between 0 and 65535", flow.SourcePort)
+		}
+		if flow.DestinationPort < 0 || flow.DestinationPort > 65535 {
+			return nil, fmt.Errorf("destination port %d must be between 0 and 65535", flow.DestinationPort)
+		}
+	}
+
+	candidates := make([]Rule, 0)
+	for _, rule := range rules {
+		if strings.EqualFold(rule.Direction, flow.Direction) {
+			candidates = append(candidates, rule)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Priority < candidates[j].Priority
+	})
+
+	// the default rules have a lower precedence than any rule which can be defined, so are evaluated last
+	if e.IncludeDefaultRules {
+		for _, rule := range DefaultRules() {
+			if strings.EqualFold(rule.Direction, flow.Direction) {
+				candidates = append(candidates, rule)
+			}
+		}
+	}
+
+	for _, rule := range candidates {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("evaluating rules: %+v", err)
+		}
+
+		matched, err := e.matches(rule, flow, sourceAddress, destinationAddress)
+		if err != nil {
+			return nil, fmt.Errorf("evaluating rule %q: %+v", rule.Name, err)
+		}
+
+		if matched {
+			result := rule
+			return &result, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (e Evaluator) matches(rule Rule, flow Flow, sourceAddress, destinationAddress net.IP) (bool, error) {
+	if !protocolMatches(rule.Protocol, flow.Protocol) {
+		return false, nil
+	}
+
+	if usesPorts(flow.Protocol) {
+		matched, err := portMatches(rule.SourcePortRanges, flow.SourcePort)
+		if err != nil || !matched {
+			return false, err
+		}
+
+		matched, err = portMatches(rule.DestinationPortRanges, flow.DestinationPort)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	matched, err := e.addressMatches(rule.SourceAddressPrefixes, rule.SourceApplicationSecurityGroupIds, sourceAddress, flow.SourceApplicationSecurityGroupIds)
+	if err != nil || !matched {
+		return false, err
+	}
+
+	return e.addressMatches(rule.DestinationAddressPrefixes, rule.DestinationApplicationSecurityGroupIds, destinationAddress, flow.DestinationApplicationSecurityGroupIds)
+}
+
+func (e Evaluator) addressMatches(prefixes []string, ruleApplicationSecurityGroupIds []string, address net.IP, flowApplicationSecurityGroupIds []string) (bool, error) {
+	for _, ruleGroupId := range ruleApplicationSecurityGroupIds {
+		for _, flowGroupId := range flowApplicationSecurityGroupIds {
+			if strings.EqualFold(ruleGroupId, flowGroupId) {
+				return true, nil
+			}
+		}
+	}
+
+	for _, prefix := range prefixes {
+		matched, err := e.prefixMatches(prefix, address)
+		if err != nil || matched {
+			return matched, err
+		}
+	}
+
+	return false, nil
+}
+
+func (e Evaluator) prefixMatches(prefix string, address net.IP) (bool, error) {
+	if prefix == "*" || strings.EqualFold(prefix, "Any") {
+		return true, nil
+	}
+
+	if strings.Contains(prefix, "/") {
+		_, ipNet, err := net.ParseCIDR(prefix)
+		if err != nil {
+			return false, fmt.Errorf("parsing address prefix %q: %+v", prefix, err)
+		}
+		return ipNet.Contains(address), nil
+	}
+
+	if ip := net.ParseIP(prefix); ip != nil {
+		return ip.Equal(address), nil
+	}
+
+	return e.serviceTagMatches(prefix, address)
+}
+
+func (e Evaluator) serviceTagMatches(name string, address net.IP) (bool, error) {
+	for tag, prefixes := range e.ServiceTags {
+		if !strings.EqualFold(tag, name) {
+			continue
+		}
+
+		for _, prefix := range prefixes {
+			// Service Tags only contain address prefixes, so guard against tags referencing one another
+			if !strings.Contains(prefix, "/") && net.ParseIP(prefix) == nil {
+				return false, fmt.Errorf("the Service Tag %q contains %q which is not a valid address prefix", name, prefix)
+			}
+
+			matched, err := e.prefixMatches(prefix, address)
+			if err != nil || matched {
+				return matched, err
+			}
+		}
+
+		return false, nil
+	}
+
+	if strings.EqualFold(name, "AzureLoadBalancer") {
+		return net.ParseIP(azureLoadBalancerAddress).Equal(address), nil
+	}
+
+	if strings.EqualFold(name, "Internet") {
+		inVirtualNetwork, err := e.serviceTagMatches("VirtualNetwork", address)
+		if err != nil {
+			return false, fmt.Errorf("the `Internet` Service Tag is determined from the `VirtualNetwork` Service Tag: %+v", err)
+		}
+		return !inVirtualNetwork, nil
+	}
+
+	return false, fmt.Errorf("the Service Tag %q was not specified so can't be evaluated", name)
+}
+
+func protocolMatches(ruleProtocol, flowProtocol string) bool {
+	if ruleProtocol == "*" || strings.EqualFold(ruleProtocol, "All") || strings.EqualFold(ruleProtocol, "Any") {
+		return true
+	}
+
+	return strings.EqualFold(ruleProtocol, flowProtocol)
+}
+
+// usesPorts returns whether port ranges apply to the protocol, since they're ignored for protocols such as ICMP
+func usesPorts(protocol string) bool {
+	return strings.EqualFold(protocol, "Tcp") || strings.EqualFold(protocol, "Udp")
+}
+
+func portMatches(ranges []string, port int) (bool, error) {
+	for _, portRange := range ranges {
+		if portRange == "*" {
+			return true, nil
+		}
+
+		start, end := portRange, portRange
+		if i := strings.Index(portRange, "-"); i != -1 {
+			start, end = portRange[:i], portRange[i+1:]
+		}
+
+		from, err := strconv.Atoi(strings.TrimSpace(start))
+		if err != nil {
+			return false, fmt.Errorf("parsing port range %q: %+v", portRange, err)
+		}
+
+		to, err := strconv.Atoi(strings.TrimSpace(end))
+		if err != nil {
+			return false, fmt.Errorf("parsing port range %q: %+v", portRange, err)
+		}
+
+		if port >= from && port <= to {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,254 @@
+package securityrules
+
+import (
+	"context"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	asgId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationSecurityGroups/web"
+
+	rules := []Rule{
+		{
+			Name:                       "DenySSH",
+			Priority:                   200,
+			Direction:                  DirectionInbound,
+			Access:                     AccessDeny,
+			Protocol:                   "Tcp",
+			SourceAddressPrefixes:      []string{"*"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"*"},
+			DestinationPortRanges:      []string{"22"},
+		},
+		{
+			Name:                       "AllowSSHFromOffice",
+			Priority:                   100,
+			Direction:                  DirectionInbound,
+			Access:                     AccessAllow,
+			Protocol:                   "Tcp",
+			SourceAddressPrefixes:      []string{"203.0.113.0/24"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"10.0.1.0/24"},
+			DestinationPortRanges:      []string{"22"},
+		},
+		{
+			Name:                                   "AllowWebToASG",
+			Priority:                               300,
+			Direction:                              DirectionInbound,
+			Access:                                 AccessAllow,
+			Protocol:                               "*",
+			SourceAddressPrefixes:                  []string{"Internet"},
+			SourcePortRanges:                       []string{"*"},
+			DestinationApplicationSecurityGroupIds: []string{asgId},
+			DestinationPortRanges:                  []string{"80", "443", "8000-8999"},
+		},
+		{
+			Name:                       "DenyStorage",
+			Priority:                   100,
+			Direction:                  DirectionOutbound,
+			Access:                     AccessDeny,
+			Protocol:                   "*",
+			SourceAddressPrefixes:      []string{"*"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"Storage"},
+			DestinationPortRanges:      []string{"*"},
+		},
+		{
+			Name:                       "AllowIcmp",
+			Priority:                   400,
+			Direction:                  DirectionInbound,
+			Access:                     AccessAllow,
+			Protocol:                   "Icmp",
+			SourceAddressPrefixes:      []string{"10.0.0.4"},
+			SourcePortRanges:           []string{"1"},
+			DestinationAddressPrefixes: []string{"*"},
+			DestinationPortRanges:      []string{"1"},
+		},
+	}
+
+	evaluator := Evaluator{
+		ServiceTags: map[string][]string{
+			"VirtualNetwork": {"10.0.0.0/16"},
+			"storage":        {"20.38.96.0/19", "2603:1000::/40"},
+		},
+		IncludeDefaultRules: true,
+	}
+
+	testData := []struct {
+		Name     string
+		Flow     Flow
+		Expected string
+	}{
+		{
+			Name:     "lowest priority value wins",
+			Flow:     Flow{Direction: "Inbound", Protocol: "Tcp", SourceAddress: "203.0.113.10", SourcePort: 50000, DestinationAddress: "10.0.1.4", DestinationPort: 22},
+			Expected: "AllowSSHFromOffice",
+		},
+		{
+			Name:     "falls through to higher priority value",
+			Flow:     Flow{Direction: "Inbound", Protocol: "Tcp", SourceAddress: "198.51.100.10", SourcePort: 50000, DestinationAddress: "10.0.1.4", DestinationPort: 22},
+			Expected: "DenySSH",
+		},
+		{
+			Name:     "application security group and port range",
+			Flow:     Flow{Direction: "Inbound", Protocol: "Udp", SourceAddress: "198.51.100.10", SourcePort: 50000, DestinationAddress: "10.0.2.4", DestinationPort: 8080, DestinationApplicationSecurityGroupIds: []string{asgId}},
+			Expected: "AllowWebToASG",
+		},
+		{
+			Name:     "application security group not a member",
+			Flow:     Flow{Direction: "Inbound", Protocol: "Udp", SourceAddress: "198.51.100.10", SourcePort: 50000, DestinationAddress: "10.0.2.4", DestinationPort: 8080},
+			Expected: "DenyAllInBound",
+		},
+		{
+			Name:     "internet excludes the virtual network",
+			Flow:     Flow{Direction: "Inbound", Protocol: "Tcp", SourceAddress: "10.0.3.4", SourcePort: 50000, DestinationAddress: "10.0.2.4", DestinationPort: 443, DestinationApplicationSecurityGroupIds: []string{asgId}},
+			Expected: "AllowVnetInBound",
+		},
+		{
+			Name:     "service tag ipv6 and case insensitive",
+			Flow:     Flow{Direction: "outbound", Protocol: "Tcp", SourceAddress: "2001:db8::1", SourcePort: 50000, DestinationAddress: "2603:1000::10", DestinationPort: 443},
+			Expected: "DenyStorage",
+		},
+		{
+			Name:     "ports are ignored for icmp",
+			Flow:     Flow{Direction: "Inbound", Protocol: "Icmp", SourceAddress: "10.0.0.4", DestinationAddress: "10.0.1.4"},
+			Expected: "AllowIcmp",
+		},
+		{
+			Name:     "default outbound internet",
+			Flow:     Flow{Direction: "Outbound", Protocol: "Tcp", SourceAddress: "10.0.1.4", SourcePort: 50000, DestinationAddress: "198.51.100.10", DestinationPort: 443},
+			Expected: "AllowInternetOutBound",
+		},
+		{
+			Name:     "default azure load balancer",
+			Flow:     Flow{Direction: "Inbound", Protocol: "Tcp", SourceAddress: "168.63.129.16", SourcePort: 50000, DestinationAddress: "10.0.1.4", DestinationPort: 80},
+			Expected: "AllowAzureLoadBalancerInBound",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := evaluator.Evaluate(context.Background(), v.Flow, rules)
+		if err != nil {
+			t.Fatalf("evaluating %q: %+v", v.Name, err)
+		}
+
+		if actual == nil {
+			t.Fatalf("expected %q to match %q but no rule matched", v.Name, v.Expected)
+		}
+
+		if actual.Name != v.Expected {
+			t.Fatalf("expected %q to match %q but got %q", v.Name, v.Expected, actual.Name)
+		}
+	}
+}
+
+func TestEvaluateWithoutDefaultRules(t *testing.T) {
+	rules := []Rule{
+		{
+			Name:                       "AllowHttps",
+			Priority:                   100,
+			Direction:                  DirectionInbound,
+			Access:                     AccessAllow,
+			Protocol:                   "Tcp",
+			SourceAddressPrefixes:      []string{"*"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"*"},
+			DestinationPortRanges:      []string{"443"},
+		},
+	}
+
+	actual, err := Evaluator{}.Evaluate(context.Background(), Flow{Direction: "Inbound", Protocol: "Tcp", SourceAddress: "10.0.0.4", SourcePort: 50000, DestinationAddress: "10.0.1.4", DestinationPort: 80}, rules)
+	if err != nil {
+		t.Fatalf("evaluating: %+v", err)
+	}
+
+	if actual != nil {
+		t.Fatalf("expected no rule to match but got %q", actual.Name)
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	rules := []Rule{
+		{
+			Name:                       "AllowSql",
+			Priority:                   100,
+			Direction:                  DirectionOutbound,
+			Access:                     AccessAllow,
+			Protocol:                   "Tcp",
+			SourceAddressPrefixes:      []string{"*"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"Sql"},
+			DestinationPortRanges:      []string{"1433"},
+		},
+		{
+			Name:                       "InvalidPorts",
+			Priority:                   200,
+			Direction:                  DirectionOutbound,
+			Access:                     AccessAllow,
+			Protocol:                   "Tcp",
+			SourceAddressPrefixes:      []string{"*"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"*"},
+			DestinationPortRanges:      []string{"http"},
+		},
+	}
+
+	testData := []struct {
+		Name string
+		Flow Flow
+	}{
+		{
+			Name: "invalid direction",
+			Flow: Flow{Direction: "Sideways", Protocol: "Tcp", SourceAddress: "10.0.0.4", DestinationAddress: "10.0.1.4", DestinationPort: 1433},
+		},
+		{
+			Name: "invalid source address",
+			Flow: Flow{Direction: "Outbound", Protocol: "Tcp", SourceAddress: "10.0.0", DestinationAddress: "10.0.1.4", DestinationPort: 1433},
+		},
+		{
+			Name: "invalid destination port",
+			Flow: Flow{Direction: "Outbound", Protocol: "Tcp", SourceAddress: "10.0.0.4", DestinationAddress: "10.0.1.4", DestinationPort: 70000},
+		},
+		{
+			Name: "unknown service tag",
+			Flow: Flow{Direction: "Outbound", Protocol: "Tcp", SourceAddress: "10.0.0.4", DestinationAddress: "10.0.1.4", DestinationPort: 1433},
+		},
+		{
+			Name: "invalid port range",
+			Flow: Flow{Direction: "Outbound", Protocol: "Tcp", SourceAddress: "10.0.0.4", DestinationAddress: "10.0.1.4", DestinationPort: 80},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if _, err := (Evaluator{}).Evaluate(context.Background(), v.Flow, rules); err == nil {
+			t.Fatalf("expected an error for %q but didn't get one", v.Name)
+		}
+	}
+}
+
+func TestEvaluateCancelledContext(t *testing.T) {
+	rules := []Rule{
+		{
+			Name:                       "AllowHTTP",
+			Priority:                   100,
+			Direction:                  "Inbound",
+			Access:                     "Allow",
+			Protocol:                   "Tcp",
+			SourceAddressPrefixes:      []string{"*"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"*"},
+			DestinationPortRanges:      []string{"80"},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := (Evaluator{}).Evaluate(ctx, Flow{Direction: "Inbound", Protocol: "Tcp", SourceAddress: "10.0.0.4", SourcePort: 0, DestinationAddress: "10.0.1.4", DestinationPort: 80}, rules); err == nil {
+		t.Fatalf("expected an error when the context is cancelled")
+	}
+}
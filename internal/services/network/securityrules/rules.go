@@ -0,0 +1,120 @@
+package securityrules
+
+const (
+	DirectionInbound  = "Inbound"
+	DirectionOutbound = "Outbound"
+
+	AccessAllow = "Allow"
+	AccessDeny  = "Deny"
+)
+
+// Flow is a single packet flow (a 5-tuple plus direction) to evaluate against a set of Security Rules
+type Flow struct {
+	Direction string
+	Protocol  string
+
+	SourceAddress string
+	SourcePort    int
+
+	DestinationAddress string
+	DestinationPort    int
+
+	// SourceApplicationSecurityGroupIds are the IDs of the Application Security Groups which the source of this
+	// Flow is a member of, used to match rules which reference Application Security Groups
+	SourceApplicationSecurityGroupIds []string
+
+	// DestinationApplicationSecurityGroupIds are the IDs of the Application Security Groups which the destination
+	// of this Flow is a member of
+	DestinationApplicationSecurityGroupIds []string
+}
+
+// Rule is a Network Security Group Security Rule - the singular and plural forms of the prefixes and port ranges
+// within the API are combined into the plural fields here
+type Rule struct {
+	Name      string
+	Priority  int
+	Direction string
+	Access    string
+	Protocol  string
+
+	SourceAddressPrefixes             []string
+	SourcePortRanges                  []string
+	SourceApplicationSecurityGroupIds []string
+
+	DestinationAddressPrefixes             []string
+	DestinationPortRanges                  []string
+	DestinationApplicationSecurityGroupIds []string
+}
+
+// DefaultRules returns the Security Rules which Azure adds to every Network Security Group, which are evaluated
+// after any user-defined rules
+func DefaultRules() []Rule {
+	return []Rule{
+		{
+			Name:                       "AllowVnetInBound",
+			Priority:                   65000,
+			Direction:                  DirectionInbound,
+			Access:                     AccessAllow,
+			Protocol:                   "*",
+			SourceAddressPrefixes:      []string{"VirtualNetwork"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"VirtualNetwork"},
+			DestinationPortRanges:      []string{"*"},
+		},
+		{
+			Name:                       "AllowAzureLoadBalancerInBound",
+			Priority:                   65001,
+			Direction:                  DirectionInbound,
+			Access:                     AccessAllow,
+			Protocol:                   "*",
+			SourceAddressPrefixes:      []string{"AzureLoadBalancer"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"*"},
+			DestinationPortRanges:      []string{"*"},
+		},
+		{
+			Name:                       "DenyAllInBound",
+			Priority:                   65500,
+			Direction:                  DirectionInbound,
+			Access:                     AccessDeny,
+			Protocol:                   "*",
+			SourceAddressPrefixes:      []string{"*"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"*"},
+			DestinationPortRanges:      []string{"*"},
+		},
+		{
+			Name:                       "AllowVnetOutBound",
+			Priority:                   65000,
+			Direction:                  DirectionOutbound,
+			Access:                     AccessAllow,
+			Protocol:                   "*",
+			SourceAddressPrefixes:      []string{"VirtualNetwork"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"VirtualNetwork"},
+			DestinationPortRanges:      []string{"*"},
+		},
+		{
+			Name:                       "AllowInternetOutBound",
+			Priority:                   65001,
+			Direction:                  DirectionOutbound,
+			Access:                     AccessAllow,
+			Protocol:                   "*",
+			SourceAddressPrefixes:      []string{"*"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"Internet"},
+			DestinationPortRanges:      []string{"*"},
+		},
+		{
+			Name:                       "DenyAllOutBound",
+			Priority:                   65500,
+			Direction:                  DirectionOutbound,
+			Access:                     AccessDeny,
+			Protocol:                   "*",
+			SourceAddressPrefixes:      []string{"*"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"*"},
+			DestinationPortRanges:      []string{"*"},
+		},
+	}
+}
@@ -70,12 +70,15 @@ func resourceSubnetNatGatewayAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return fmt.Errorf("parsing NAT gateway id '%s': %+v", natGatewayId, err)
 	}
 
-	locks.ByName(parsedGatewayId.Name, natGatewayResourceName)
-	defer locks.UnlockByName(parsedGatewayId.Name, natGatewayResourceName)
-	locks.ByName(parsedSubnetId.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(parsedSubnetId.VirtualNetworkName, VirtualNetworkResourceName)
-	locks.ByName(parsedSubnetId.Name, SubnetResourceName)
-	defer locks.UnlockByName(parsedSubnetId.Name, SubnetResourceName)
+	locks.ByID(parsedGatewayId.ID())
+	defer locks.UnlockByID(parsedGatewayId.ID())
+	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+		virtualNetworkId := parse.NewVirtualNetworkID(parsedSubnetId.SubscriptionId, parsedSubnetId.ResourceGroup, parsedSubnetId.VirtualNetworkName)
+		locks.ByID(virtualNetworkId.ID())
+		defer locks.UnlockByID(virtualNetworkId.ID())
+	}
+	locks.ByID(parsedSubnetId.ID())
+	defer locks.UnlockByID(parsedSubnetId.ID())
 
 	subnet, err := client.Get(ctx, parsedSubnetId.ResourceGroup, parsedSubnetId.VirtualNetworkName, parsedSubnetId.Name, "")
 	if err != nil {
@@ -205,10 +208,16 @@ func resourceSubnetNatGatewayAssociationDelete(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(parsedGatewayId.Name, natGatewayResourceName)
-	defer locks.UnlockByName(parsedGatewayId.Name, natGatewayResourceName)
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	locks.ByID(parsedGatewayId.ID())
+	defer locks.UnlockByID(parsedGatewayId.ID())
+	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+		virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+		locks.ByID(virtualNetworkId.ID())
+		defer locks.UnlockByID(virtualNetworkId.ID())
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	// ensure we get the latest state
 	subnet, err = client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
@@ -73,14 +73,17 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
+	locks.ByID(parsedNetworkSecurityGroupId.ID())
+	defer locks.UnlockByID(parsedNetworkSecurityGroupId.ID())
 
-	locks.ByName(parsedSubnetId.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(parsedSubnetId.VirtualNetworkName, VirtualNetworkResourceName)
+	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+		virtualNetworkId := parse.NewVirtualNetworkID(parsedSubnetId.SubscriptionId, parsedSubnetId.ResourceGroup, parsedSubnetId.VirtualNetworkName)
+		locks.ByID(virtualNetworkId.ID())
+		defer locks.UnlockByID(virtualNetworkId.ID())
+	}
 
-	locks.ByName(parsedSubnetId.Name, SubnetResourceName)
-	defer locks.UnlockByName(parsedSubnetId.Name, SubnetResourceName)
+	locks.ByID(parsedSubnetId.ID())
+	defer locks.UnlockByID(parsedSubnetId.ID())
 
 	subnet, err := client.Get(ctx, parsedSubnetId.ResourceGroup, parsedSubnetId.VirtualNetworkName, parsedSubnetId.Name, "")
 	if err != nil {
@@ -218,14 +221,17 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
+	locks.ByID(parsedNetworkSecurityGroupId.ID())
+	defer locks.UnlockByID(parsedNetworkSecurityGroupId.ID())
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+		virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+		locks.ByID(virtualNetworkId.ID())
+		defer locks.UnlockByID(virtualNetworkId.ID())
+	}
 
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
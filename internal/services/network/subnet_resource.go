@@ -21,8 +21,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-var SubnetResourceName = "azurerm_subnet"
-
 func resourceSubnet() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSubnetCreate,
@@ -203,8 +201,14 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return tf.ImportAsExistsError("azurerm_subnet", id.ID())
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+		virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+		locks.ByID(virtualNetworkId.ID())
+		defer locks.UnlockByID(virtualNetworkId.ID())
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	properties := network.SubnetPropertiesFormat{}
 	if value, ok := d.GetOk("address_prefixes"); ok {
@@ -293,11 +297,14 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+		virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+		locks.ByID(virtualNetworkId.ID())
+		defer locks.UnlockByID(virtualNetworkId.ID())
+	}
 
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
 	if err != nil {
@@ -467,11 +474,14 @@ func resourceSubnetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+		virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+		locks.ByID(virtualNetworkId.ID())
+		defer locks.UnlockByID(virtualNetworkId.ID())
+	}
 
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name)
 	if err != nil {
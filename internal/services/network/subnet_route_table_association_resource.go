@@ -73,15 +73,21 @@ func resourceSubnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
-	defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
+	locks.ByID(parsedRouteTableId.ID())
+	defer locks.UnlockByID(parsedRouteTableId.ID())
 
 	subnetName := parsedSubnetId.Name
 	virtualNetworkName := parsedSubnetId.VirtualNetworkName
 	resourceGroup := parsedSubnetId.ResourceGroup
 
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+		virtualNetworkId := parse.NewVirtualNetworkID(parsedSubnetId.SubscriptionId, parsedSubnetId.ResourceGroup, parsedSubnetId.VirtualNetworkName)
+		locks.ByID(virtualNetworkId.ID())
+		defer locks.UnlockByID(virtualNetworkId.ID())
+	}
+
+	locks.ByID(parsedSubnetId.ID())
+	defer locks.UnlockByID(parsedSubnetId.ID())
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -230,11 +236,17 @@ func resourceSubnetRouteTableAssociationDelete(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
-	defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
+	locks.ByID(parsedRouteTableId.ID())
+	defer locks.UnlockByID(parsedRouteTableId.ID())
+
+	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+		virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+		locks.ByID(virtualNetworkId.ID())
+		defer locks.UnlockByID(virtualNetworkId.ID())
+	}
 
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
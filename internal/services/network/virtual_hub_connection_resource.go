@@ -177,16 +177,16 @@ func resourceVirtualHubConnectionCreateOrUpdate(d *pluginsdk.ResourceData, meta
 
 	id := parse.NewHubVirtualNetworkConnectionID(virtualHubId.SubscriptionId, virtualHubId.ResourceGroup, virtualHubId.Name, d.Get("name").(string))
 
-	locks.ByName(virtualHubId.Name, virtualHubResourceName)
-	defer locks.UnlockByName(virtualHubId.Name, virtualHubResourceName)
+	locks.ByID(virtualHubId.ID())
+	defer locks.UnlockByID(virtualHubId.ID())
 
 	remoteVirtualNetworkId, err := parse.VirtualNetworkID(d.Get("remote_virtual_network_id").(string))
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(remoteVirtualNetworkId.Name, VirtualNetworkResourceName)
-	defer locks.UnlockByName(remoteVirtualNetworkId.Name, VirtualNetworkResourceName)
+	locks.ByID(remoteVirtualNetworkId.ID())
+	defer locks.UnlockByID(remoteVirtualNetworkId.ID())
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
@@ -296,8 +296,8 @@ func resourceVirtualHubConnectionDelete(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+	locks.ByID(parse.NewVirtualHubID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName).ID())
+	defer locks.UnlockByID(parse.NewVirtualHubID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName).ID())
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
 	if err != nil {
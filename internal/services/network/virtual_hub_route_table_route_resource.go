@@ -99,8 +99,8 @@ func resourceVirtualHubRouteTableRouteCreateUpdate(d *pluginsdk.ResourceData, me
 		return err
 	}
 
-	locks.ByName(routeTableId.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(routeTableId.VirtualHubName, virtualHubResourceName)
+	locks.ByID(parse.NewVirtualHubID(routeTableId.SubscriptionId, routeTableId.ResourceGroup, routeTableId.VirtualHubName).ID())
+	defer locks.UnlockByID(parse.NewVirtualHubID(routeTableId.SubscriptionId, routeTableId.ResourceGroup, routeTableId.VirtualHubName).ID())
 
 	routeTable, err := client.Get(ctx, routeTableId.ResourceGroup, routeTableId.VirtualHubName, routeTableId.Name)
 	if err != nil {
@@ -218,8 +218,8 @@ func resourceVirtualHubRouteTableRouteDelete(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	locks.ByName(route.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(route.VirtualHubName, virtualHubResourceName)
+	locks.ByID(parse.NewVirtualHubID(route.SubscriptionId, route.ResourceGroup, route.VirtualHubName).ID())
+	defer locks.UnlockByID(parse.NewVirtualHubID(route.SubscriptionId, route.ResourceGroup, route.VirtualHubName).ID())
 
 	// get latest list of routes
 	routeTable, err := client.Get(ctx, route.ResourceGroup, route.VirtualHubName, route.HubRouteTableName)
@@ -0,0 +1,285 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	firewallValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	virtualHubRoutingPolicyDestinationInternet       = "Internet"
+	virtualHubRoutingPolicyDestinationPrivateTraffic = "PrivateTraffic"
+)
+
+func resourceVirtualHubRoutingIntent() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualHubRoutingIntentCreateUpdate,
+		Read:   resourceVirtualHubRoutingIntentRead,
+		Update: resourceVirtualHubRoutingIntentCreateUpdate,
+		Delete: resourceVirtualHubRoutingIntentDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VirtualHubRoutingIntentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_hub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualHubID,
+			},
+
+			"routing_policy": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"destinations": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									virtualHubRoutingPolicyDestinationInternet,
+									virtualHubRoutingPolicyDestinationPrivateTraffic,
+								}, false),
+							},
+						},
+
+						"next_hop": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: firewallValidate.FirewallID,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualHubRoutingIntentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubRoutingIntentClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualHubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// Route Tables on the Virtual Hub also lock on the Virtual Hub ID, so holding this lock
+	// ensures that one can't be added whilst we're checking for conflicts below
+	locks.ByID(virtualHubId.ID())
+	defer locks.UnlockByID(virtualHubId.ID())
+
+	id := parse.NewVirtualHubRoutingIntentID(virtualHubId.SubscriptionId, virtualHubId.ResourceGroup, virtualHubId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.RoutingIntentName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_virtual_hub_routing_intent", id.ID())
+		}
+	}
+
+	policies, err := expandVirtualHubRoutingPolicies(d.Get("routing_policy").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if err := checkVirtualHubHasNoCustomRouteTables(ctx, meta.(*clients.Client).Network.HubRouteTableClient, *virtualHubId); err != nil {
+		return fmt.Errorf("validating %s: %+v", id, err)
+	}
+
+	parameters := network.RoutingIntent{
+		Name: utils.String(id.RoutingIntentName),
+		RoutingIntentProperties: &network.RoutingIntentProperties{
+			RoutingPolicies: policies,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, id.RoutingIntentName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualHubRoutingIntentRead(d, meta)
+}
+
+func resourceVirtualHubRoutingIntentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubRoutingIntentClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualHubRoutingIntentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.RoutingIntentName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RoutingIntentName)
+	d.Set("virtual_hub_id", parse.NewVirtualHubID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName).ID())
+
+	if props := resp.RoutingIntentProperties; props != nil {
+		if err := d.Set("routing_policy", flattenVirtualHubRoutingPolicies(props.RoutingPolicies)); err != nil {
+			return fmt.Errorf("setting `routing_policy`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualHubRoutingIntentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubRoutingIntentClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualHubRoutingIntentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(parse.NewVirtualHubID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName).ID())
+	defer locks.UnlockByID(parse.NewVirtualHubID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName).ID())
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.RoutingIntentName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// checkVirtualHubHasNoCustomRouteTables returns an error when the Virtual Hub contains any Route Tables other
+// than the built-in ones, since traffic within a hub using Routing Intent is routed by the policies alone
+func checkVirtualHubHasNoCustomRouteTables(ctx context.Context, client *network.HubRouteTablesClient, id parse.VirtualHubId) error {
+	iterator, err := client.ListComplete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing Route Tables for %s: %+v", id, err)
+	}
+
+	customRouteTables := make([]string, 0)
+	for iterator.NotDone() {
+		routeTable := iterator.Value()
+		if routeTable.Name != nil && !strings.EqualFold(*routeTable.Name, "defaultRouteTable") && !strings.EqualFold(*routeTable.Name, "noneRouteTable") {
+			customRouteTables = append(customRouteTables, *routeTable.Name)
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Route Tables for %s: %+v", id, err)
+		}
+	}
+
+	if len(customRouteTables) > 0 {
+		return fmt.Errorf("Routing Intent can't be used on a Virtual Hub containing custom Route Tables - the Route Tables %q must be removed first", strings.Join(customRouteTables, ", "))
+	}
+
+	return nil
+}
+
+func expandVirtualHubRoutingPolicies(input []interface{}) (*[]network.RoutingPolicy, error) {
+	results := make([]network.RoutingPolicy, 0)
+	destinations := make(map[string]struct{})
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		for _, destination := range v["destinations"].([]interface{}) {
+			if _, exists := destinations[destination.(string)]; exists {
+				return nil, fmt.Errorf("the destination %q is used by more than one `routing_policy` - each destination can only be routed by a single policy", destination.(string))
+			}
+			destinations[destination.(string)] = struct{}{}
+		}
+
+		results = append(results, network.RoutingPolicy{
+			Name:         utils.String(v["name"].(string)),
+			Destinations: utils.ExpandStringSlice(v["destinations"].([]interface{})),
+			NextHop:      utils.String(v["next_hop"].(string)),
+		})
+	}
+
+	return &results, nil
+}
+
+func flattenVirtualHubRoutingPolicies(input *[]network.RoutingPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"name":         utils.NormalizeNilableString(item.Name),
+			"destinations": utils.FlattenStringSlice(item.Destinations),
+			"next_hop":     utils.NormalizeNilableString(item.NextHop),
+		})
+	}
+
+	return results
+}
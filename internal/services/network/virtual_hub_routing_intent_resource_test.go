@@ -0,0 +1,248 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualHubRoutingIntentResource struct{}
+
+func TestAccVirtualHubRoutingIntent_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_routing_intent", "test")
+	r := VirtualHubRoutingIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualHubRoutingIntent_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_routing_intent", "test")
+	r := VirtualHubRoutingIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualHubRoutingIntent_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_routing_intent", "test")
+	r := VirtualHubRoutingIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualHubRoutingIntent_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_routing_intent", "test")
+	r := VirtualHubRoutingIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualHubRoutingIntent_customRouteTableConflict(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_routing_intent", "test")
+	r := VirtualHubRoutingIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.customRouteTable(data),
+			ExpectError: regexp.MustCompile("Routing Intent can't be used on a Virtual Hub containing custom Route Tables"),
+		},
+	})
+}
+
+func (r VirtualHubRoutingIntentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualHubRoutingIntentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.VirtualHubRoutingIntentClient.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.RoutingIntentName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.RoutingIntentProperties != nil), nil
+}
+
+func (r VirtualHubRoutingIntentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vhub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctest-vwan-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctest-vhub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_prefix      = "10.0.1.0/24"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall" "test" {
+  name                = "acctest-fw-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "AZFW_Hub"
+  firewall_policy_id  = azurerm_firewall_policy.test.id
+
+  virtual_hub {
+    virtual_hub_id  = azurerm_virtual_hub.test.id
+    public_ip_count = 1
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r VirtualHubRoutingIntentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_routing_intent" "test" {
+  name           = "acctest-routingintent-%d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  routing_policy {
+    name         = "InternetTrafficPolicy"
+    destinations = ["Internet"]
+    next_hop     = azurerm_firewall.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualHubRoutingIntentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_routing_intent" "import" {
+  name           = azurerm_virtual_hub_routing_intent.test.name
+  virtual_hub_id = azurerm_virtual_hub_routing_intent.test.virtual_hub_id
+
+  routing_policy {
+    name         = "InternetTrafficPolicy"
+    destinations = ["Internet"]
+    next_hop     = azurerm_firewall.test.id
+  }
+}
+`, r.basic(data))
+}
+
+func (r VirtualHubRoutingIntentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_routing_intent" "test" {
+  name           = "acctest-routingintent-%d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  routing_policy {
+    name         = "InternetTrafficPolicy"
+    destinations = ["Internet"]
+    next_hop     = azurerm_firewall.test.id
+  }
+
+  routing_policy {
+    name         = "PrivateTrafficPolicy"
+    destinations = ["PrivateTraffic"]
+    next_hop     = azurerm_firewall.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualHubRoutingIntentResource) customRouteTable(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_route_table" "test" {
+  name           = "acctest-routetable-%[2]d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+  labels         = ["label1"]
+}
+
+resource "azurerm_virtual_hub_routing_intent" "test" {
+  name           = "acctest-routingintent-%[2]d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  routing_policy {
+    name         = "InternetTrafficPolicy"
+    destinations = ["Internet"]
+    next_hop     = azurerm_firewall.test.id
+  }
+
+  depends_on = [azurerm_virtual_hub_route_table.test]
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -77,8 +77,8 @@ func resourceVirtualNetworkDnsServersCreateUpdate(d *pluginsdk.ResourceData, met
 		return fmt.Errorf("reading %s: %s", vnetId, err)
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	locks.ByID(vnetId.ID())
+	defer locks.UnlockByID(vnetId.ID())
 
 	if vnet.VirtualNetworkPropertiesFormat == nil {
 		return fmt.Errorf("%s was returned without any properties", vnetId)
@@ -167,8 +167,8 @@ func resourceVirtualNetworkDnsServersDelete(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("reading %s: %s", vnetId, err)
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	locks.ByID(vnetId.ID())
+	defer locks.UnlockByID(vnetId.ID())
 
 	if vnet.VirtualNetworkPropertiesFormat == nil {
 		return fmt.Errorf("%s was returned without any properties", vnetId)
@@ -22,8 +22,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-var VirtualNetworkResourceName = "azurerm_virtual_network"
-
 func resourceVirtualNetwork() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVirtualNetworkCreateUpdate,
@@ -196,7 +194,7 @@ func resourceVirtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		vnet.VirtualNetworkPropertiesFormat.FlowTimeoutInMinutes = utils.Int32(int32(v.(int)))
 	}
 
-	networkSecurityGroupNames := make([]string, 0)
+	networkSecurityGroupIds := make([]string, 0)
 	for _, subnet := range *vnet.VirtualNetworkPropertiesFormat.Subnets {
 		if subnet.NetworkSecurityGroup != nil {
 			parsedNsgID, err := parse.NetworkSecurityGroupID(*subnet.NetworkSecurityGroup.ID)
@@ -204,15 +202,15 @@ func resourceVirtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 				return err
 			}
 
-			networkSecurityGroupName := parsedNsgID.Name
-			if !utils.SliceContainsValue(networkSecurityGroupNames, networkSecurityGroupName) {
-				networkSecurityGroupNames = append(networkSecurityGroupNames, networkSecurityGroupName)
+			networkSecurityGroupId := parsedNsgID.ID()
+			if !utils.SliceContainsValue(networkSecurityGroupIds, networkSecurityGroupId) {
+				networkSecurityGroupIds = append(networkSecurityGroupIds, networkSecurityGroupId)
 			}
 		}
 	}
 
-	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
-	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
+	locks.MultipleByID(&networkSecurityGroupIds)
+	defer locks.UnlockMultipleByID(&networkSecurityGroupIds)
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, vnet)
 	if err != nil {
@@ -311,13 +309,13 @@ func resourceVirtualNetworkDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
-	nsgNames, err := expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupNames(d)
+	nsgIds, err := expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupIDs(d)
 	if err != nil {
 		return fmt.Errorf("parsing Network Security Group ID's: %+v", err)
 	}
 
-	locks.MultipleByName(&nsgNames, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&nsgNames, VirtualNetworkResourceName)
+	locks.MultipleByID(&nsgIds)
+	defer locks.UnlockMultipleByID(&nsgIds)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -507,8 +505,8 @@ func getExistingSubnet(ctx context.Context, resGroup string, vnetName string, su
 	return &resp, nil
 }
 
-func expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupNames(d *pluginsdk.ResourceData) ([]string, error) {
-	nsgNames := make([]string, 0)
+func expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupIDs(d *pluginsdk.ResourceData) ([]string, error) {
+	nsgIds := make([]string, 0)
 
 	if v, ok := d.GetOk("subnet"); ok {
 		subnets := v.(*pluginsdk.Set).List()
@@ -525,15 +523,14 @@ func expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupNames(d *pluginsdk.Re
 					return nil, err
 				}
 
-				networkSecurityGroupName := parsedNsgID.Name
-				if !utils.SliceContainsValue(nsgNames, networkSecurityGroupName) {
-					nsgNames = append(nsgNames, networkSecurityGroupName)
+				if !utils.SliceContainsValue(nsgIds, parsedNsgID.ID()) {
+					nsgIds = append(nsgIds, parsedNsgID.ID())
 				}
 			}
 		}
 	}
 
-	return nsgNames, nil
+	return nsgIds, nil
 }
 
 func VirtualNetworkProvisioningStateRefreshFunc(ctx context.Context, client *network.VirtualNetworksClient, id parse.VirtualNetworkId) pluginsdk.StateRefreshFunc {
@@ -386,8 +386,8 @@ func resourceVpnGatewayConnectionResourceCreateUpdate(d *pluginsdk.ResourceData,
 		}
 	}
 
-	locks.ByName(gatewayId.Name, VPNGatewayResourceName)
-	defer locks.UnlockByName(gatewayId.Name, VPNGatewayResourceName)
+	locks.ByID(gatewayId.ID())
+	defer locks.UnlockByID(gatewayId.ID())
 
 	param := network.VpnConnection{
 		Name: &name,
@@ -502,8 +502,8 @@ func resourceVpnGatewayConnectionResourceDelete(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(id.VpnGatewayName, VPNGatewayResourceName)
-	defer locks.UnlockByName(id.VpnGatewayName, VPNGatewayResourceName)
+	locks.ByID(parse.NewVpnGatewayID(id.SubscriptionId, id.ResourceGroup, id.VpnGatewayName).ID())
+	defer locks.UnlockByID(parse.NewVpnGatewayID(id.SubscriptionId, id.ResourceGroup, id.VpnGatewayName).ID())
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VpnGatewayName, id.Name)
 	if err != nil {
@@ -22,8 +22,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-var VPNGatewayResourceName = "azurerm_vpn_gateway"
-
 func resourceVPNGateway() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVPNGatewayCreate,
@@ -281,8 +279,9 @@ func resourceVPNGatewayUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(name, VPNGatewayResourceName)
-	defer locks.UnlockByName(name, VPNGatewayResourceName)
+	id := parse.NewVpnGatewayID(meta.(*clients.Client).Account.SubscriptionId, resourceGroup, name)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	existing, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
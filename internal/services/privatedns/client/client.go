@@ -3,12 +3,20 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2022-07-01/dnsresolver"
 )
 
 type Client struct {
 	RecordSetsClient          *privatedns.RecordSetsClient
 	PrivateZonesClient        *privatedns.PrivateZonesClient
 	VirtualNetworkLinksClient *privatedns.VirtualNetworkLinksClient
+
+	DnsForwardingRulesetsClient      *dnsresolver.DnsForwardingRulesetsClient
+	DnsResolversClient               *dnsresolver.DnsResolversClient
+	ForwardingRulesClient            *dnsresolver.ForwardingRulesClient
+	InboundEndpointsClient           *dnsresolver.InboundEndpointsClient
+	OutboundEndpointsClient          *dnsresolver.OutboundEndpointsClient
+	RulesetVirtualNetworkLinksClient *dnsresolver.VirtualNetworkLinksClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -21,9 +29,34 @@ func NewClient(o *common.ClientOptions) *Client {
 	virtualNetworkLinksClient := privatedns.NewVirtualNetworkLinksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&virtualNetworkLinksClient.Client, o.ResourceManagerAuthorizer)
 
+	dnsForwardingRulesetsClient := dnsresolver.NewDnsForwardingRulesetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dnsForwardingRulesetsClient.Client, o.ResourceManagerAuthorizer)
+
+	dnsResolversClient := dnsresolver.NewDnsResolversClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dnsResolversClient.Client, o.ResourceManagerAuthorizer)
+
+	forwardingRulesClient := dnsresolver.NewForwardingRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&forwardingRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	inboundEndpointsClient := dnsresolver.NewInboundEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&inboundEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	outboundEndpointsClient := dnsresolver.NewOutboundEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&outboundEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	rulesetVirtualNetworkLinksClient := dnsresolver.NewVirtualNetworkLinksClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&rulesetVirtualNetworkLinksClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		RecordSetsClient:          &recordSetsClient,
 		PrivateZonesClient:        &privateZonesClient,
 		VirtualNetworkLinksClient: &virtualNetworkLinksClient,
+
+		DnsForwardingRulesetsClient:      &dnsForwardingRulesetsClient,
+		DnsResolversClient:               &dnsResolversClient,
+		ForwardingRulesClient:            &forwardingRulesClient,
+		InboundEndpointsClient:           &inboundEndpointsClient,
+		OutboundEndpointsClient:          &outboundEndpointsClient,
+		RulesetVirtualNetworkLinksClient: &rulesetVirtualNetworkLinksClient,
 	}
 }
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DnsForwardingRuleId struct {
+	SubscriptionId           string
+	ResourceGroup            string
+	DnsForwardingRulesetName string
+	ForwardingRuleName       string
+}
+
+func NewDnsForwardingRuleID(subscriptionId, resourceGroup, dnsForwardingRulesetName, forwardingRuleName string) DnsForwardingRuleId {
+	return DnsForwardingRuleId{
+		SubscriptionId:           subscriptionId,
+		ResourceGroup:            resourceGroup,
+		DnsForwardingRulesetName: dnsForwardingRulesetName,
+		ForwardingRuleName:       forwardingRuleName,
+	}
+}
+
+func (id DnsForwardingRuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Forwarding Rule Name %q", id.ForwardingRuleName),
+		fmt.Sprintf("Dns Forwarding Ruleset Name %q", id.DnsForwardingRulesetName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Dns Forwarding Rule", segmentsStr)
+}
+
+func (id DnsForwardingRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsForwardingRulesets/%s/forwardingRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, id.ForwardingRuleName)
+}
+
+// DnsForwardingRuleID parses a DnsForwardingRule ID into an DnsForwardingRuleId struct
+func DnsForwardingRuleID(input string) (*DnsForwardingRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsForwardingRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnsForwardingRulesetName, err = id.PopSegment("dnsForwardingRulesets"); err != nil {
+		return nil, err
+	}
+	if resourceId.ForwardingRuleName, err = id.PopSegment("forwardingRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DnsForwardingRulesetVirtualNetworkLinkId struct {
+	SubscriptionId           string
+	ResourceGroup            string
+	DnsForwardingRulesetName string
+	VirtualNetworkLinkName   string
+}
+
+func NewDnsForwardingRulesetVirtualNetworkLinkID(subscriptionId, resourceGroup, dnsForwardingRulesetName, virtualNetworkLinkName string) DnsForwardingRulesetVirtualNetworkLinkId {
+	return DnsForwardingRulesetVirtualNetworkLinkId{
+		SubscriptionId:           subscriptionId,
+		ResourceGroup:            resourceGroup,
+		DnsForwardingRulesetName: dnsForwardingRulesetName,
+		VirtualNetworkLinkName:   virtualNetworkLinkName,
+	}
+}
+
+func (id DnsForwardingRulesetVirtualNetworkLinkId) String() string {
+	segments := []string{
+		fmt.Sprintf("Virtual Network Link Name %q", id.VirtualNetworkLinkName),
+		fmt.Sprintf("Dns Forwarding Ruleset Name %q", id.DnsForwardingRulesetName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Dns Forwarding Ruleset Virtual Network Link", segmentsStr)
+}
+
+func (id DnsForwardingRulesetVirtualNetworkLinkId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsForwardingRulesets/%s/virtualNetworkLinks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, id.VirtualNetworkLinkName)
+}
+
+// DnsForwardingRulesetVirtualNetworkLinkID parses a DnsForwardingRulesetVirtualNetworkLink ID into an DnsForwardingRulesetVirtualNetworkLinkId struct
+func DnsForwardingRulesetVirtualNetworkLinkID(input string) (*DnsForwardingRulesetVirtualNetworkLinkId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsForwardingRulesetVirtualNetworkLinkId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnsForwardingRulesetName, err = id.PopSegment("dnsForwardingRulesets"); err != nil {
+		return nil, err
+	}
+	if resourceId.VirtualNetworkLinkName, err = id.PopSegment("virtualNetworkLinks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DnsResolverInboundEndpointId struct {
+	SubscriptionId      string
+	ResourceGroup       string
+	DnsResolverName     string
+	InboundEndpointName string
+}
+
+func NewDnsResolverInboundEndpointID(subscriptionId, resourceGroup, dnsResolverName, inboundEndpointName string) DnsResolverInboundEndpointId {
+	return DnsResolverInboundEndpointId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroup:       resourceGroup,
+		DnsResolverName:     dnsResolverName,
+		InboundEndpointName: inboundEndpointName,
+	}
+}
+
+func (id DnsResolverInboundEndpointId) String() string {
+	segments := []string{
+		fmt.Sprintf("Inbound Endpoint Name %q", id.InboundEndpointName),
+		fmt.Sprintf("Dns Resolver Name %q", id.DnsResolverName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Dns Resolver Inbound Endpoint", segmentsStr)
+}
+
+func (id DnsResolverInboundEndpointId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsResolvers/%s/inboundEndpoints/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsResolverName, id.InboundEndpointName)
+}
+
+// DnsResolverInboundEndpointID parses a DnsResolverInboundEndpoint ID into an DnsResolverInboundEndpointId struct
+func DnsResolverInboundEndpointID(input string) (*DnsResolverInboundEndpointId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsResolverInboundEndpointId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnsResolverName, err = id.PopSegment("dnsResolvers"); err != nil {
+		return nil, err
+	}
+	if resourceId.InboundEndpointName, err = id.PopSegment("inboundEndpoints"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
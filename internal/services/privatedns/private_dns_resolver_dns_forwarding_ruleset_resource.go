@@ -0,0 +1,165 @@
+package privatedns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2022-07-01/dnsresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourcePrivateDnsResolverDnsForwardingRuleset() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateDnsResolverDnsForwardingRulesetCreateUpdate,
+		Read:   resourcePrivateDnsResolverDnsForwardingRulesetRead,
+		Update: resourcePrivateDnsResolverDnsForwardingRulesetCreateUpdate,
+		Delete: resourcePrivateDnsResolverDnsForwardingRulesetDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DnsForwardingRulesetID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsResolverName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": location.Schema(),
+
+			"private_dns_resolver_outbound_endpoint_ids": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.DnsResolverOutboundEndpointID,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourcePrivateDnsResolverDnsForwardingRulesetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.DnsForwardingRulesetsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDnsForwardingRulesetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_private_dns_resolver_dns_forwarding_ruleset", id.ID())
+		}
+	}
+
+	outboundEndpoints := make([]dnsresolver.SubResource, 0)
+	for _, v := range d.Get("private_dns_resolver_outbound_endpoint_ids").([]interface{}) {
+		outboundEndpoints = append(outboundEndpoints, dnsresolver.SubResource{
+			Id: v.(string),
+		})
+	}
+
+	parameters := dnsresolver.DnsForwardingRuleset{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: dnsresolver.DnsForwardingRulesetProperties{
+			DnsResolverOutboundEndpoints: outboundEndpoints,
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourcePrivateDnsResolverDnsForwardingRulesetRead(d, meta)
+}
+
+func resourcePrivateDnsResolverDnsForwardingRulesetRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.DnsForwardingRulesetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsForwardingRulesetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		outboundEndpointIds := make([]interface{}, 0)
+		for _, v := range model.Properties.DnsResolverOutboundEndpoints {
+			outboundEndpointIds = append(outboundEndpointIds, v.Id)
+		}
+		if err := d.Set("private_dns_resolver_outbound_endpoint_ids", outboundEndpointIds); err != nil {
+			return fmt.Errorf("setting `private_dns_resolver_outbound_endpoint_ids`: %+v", err)
+		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+	}
+
+	return nil
+}
+
+func resourcePrivateDnsResolverDnsForwardingRulesetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.DnsForwardingRulesetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsForwardingRulesetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,264 @@
+package privatedns
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2022-07-01/dnsresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourcePrivateDnsResolverForwardingRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateDnsResolverForwardingRuleCreateUpdate,
+		Read:   resourcePrivateDnsResolverForwardingRuleRead,
+		Update: resourcePrivateDnsResolverForwardingRuleCreateUpdate,
+		Delete: resourcePrivateDnsResolverForwardingRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DnsForwardingRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsResolverName,
+			},
+
+			"dns_forwarding_ruleset_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsForwardingRulesetID,
+			},
+
+			"domain_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringIsNotWhiteSpace,
+					func(v interface{}, k string) (warnings []string, errors []error) {
+						if value, ok := v.(string); ok && !strings.HasSuffix(value, ".") {
+							errors = append(errors, fmt.Errorf("%q must be a fully qualified domain name ending with a `.` (for example `contoso.com.`)", k))
+						}
+						return
+					},
+				),
+			},
+
+			"target_dns_servers": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"ip_address": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+
+						"port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      53,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"metadata": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourcePrivateDnsResolverForwardingRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.ForwardingRulesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	rulesetId, err := parse.DnsForwardingRulesetID(d.Get("dns_forwarding_ruleset_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewDnsForwardingRuleID(rulesetId.SubscriptionId, rulesetId.ResourceGroup, rulesetId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_private_dns_resolver_forwarding_rule", id.ID())
+		}
+	}
+
+	state := dnsresolver.ForwardingRuleStateDisabled
+	if d.Get("enabled").(bool) {
+		state = dnsresolver.ForwardingRuleStateEnabled
+	}
+
+	parameters := dnsresolver.ForwardingRule{
+		Properties: dnsresolver.ForwardingRuleProperties{
+			DomainName:          d.Get("domain_name").(string),
+			ForwardingRuleState: &state,
+			Metadata:            expandPrivateDnsResolverMetadata(d.Get("metadata").(map[string]interface{})),
+			TargetDnsServers:    expandPrivateDnsResolverTargetDnsServers(d.Get("target_dns_servers").([]interface{})),
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourcePrivateDnsResolverForwardingRuleRead(d, meta)
+}
+
+func resourcePrivateDnsResolverForwardingRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.ForwardingRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsForwardingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.ForwardingRuleName)
+	d.Set("dns_forwarding_ruleset_id", parse.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+		d.Set("domain_name", props.DomainName)
+		d.Set("enabled", props.ForwardingRuleState == nil || *props.ForwardingRuleState == dnsresolver.ForwardingRuleStateEnabled)
+
+		if err := d.Set("target_dns_servers", flattenPrivateDnsResolverTargetDnsServers(props.TargetDnsServers)); err != nil {
+			return fmt.Errorf("setting `target_dns_servers`: %+v", err)
+		}
+
+		if err := d.Set("metadata", flattenPrivateDnsResolverMetadata(props.Metadata)); err != nil {
+			return fmt.Errorf("setting `metadata`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourcePrivateDnsResolverForwardingRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.ForwardingRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsForwardingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandPrivateDnsResolverTargetDnsServers(input []interface{}) []dnsresolver.TargetDnsServer {
+	results := make([]dnsresolver.TargetDnsServer, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, dnsresolver.TargetDnsServer{
+			IPAddress: v["ip_address"].(string),
+			Port:      utils.Int64(int64(v["port"].(int))),
+		})
+	}
+
+	return results
+}
+
+func flattenPrivateDnsResolverTargetDnsServers(input []dnsresolver.TargetDnsServer) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, v := range input {
+		port := 53
+		if v.Port != nil {
+			port = int(*v.Port)
+		}
+
+		results = append(results, map[string]interface{}{
+			"ip_address": v.IPAddress,
+			"port":       port,
+		})
+	}
+
+	return results
+}
+
+func expandPrivateDnsResolverMetadata(input map[string]interface{}) *map[string]string {
+	results := make(map[string]string)
+
+	for k, v := range input {
+		results[k] = v.(string)
+	}
+
+	return &results
+}
+
+func flattenPrivateDnsResolverMetadata(input *map[string]string) map[string]interface{} {
+	results := make(map[string]interface{})
+	if input == nil {
+		return results
+	}
+
+	for k, v := range *input {
+		results[k] = v
+	}
+
+	return results
+}
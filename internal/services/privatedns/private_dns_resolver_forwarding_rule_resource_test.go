@@ -0,0 +1,150 @@
+package privatedns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDnsResolverForwardingRuleResource struct{}
+
+func TestAccPrivateDnsResolverForwardingRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_forwarding_rule", "test")
+	r := PrivateDnsResolverForwardingRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("target_dns_servers.0.port").HasValue("53"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDnsResolverForwardingRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_forwarding_rule", "test")
+	r := PrivateDnsResolverForwardingRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPrivateDnsResolverForwardingRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_forwarding_rule", "test")
+	r := PrivateDnsResolverForwardingRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("target_dns_servers.#").HasValue("2"),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (PrivateDnsResolverForwardingRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DnsForwardingRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.PrivateDns.ForwardingRulesClient.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (PrivateDnsResolverForwardingRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_forwarding_rule" "test" {
+  name                      = "acctest-drfr-%d"
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.id
+  domain_name               = "onprem.local."
+
+  target_dns_servers {
+    ip_address = "10.10.0.1"
+  }
+}
+`, PrivateDnsResolverDnsForwardingRulesetResource{}.basic(data), data.RandomInteger)
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_forwarding_rule" "import" {
+  name                      = azurerm_private_dns_resolver_forwarding_rule.test.name
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_forwarding_rule.test.dns_forwarding_ruleset_id
+  domain_name               = azurerm_private_dns_resolver_forwarding_rule.test.domain_name
+
+  target_dns_servers {
+    ip_address = "10.10.0.1"
+  }
+}
+`, r.basic(data))
+}
+
+func (PrivateDnsResolverForwardingRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_forwarding_rule" "test" {
+  name                      = "acctest-drfr-%d"
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.id
+  domain_name               = "onprem.local."
+  enabled                   = false
+
+  target_dns_servers {
+    ip_address = "10.10.0.1"
+    port       = 53
+  }
+
+  target_dns_servers {
+    ip_address = "10.10.0.2"
+    port       = 5353
+  }
+
+  metadata = {
+    key = "value"
+  }
+}
+`, PrivateDnsResolverDnsForwardingRulesetResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,244 @@
+package privatedns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2022-07-01/dnsresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourcePrivateDnsResolverInboundEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateDnsResolverInboundEndpointCreateUpdate,
+		Read:   resourcePrivateDnsResolverInboundEndpointRead,
+		Update: resourcePrivateDnsResolverInboundEndpointCreateUpdate,
+		Delete: resourcePrivateDnsResolverInboundEndpointDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DnsResolverInboundEndpointID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsResolverName,
+			},
+
+			"private_dns_resolver_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsResolverID,
+			},
+
+			"location": location.Schema(),
+
+			"ip_configurations": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subnet_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: networkValidate.SubnetID,
+						},
+
+						"private_ip_allocation_method": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  string(dnsresolver.IPAllocationMethodDynamic),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(dnsresolver.IPAllocationMethodDynamic),
+								string(dnsresolver.IPAllocationMethodStatic),
+							}, false),
+						},
+
+						"private_ip_address": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourcePrivateDnsResolverInboundEndpointCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.InboundEndpointsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resolverId, err := parse.DnsResolverID(d.Get("private_dns_resolver_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewDnsResolverInboundEndpointID(resolverId.SubscriptionId, resolverId.ResourceGroup, resolverId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_private_dns_resolver_inbound_endpoint", id.ID())
+		}
+	}
+
+	ipConfigurations, err := expandPrivateDnsResolverIPConfigurations(d.Get("ip_configurations").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	parameters := dnsresolver.InboundEndpoint{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: dnsresolver.InboundEndpointProperties{
+			IPConfigurations: ipConfigurations,
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourcePrivateDnsResolverInboundEndpointRead(d, meta)
+}
+
+func resourcePrivateDnsResolverInboundEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.InboundEndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsResolverInboundEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.InboundEndpointName)
+	d.Set("private_dns_resolver_id", parse.NewDnsResolverID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if err := d.Set("ip_configurations", flattenPrivateDnsResolverIPConfigurations(model.Properties.IPConfigurations)); err != nil {
+			return fmt.Errorf("setting `ip_configurations`: %+v", err)
+		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+	}
+
+	return nil
+}
+
+func resourcePrivateDnsResolverInboundEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.InboundEndpointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsResolverInboundEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandPrivateDnsResolverIPConfigurations(input []interface{}) ([]dnsresolver.IPConfiguration, error) {
+	results := make([]dnsresolver.IPConfiguration, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		allocationMethod := dnsresolver.IPAllocationMethod(v["private_ip_allocation_method"].(string))
+		ipConfiguration := dnsresolver.IPConfiguration{
+			PrivateIPAllocationMethod: &allocationMethod,
+			Subnet: dnsresolver.SubResource{
+				Id: v["subnet_id"].(string),
+			},
+		}
+
+		// when the allocation method is Dynamic the (computed) address is assigned by Azure
+		if allocationMethod == dnsresolver.IPAllocationMethodStatic {
+			privateIPAddress := v["private_ip_address"].(string)
+			if privateIPAddress == "" {
+				return nil, fmt.Errorf("`private_ip_address` must be specified when `private_ip_allocation_method` is `Static`")
+			}
+			ipConfiguration.PrivateIPAddress = utils.String(privateIPAddress)
+		}
+
+		results = append(results, ipConfiguration)
+	}
+
+	return results, nil
+}
+
+func flattenPrivateDnsResolverIPConfigurations(input []dnsresolver.IPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, v := range input {
+		allocationMethod := ""
+		if v.PrivateIPAllocationMethod != nil {
+			allocationMethod = string(*v.PrivateIPAllocationMethod)
+		}
+
+		results = append(results, map[string]interface{}{
+			"subnet_id":                    v.Subnet.Id,
+			"private_ip_allocation_method": allocationMethod,
+			"private_ip_address":           utils.NormalizeNilableString(v.PrivateIPAddress),
+		})
+	}
+
+	return results
+}
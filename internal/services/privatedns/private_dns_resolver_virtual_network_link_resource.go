@@ -0,0 +1,159 @@
+package privatedns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2022-07-01/dnsresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourcePrivateDnsResolverVirtualNetworkLink() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateDnsResolverVirtualNetworkLinkCreateUpdate,
+		Read:   resourcePrivateDnsResolverVirtualNetworkLinkRead,
+		Update: resourcePrivateDnsResolverVirtualNetworkLinkCreateUpdate,
+		Delete: resourcePrivateDnsResolverVirtualNetworkLinkDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DnsForwardingRulesetVirtualNetworkLinkID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsResolverName,
+			},
+
+			"dns_forwarding_ruleset_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsForwardingRulesetID,
+			},
+
+			"virtual_network_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.VirtualNetworkID,
+			},
+
+			"metadata": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourcePrivateDnsResolverVirtualNetworkLinkCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.RulesetVirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	rulesetId, err := parse.DnsForwardingRulesetID(d.Get("dns_forwarding_ruleset_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewDnsForwardingRulesetVirtualNetworkLinkID(rulesetId.SubscriptionId, rulesetId.ResourceGroup, rulesetId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_private_dns_resolver_virtual_network_link", id.ID())
+		}
+	}
+
+	parameters := dnsresolver.VirtualNetworkLink{
+		Properties: dnsresolver.VirtualNetworkLinkProperties{
+			Metadata: expandPrivateDnsResolverMetadata(d.Get("metadata").(map[string]interface{})),
+			VirtualNetwork: dnsresolver.SubResource{
+				Id: d.Get("virtual_network_id").(string),
+			},
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourcePrivateDnsResolverVirtualNetworkLinkRead(d, meta)
+}
+
+func resourcePrivateDnsResolverVirtualNetworkLinkRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.RulesetVirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsForwardingRulesetVirtualNetworkLinkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.VirtualNetworkLinkName)
+	d.Set("dns_forwarding_ruleset_id", parse.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("virtual_network_id", model.Properties.VirtualNetwork.Id)
+
+		if err := d.Set("metadata", flattenPrivateDnsResolverMetadata(model.Properties.Metadata)); err != nil {
+			return fmt.Errorf("setting `metadata`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourcePrivateDnsResolverVirtualNetworkLinkDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.RulesetVirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsForwardingRulesetVirtualNetworkLinkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
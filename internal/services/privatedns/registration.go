@@ -28,14 +28,20 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_private_dns_zone":                      resourcePrivateDnsZone(),
-		"azurerm_private_dns_a_record":                  resourcePrivateDnsARecord(),
-		"azurerm_private_dns_aaaa_record":               resourcePrivateDnsAaaaRecord(),
-		"azurerm_private_dns_cname_record":              resourcePrivateDnsCNameRecord(),
-		"azurerm_private_dns_mx_record":                 resourcePrivateDnsMxRecord(),
-		"azurerm_private_dns_ptr_record":                resourcePrivateDnsPtrRecord(),
-		"azurerm_private_dns_srv_record":                resourcePrivateDnsSrvRecord(),
-		"azurerm_private_dns_txt_record":                resourcePrivateDnsTxtRecord(),
-		"azurerm_private_dns_zone_virtual_network_link": resourcePrivateDnsZoneVirtualNetworkLink(),
+		"azurerm_private_dns_zone":                            resourcePrivateDnsZone(),
+		"azurerm_private_dns_a_record":                        resourcePrivateDnsARecord(),
+		"azurerm_private_dns_aaaa_record":                     resourcePrivateDnsAaaaRecord(),
+		"azurerm_private_dns_cname_record":                    resourcePrivateDnsCNameRecord(),
+		"azurerm_private_dns_mx_record":                       resourcePrivateDnsMxRecord(),
+		"azurerm_private_dns_ptr_record":                      resourcePrivateDnsPtrRecord(),
+		"azurerm_private_dns_srv_record":                      resourcePrivateDnsSrvRecord(),
+		"azurerm_private_dns_txt_record":                      resourcePrivateDnsTxtRecord(),
+		"azurerm_private_dns_zone_virtual_network_link":       resourcePrivateDnsZoneVirtualNetworkLink(),
+		"azurerm_private_dns_resolver":                        resourcePrivateDnsResolver(),
+		"azurerm_private_dns_resolver_dns_forwarding_ruleset": resourcePrivateDnsResolverDnsForwardingRuleset(),
+		"azurerm_private_dns_resolver_forwarding_rule":        resourcePrivateDnsResolverForwardingRule(),
+		"azurerm_private_dns_resolver_inbound_endpoint":       resourcePrivateDnsResolverInboundEndpoint(),
+		"azurerm_private_dns_resolver_outbound_endpoint":      resourcePrivateDnsResolverOutboundEndpoint(),
+		"azurerm_private_dns_resolver_virtual_network_link":   resourcePrivateDnsResolverVirtualNetworkLink(),
 	}
 }
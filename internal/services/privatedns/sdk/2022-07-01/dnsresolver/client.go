@@ -0,0 +1,129 @@
+package dnsresolver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// ResourceId is the ID of a resource within the DNS Resolver API, such as one of the IDs within the `parse` package
+type ResourceId interface {
+	ID() string
+}
+
+type baseClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func newBaseClient(endpoint string) baseClient {
+	return baseClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
+
+// createOrUpdateThenPoll performs a PUT of `input` to the specified ID, then polls until the operation has completed
+func (c baseClient) createOrUpdateThenPoll(ctx context.Context, clientName string, id ResourceId, input interface{}) error {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, clientName, "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, clientName, "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	if err != nil {
+		return autorest.NewErrorWithError(err, clientName, "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// get retrieves the specified ID, unmarshalling the response into `model`
+func (c baseClient) get(ctx context.Context, clientName string, id ResourceId, model interface{}) (*http.Response, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, clientName, "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(model),
+		autorest.ByClosing())
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "Get", resp, "Failure responding to request")
+	}
+
+	return resp, nil
+}
+
+// deleteThenPoll deletes the specified ID, then polls until the operation has completed
+func (c baseClient) deleteThenPoll(ctx context.Context, clientName string, id ResourceId) error {
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, clientName, "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, clientName, "Delete", resp, "Failure sending request")
+	}
+
+	// resources which have already been removed return a 204 rather than a long running operation
+	if resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	poller, err := polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	if err != nil {
+		return autorest.NewErrorWithError(err, clientName, "Delete", resp, "Failure sending request")
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
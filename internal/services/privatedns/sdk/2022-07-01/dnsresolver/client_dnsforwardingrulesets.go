@@ -0,0 +1,37 @@
+package dnsresolver
+
+import (
+	"context"
+	"net/http"
+)
+
+type DnsForwardingRulesetsClient struct {
+	baseClient
+}
+
+func NewDnsForwardingRulesetsClientWithBaseURI(endpoint string) DnsForwardingRulesetsClient {
+	return DnsForwardingRulesetsClient{
+		baseClient: newBaseClient(endpoint),
+	}
+}
+
+type GetDnsForwardingRulesetResponse struct {
+	HttpResponse *http.Response
+	Model        *DnsForwardingRuleset
+}
+
+// CreateOrUpdateThenPoll creates or updates the DnsForwardingRuleset then polls until it's completed
+func (c DnsForwardingRulesetsClient) CreateOrUpdateThenPoll(ctx context.Context, id ResourceId, input DnsForwardingRuleset) error {
+	return c.createOrUpdateThenPoll(ctx, "dnsresolver.DnsForwardingRulesetsClient", id, input)
+}
+
+// Get retrieves the DnsForwardingRuleset
+func (c DnsForwardingRulesetsClient) Get(ctx context.Context, id ResourceId) (result GetDnsForwardingRulesetResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, "dnsresolver.DnsForwardingRulesetsClient", id, &result.Model)
+	return
+}
+
+// DeleteThenPoll deletes the DnsForwardingRuleset then polls until it's completed
+func (c DnsForwardingRulesetsClient) DeleteThenPoll(ctx context.Context, id ResourceId) error {
+	return c.deleteThenPoll(ctx, "dnsresolver.DnsForwardingRulesetsClient", id)
+}
@@ -0,0 +1,37 @@
+package dnsresolver
+
+import (
+	"context"
+	"net/http"
+)
+
+type DnsResolversClient struct {
+	baseClient
+}
+
+func NewDnsResolversClientWithBaseURI(endpoint string) DnsResolversClient {
+	return DnsResolversClient{
+		baseClient: newBaseClient(endpoint),
+	}
+}
+
+type GetDnsResolverResponse struct {
+	HttpResponse *http.Response
+	Model        *DnsResolver
+}
+
+// CreateOrUpdateThenPoll creates or updates the DnsResolver then polls until it's completed
+func (c DnsResolversClient) CreateOrUpdateThenPoll(ctx context.Context, id ResourceId, input DnsResolver) error {
+	return c.createOrUpdateThenPoll(ctx, "dnsresolver.DnsResolversClient", id, input)
+}
+
+// Get retrieves the DnsResolver
+func (c DnsResolversClient) Get(ctx context.Context, id ResourceId) (result GetDnsResolverResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, "dnsresolver.DnsResolversClient", id, &result.Model)
+	return
+}
+
+// DeleteThenPoll deletes the DnsResolver then polls until it's completed
+func (c DnsResolversClient) DeleteThenPoll(ctx context.Context, id ResourceId) error {
+	return c.deleteThenPoll(ctx, "dnsresolver.DnsResolversClient", id)
+}
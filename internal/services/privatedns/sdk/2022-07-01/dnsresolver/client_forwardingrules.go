@@ -0,0 +1,37 @@
+package dnsresolver
+
+import (
+	"context"
+	"net/http"
+)
+
+type ForwardingRulesClient struct {
+	baseClient
+}
+
+func NewForwardingRulesClientWithBaseURI(endpoint string) ForwardingRulesClient {
+	return ForwardingRulesClient{
+		baseClient: newBaseClient(endpoint),
+	}
+}
+
+type GetForwardingRuleResponse struct {
+	HttpResponse *http.Response
+	Model        *ForwardingRule
+}
+
+// CreateOrUpdateThenPoll creates or updates the ForwardingRule then polls until it's completed
+func (c ForwardingRulesClient) CreateOrUpdateThenPoll(ctx context.Context, id ResourceId, input ForwardingRule) error {
+	return c.createOrUpdateThenPoll(ctx, "dnsresolver.ForwardingRulesClient", id, input)
+}
+
+// Get retrieves the ForwardingRule
+func (c ForwardingRulesClient) Get(ctx context.Context, id ResourceId) (result GetForwardingRuleResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, "dnsresolver.ForwardingRulesClient", id, &result.Model)
+	return
+}
+
+// DeleteThenPoll deletes the ForwardingRule then polls until it's completed
+func (c ForwardingRulesClient) DeleteThenPoll(ctx context.Context, id ResourceId) error {
+	return c.deleteThenPoll(ctx, "dnsresolver.ForwardingRulesClient", id)
+}
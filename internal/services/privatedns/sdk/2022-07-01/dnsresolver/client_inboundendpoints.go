@@ -0,0 +1,37 @@
+package dnsresolver
+
+import (
+	"context"
+	"net/http"
+)
+
+type InboundEndpointsClient struct {
+	baseClient
+}
+
+func NewInboundEndpointsClientWithBaseURI(endpoint string) InboundEndpointsClient {
+	return InboundEndpointsClient{
+		baseClient: newBaseClient(endpoint),
+	}
+}
+
+type GetInboundEndpointResponse struct {
+	HttpResponse *http.Response
+	Model        *InboundEndpoint
+}
+
+// CreateOrUpdateThenPoll creates or updates the InboundEndpoint then polls until it's completed
+func (c InboundEndpointsClient) CreateOrUpdateThenPoll(ctx context.Context, id ResourceId, input InboundEndpoint) error {
+	return c.createOrUpdateThenPoll(ctx, "dnsresolver.InboundEndpointsClient", id, input)
+}
+
+// Get retrieves the InboundEndpoint
+func (c InboundEndpointsClient) Get(ctx context.Context, id ResourceId) (result GetInboundEndpointResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, "dnsresolver.InboundEndpointsClient", id, &result.Model)
+	return
+}
+
+// DeleteThenPoll deletes the InboundEndpoint then polls until it's completed
+func (c InboundEndpointsClient) DeleteThenPoll(ctx context.Context, id ResourceId) error {
+	return c.deleteThenPoll(ctx, "dnsresolver.InboundEndpointsClient", id)
+}
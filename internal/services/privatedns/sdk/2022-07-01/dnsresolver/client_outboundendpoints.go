@@ -0,0 +1,37 @@
+package dnsresolver
+
+import (
+	"context"
+	"net/http"
+)
+
+type OutboundEndpointsClient struct {
+	baseClient
+}
+
+func NewOutboundEndpointsClientWithBaseURI(endpoint string) OutboundEndpointsClient {
+	return OutboundEndpointsClient{
+		baseClient: newBaseClient(endpoint),
+	}
+}
+
+type GetOutboundEndpointResponse struct {
+	HttpResponse *http.Response
+	Model        *OutboundEndpoint
+}
+
+// CreateOrUpdateThenPoll creates or updates the OutboundEndpoint then polls until it's completed
+func (c OutboundEndpointsClient) CreateOrUpdateThenPoll(ctx context.Context, id ResourceId, input OutboundEndpoint) error {
+	return c.createOrUpdateThenPoll(ctx, "dnsresolver.OutboundEndpointsClient", id, input)
+}
+
+// Get retrieves the OutboundEndpoint
+func (c OutboundEndpointsClient) Get(ctx context.Context, id ResourceId) (result GetOutboundEndpointResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, "dnsresolver.OutboundEndpointsClient", id, &result.Model)
+	return
+}
+
+// DeleteThenPoll deletes the OutboundEndpoint then polls until it's completed
+func (c OutboundEndpointsClient) DeleteThenPoll(ctx context.Context, id ResourceId) error {
+	return c.deleteThenPoll(ctx, "dnsresolver.OutboundEndpointsClient", id)
+}
@@ -0,0 +1,37 @@
+package dnsresolver
+
+import (
+	"context"
+	"net/http"
+)
+
+type VirtualNetworkLinksClient struct {
+	baseClient
+}
+
+func NewVirtualNetworkLinksClientWithBaseURI(endpoint string) VirtualNetworkLinksClient {
+	return VirtualNetworkLinksClient{
+		baseClient: newBaseClient(endpoint),
+	}
+}
+
+type GetVirtualNetworkLinkResponse struct {
+	HttpResponse *http.Response
+	Model        *VirtualNetworkLink
+}
+
+// CreateOrUpdateThenPoll creates or updates the VirtualNetworkLink then polls until it's completed
+func (c VirtualNetworkLinksClient) CreateOrUpdateThenPoll(ctx context.Context, id ResourceId, input VirtualNetworkLink) error {
+	return c.createOrUpdateThenPoll(ctx, "dnsresolver.VirtualNetworkLinksClient", id, input)
+}
+
+// Get retrieves the VirtualNetworkLink
+func (c VirtualNetworkLinksClient) Get(ctx context.Context, id ResourceId) (result GetVirtualNetworkLinkResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, "dnsresolver.VirtualNetworkLinksClient", id, &result.Model)
+	return
+}
+
+// DeleteThenPoll deletes the VirtualNetworkLink then polls until it's completed
+func (c VirtualNetworkLinksClient) DeleteThenPoll(ctx context.Context, id ResourceId) error {
+	return c.deleteThenPoll(ctx, "dnsresolver.VirtualNetworkLinksClient", id)
+}
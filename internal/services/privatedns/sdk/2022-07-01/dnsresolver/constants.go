@@ -0,0 +1,65 @@
+package dnsresolver
+
+type DnsResolverState string
+
+const (
+	DnsResolverStateConnected    DnsResolverState = "Connected"
+	DnsResolverStateDisconnected DnsResolverState = "Disconnected"
+)
+
+func PossibleValuesForDnsResolverState() []string {
+	return []string{
+		string(DnsResolverStateConnected),
+		string(DnsResolverStateDisconnected),
+	}
+}
+
+type ForwardingRuleState string
+
+const (
+	ForwardingRuleStateDisabled ForwardingRuleState = "Disabled"
+	ForwardingRuleStateEnabled  ForwardingRuleState = "Enabled"
+)
+
+func PossibleValuesForForwardingRuleState() []string {
+	return []string{
+		string(ForwardingRuleStateDisabled),
+		string(ForwardingRuleStateEnabled),
+	}
+}
+
+type IPAllocationMethod string
+
+const (
+	IPAllocationMethodDynamic IPAllocationMethod = "Dynamic"
+	IPAllocationMethodStatic  IPAllocationMethod = "Static"
+)
+
+func PossibleValuesForIPAllocationMethod() []string {
+	return []string{
+		string(IPAllocationMethodDynamic),
+		string(IPAllocationMethodStatic),
+	}
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
@@ -0,0 +1,111 @@
+package dnsresolver
+
+type SubResource struct {
+	Id string `json:"id"`
+}
+
+type DnsResolver struct {
+	Etag       *string               `json:"etag,omitempty"`
+	Id         *string               `json:"id,omitempty"`
+	Location   string                `json:"location"`
+	Name       *string               `json:"name,omitempty"`
+	Properties DnsResolverProperties `json:"properties"`
+	Tags       *map[string]string    `json:"tags,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
+
+type DnsResolverProperties struct {
+	DnsResolverState  *DnsResolverState  `json:"dnsResolverState,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	ResourceGuid      *string            `json:"resourceGuid,omitempty"`
+	VirtualNetwork    SubResource        `json:"virtualNetwork"`
+}
+
+type InboundEndpoint struct {
+	Etag       *string                   `json:"etag,omitempty"`
+	Id         *string                   `json:"id,omitempty"`
+	Location   string                    `json:"location"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties InboundEndpointProperties `json:"properties"`
+	Tags       *map[string]string        `json:"tags,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+type InboundEndpointProperties struct {
+	IPConfigurations  []IPConfiguration  `json:"ipConfigurations"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	ResourceGuid      *string            `json:"resourceGuid,omitempty"`
+}
+
+type IPConfiguration struct {
+	PrivateIPAddress          *string             `json:"privateIpAddress,omitempty"`
+	PrivateIPAllocationMethod *IPAllocationMethod `json:"privateIpAllocationMethod,omitempty"`
+	Subnet                    SubResource         `json:"subnet"`
+}
+
+type OutboundEndpoint struct {
+	Etag       *string                    `json:"etag,omitempty"`
+	Id         *string                    `json:"id,omitempty"`
+	Location   string                     `json:"location"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties OutboundEndpointProperties `json:"properties"`
+	Tags       *map[string]string         `json:"tags,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
+
+type OutboundEndpointProperties struct {
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	ResourceGuid      *string            `json:"resourceGuid,omitempty"`
+	Subnet            SubResource        `json:"subnet"`
+}
+
+type DnsForwardingRuleset struct {
+	Etag       *string                        `json:"etag,omitempty"`
+	Id         *string                        `json:"id,omitempty"`
+	Location   string                         `json:"location"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties DnsForwardingRulesetProperties `json:"properties"`
+	Tags       *map[string]string             `json:"tags,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
+
+type DnsForwardingRulesetProperties struct {
+	DnsResolverOutboundEndpoints []SubResource      `json:"dnsResolverOutboundEndpoints"`
+	ProvisioningState            *ProvisioningState `json:"provisioningState,omitempty"`
+	ResourceGuid                 *string            `json:"resourceGuid,omitempty"`
+}
+
+type ForwardingRule struct {
+	Etag       *string                  `json:"etag,omitempty"`
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties ForwardingRuleProperties `json:"properties"`
+	Type       *string                  `json:"type,omitempty"`
+}
+
+type ForwardingRuleProperties struct {
+	DomainName          string               `json:"domainName"`
+	ForwardingRuleState *ForwardingRuleState `json:"forwardingRuleState,omitempty"`
+	Metadata            *map[string]string   `json:"metadata,omitempty"`
+	ProvisioningState   *ProvisioningState   `json:"provisioningState,omitempty"`
+	TargetDnsServers    []TargetDnsServer    `json:"targetDnsServers"`
+}
+
+type TargetDnsServer struct {
+	IPAddress string `json:"ipAddress"`
+	Port      *int64 `json:"port,omitempty"`
+}
+
+type VirtualNetworkLink struct {
+	Etag       *string                      `json:"etag,omitempty"`
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties VirtualNetworkLinkProperties `json:"properties"`
+	Type       *string                      `json:"type,omitempty"`
+}
+
+type VirtualNetworkLinkProperties struct {
+	Metadata          *map[string]string `json:"metadata,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	VirtualNetwork    SubResource        `json:"virtualNetwork"`
+}
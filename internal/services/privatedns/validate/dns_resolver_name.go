@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DnsResolverName validates the name of a DNS Resolver, or one of its child resources (Inbound/Outbound Endpoints,
+// Forwarding Rulesets, Forwarding Rules and Virtual Network Links) - which share the same naming rules
+func DnsResolverName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_-]{0,78}[a-zA-Z0-9_])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 80 characters, begin with a letter or number, end with a letter, number or underscore and may contain only letters, numbers, underscores and hyphens", k))
+	}
+
+	return
+}
@@ -0,0 +1,68 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDnsResolverName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// single character
+			input:    "a",
+			expected: true,
+		},
+		{
+			input:    "example-resolver_1",
+			expected: true,
+		},
+		{
+			// ends with an underscore
+			input:    "example_",
+			expected: true,
+		},
+		{
+			// begins with a hyphen
+			input:    "-example",
+			expected: false,
+		},
+		{
+			// ends with a hyphen
+			input:    "example-",
+			expected: false,
+		},
+		{
+			// contains a period
+			input:    "example.resolver",
+			expected: false,
+		},
+		{
+			// 80 characters
+			input:    strings.Repeat("a", 80),
+			expected: true,
+		},
+		{
+			// 81 characters
+			input:    strings.Repeat("a", 81),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := DnsResolverName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
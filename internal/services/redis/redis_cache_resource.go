@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/parse"
@@ -423,11 +422,11 @@ func resourceRedisCacheCreate(d *pluginsdk.ResourceData, meta interface{}) error
 			return err
 		}
 
-		locks.ByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-		defer locks.UnlockByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
+		locks.ByID(networkParse.NewVirtualNetworkID(parsed.SubscriptionId, parsed.ResourceGroup, parsed.VirtualNetworkName).ID())
+		defer locks.UnlockByID(networkParse.NewVirtualNetworkID(parsed.SubscriptionId, parsed.ResourceGroup, parsed.VirtualNetworkName).ID())
 
-		locks.ByName(parsed.Name, network.SubnetResourceName)
-		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
+		locks.ByID(parsed.ID())
+		defer locks.UnlockByID(parsed.ID())
 
 		parameters.SubnetID = utils.String(v.(string))
 	}
@@ -705,11 +704,11 @@ func resourceRedisCacheDelete(d *pluginsdk.ResourceData, meta interface{}) error
 			return err
 		}
 
-		locks.ByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-		defer locks.UnlockByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
+		locks.ByID(networkParse.NewVirtualNetworkID(parsed.SubscriptionId, parsed.ResourceGroup, parsed.VirtualNetworkName).ID())
+		defer locks.UnlockByID(networkParse.NewVirtualNetworkID(parsed.SubscriptionId, parsed.ResourceGroup, parsed.VirtualNetworkName).ID())
 
-		locks.ByName(parsed.Name, network.SubnetResourceName)
-		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
+		locks.ByID(parsed.ID())
+		defer locks.UnlockByID(parsed.ID())
 	}
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.RediName)
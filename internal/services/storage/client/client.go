@@ -11,6 +11,8 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	az "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2019-12-12/table/batches"
+	recursivepaths "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2020-02-10/datalakestore/paths"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/accounts"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
@@ -26,21 +28,22 @@ import (
 )
 
 type Client struct {
-	AccountsClient              *storage.AccountsClient
-	FileSystemsClient           *filesystems.Client
-	ADLSGen2PathsClient         *paths.Client
-	ManagementPoliciesClient    *storage.ManagementPoliciesClient
-	BlobServicesClient          *storage.BlobServicesClient
-	BlobInventoryPoliciesClient *legacystorage.BlobInventoryPoliciesClient
-	CloudEndpointsClient        *storagesync.CloudEndpointsClient
-	DisksPoolsClient            *storagepool.DiskPoolsClient
-	EncryptionScopesClient      *storage.EncryptionScopesClient
-	Environment                 az.Environment
-	FileServicesClient          *storage.FileServicesClient
-	ObjectReplicationClient     *storage.ObjectReplicationPoliciesClient
-	SyncServiceClient           *storagesync.ServicesClient
-	SyncGroupsClient            *storagesync.SyncGroupsClient
-	SubscriptionId              string
+	AccountsClient               *storage.AccountsClient
+	FileSystemsClient            *filesystems.Client
+	ADLSGen2PathsClient          *paths.Client
+	ADLSGen2PathsRecursiveClient *recursivepaths.Client
+	ManagementPoliciesClient     *storage.ManagementPoliciesClient
+	BlobServicesClient           *storage.BlobServicesClient
+	BlobInventoryPoliciesClient  *legacystorage.BlobInventoryPoliciesClient
+	CloudEndpointsClient         *storagesync.CloudEndpointsClient
+	DisksPoolsClient             *storagepool.DiskPoolsClient
+	EncryptionScopesClient       *storage.EncryptionScopesClient
+	Environment                  az.Environment
+	FileServicesClient           *storage.FileServicesClient
+	ObjectReplicationClient      *storage.ObjectReplicationPoliciesClient
+	SyncServiceClient            *storagesync.ServicesClient
+	SyncGroupsClient             *storagesync.SyncGroupsClient
+	SubscriptionId               string
 
 	resourceManagerAuthorizer autorest.Authorizer
 	storageAdAuth             *autorest.Authorizer
@@ -56,6 +59,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	adlsGen2PathsClient := paths.NewWithEnvironment(options.Environment)
 	options.ConfigureClient(&adlsGen2PathsClient.Client, options.StorageAuthorizer)
 
+	adlsGen2PathsRecursiveClient := recursivepaths.NewWithEnvironment(options.Environment)
+	options.ConfigureClient(&adlsGen2PathsRecursiveClient.Client, options.StorageAuthorizer)
+
 	managementPoliciesClient := storage.NewManagementPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&managementPoliciesClient.Client, options.ResourceManagerAuthorizer)
 
@@ -89,21 +95,22 @@ func NewClient(options *common.ClientOptions) *Client {
 	// TODO: switch Storage Containers to using the storage.BlobContainersClient
 	// (which should fix #2977) when the storage clients have been moved in here
 	client := Client{
-		AccountsClient:              &accountsClient,
-		FileSystemsClient:           &fileSystemsClient,
-		ADLSGen2PathsClient:         &adlsGen2PathsClient,
-		ManagementPoliciesClient:    &managementPoliciesClient,
-		BlobServicesClient:          &blobServicesClient,
-		BlobInventoryPoliciesClient: &blobInventoryPoliciesClient,
-		CloudEndpointsClient:        &cloudEndpointsClient,
-		DisksPoolsClient:            &disksPoolsClient,
-		EncryptionScopesClient:      &encryptionScopesClient,
-		Environment:                 options.Environment,
-		FileServicesClient:          &fileServicesClient,
-		ObjectReplicationClient:     &objectReplicationPolicyClient,
-		SubscriptionId:              options.SubscriptionId,
-		SyncServiceClient:           &syncServiceClient,
-		SyncGroupsClient:            &syncGroupsClient,
+		AccountsClient:               &accountsClient,
+		FileSystemsClient:            &fileSystemsClient,
+		ADLSGen2PathsClient:          &adlsGen2PathsClient,
+		ADLSGen2PathsRecursiveClient: &adlsGen2PathsRecursiveClient,
+		ManagementPoliciesClient:     &managementPoliciesClient,
+		BlobServicesClient:           &blobServicesClient,
+		BlobInventoryPoliciesClient:  &blobInventoryPoliciesClient,
+		CloudEndpointsClient:         &cloudEndpointsClient,
+		DisksPoolsClient:             &disksPoolsClient,
+		EncryptionScopesClient:       &encryptionScopesClient,
+		Environment:                  options.Environment,
+		FileServicesClient:           &fileServicesClient,
+		ObjectReplicationClient:      &objectReplicationPolicyClient,
+		SubscriptionId:               options.SubscriptionId,
+		SyncServiceClient:            &syncServiceClient,
+		SyncGroupsClient:             &syncGroupsClient,
 
 		resourceManagerAuthorizer: options.ResourceManagerAuthorizer,
 	}
@@ -279,6 +286,24 @@ func (client Client) TableEntityClient(ctx context.Context, account accountDetai
 	return &entitiesClient, nil
 }
 
+func (client Client) TableEntityBatchesClient(ctx context.Context, account accountDetails) (*batches.Client, error) {
+	// NOTE: Table Entity does not support AzureAD Authentication
+
+	accountKey, err := account.AccountKey(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account Key: %s", err)
+	}
+
+	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKeyLiteForTable)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorizer: %+v", err)
+	}
+
+	batchesClient := batches.NewWithEnvironment(client.Environment)
+	batchesClient.Client.Authorizer = storageAuth
+	return &batchesClient, nil
+}
+
 func (client Client) TablesClient(ctx context.Context, account accountDetails) (shim.StorageTableWrapper, error) {
 	// NOTE: Tables do not support AzureAD Authentication
 
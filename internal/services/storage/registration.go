@@ -48,14 +48,17 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_storage_encryption_scope":             resourceStorageEncryptionScope(),
 		"azurerm_storage_data_lake_gen2_filesystem":    resourceStorageDataLakeGen2FileSystem(),
 		"azurerm_storage_data_lake_gen2_path":          resourceStorageDataLakeGen2Path(),
+		"azurerm_storage_data_lake_gen2_path_acl":      resourceStorageDataLakeGen2PathAcl(),
 		"azurerm_storage_management_policy":            resourceStorageManagementPolicy(),
 		"azurerm_storage_object_replication":           resourceStorageObjectReplication(),
 		"azurerm_storage_queue":                        resourceStorageQueue(),
 		"azurerm_storage_share":                        resourceStorageShare(),
 		"azurerm_storage_share_file":                   resourceStorageShareFile(),
 		"azurerm_storage_share_directory":              resourceStorageShareDirectory(),
+		"azurerm_storage_share_directory_sync":         resourceStorageShareDirectorySync(),
 		"azurerm_storage_table":                        resourceStorageTable(),
 		"azurerm_storage_table_entity":                 resourceStorageTableEntity(),
+		"azurerm_storage_table_entities":               resourceStorageTableEntities(),
 		"azurerm_storage_sync":                         resourceStorageSync(),
 		"azurerm_storage_sync_cloud_endpoint":          resourceStorageSyncCloudEndpoint(),
 		"azurerm_storage_sync_group":                   resourceStorageSyncGroup(),
@@ -0,0 +1,27 @@
+package batches
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Client is the base client for Table Storage Entity Group Transactions (Batches)
+type Client struct {
+	autorest.Client
+	BaseURI string
+}
+
+// NewWithEnvironment creates an instance of the Table Storage Batches client.
+func NewWithEnvironment(environment azure.Environment) Client {
+	return Client{
+		Client:  autorest.NewClientWithUserAgent(UserAgent()),
+		BaseURI: environment.StorageEndpointSuffix,
+	}
+}
+
+// tableEndpoint returns the endpoint for Table API Operations on this storage account
+func tableEndpoint(baseUri string, accountName string) string {
+	return fmt.Sprintf("https://%s.table.%s", accountName, baseUri)
+}
@@ -0,0 +1,249 @@
+package batches
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"github.com/google/uuid"
+)
+
+// MaxOperationsPerBatch is the maximum number of operations which can be submitted in a single Entity Group Transaction
+const MaxOperationsPerBatch = 100
+
+type OperationType string
+
+const (
+	OperationTypeDelete          OperationType = "Delete"
+	OperationTypeInsertOrReplace OperationType = "InsertOrReplace"
+)
+
+type Operation struct {
+	Type         OperationType
+	PartitionKey string
+	RowKey       string
+
+	// Entity is the set of properties for the Entity, this is ignored for Delete operations
+	Entity map[string]interface{}
+}
+
+type ExecuteInput struct {
+	// Operations are the operations to perform as a single transaction - these must share a Partition Key
+	Operations []Operation
+}
+
+// Execute submits the operations as a single Entity Group Transaction, which either succeeds or fails as a whole
+func (client Client) Execute(ctx context.Context, accountName, tableName string, input ExecuteInput) (result autorest.Response, err error) {
+	if accountName == "" {
+		return result, validation.NewError("batches.Client", "Execute", "`accountName` cannot be an empty string.")
+	}
+	if tableName == "" {
+		return result, validation.NewError("batches.Client", "Execute", "`tableName` cannot be an empty string.")
+	}
+	if len(input.Operations) == 0 {
+		return result, validation.NewError("batches.Client", "Execute", "`input.Operations` cannot be empty.")
+	}
+	if len(input.Operations) > MaxOperationsPerBatch {
+		return result, validation.NewError("batches.Client", "Execute", fmt.Sprintf("`input.Operations` cannot contain more than %d operations.", MaxOperationsPerBatch))
+	}
+	for _, v := range input.Operations {
+		if v.PartitionKey != input.Operations[0].PartitionKey {
+			return result, validation.NewError("batches.Client", "Execute", "all of the `input.Operations` must share the same Partition Key.")
+		}
+	}
+
+	req, err := client.ExecutePreparer(ctx, accountName, tableName, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batches.Client", "Execute", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.ExecuteSender(req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "batches.Client", "Execute", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.ExecuteResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batches.Client", "Execute", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// ExecutePreparer prepares the Execute request.
+func (client Client) ExecutePreparer(ctx context.Context, accountName, tableName string, input ExecuteInput) (*http.Request, error) {
+	batchBoundary := fmt.Sprintf("batch_%s", uuid.New().String())
+	changeSetBoundary := fmt.Sprintf("changeset_%s", uuid.New().String())
+
+	body, err := buildBatchBody(tableEndpoint(client.BaseURI, accountName), tableName, batchBoundary, changeSetBoundary, input.Operations)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version":          APIVersion,
+		"Accept":                "application/json;odata=minimalmetadata",
+		"DataServiceVersion":    "3.0;",
+		"MaxDataServiceVersion": "3.0;NetFx",
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType(fmt.Sprintf("multipart/mixed; boundary=%s", batchBoundary)),
+		autorest.AsPost(),
+		autorest.WithBaseURL(tableEndpoint(client.BaseURI, accountName)),
+		autorest.WithPath("/$batch"),
+		autorest.WithBytes(&body),
+		autorest.WithHeaders(headers))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// ExecuteSender sends the Execute request. The method will close the
+// http.Response Body if it receives an error.
+func (client Client) ExecuteSender(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req,
+		azure.DoRetryWithRegistration(client.Client))
+}
+
+// ExecuteResponder handles the response to the Execute request. The method always
+// closes the http.Response Body.
+func (client Client) ExecuteResponder(resp *http.Response) (result autorest.Response, err error) {
+	result = autorest.Response{Response: resp}
+
+	var body []byte
+	if resp != nil && resp.Body != nil {
+		body, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return result, fmt.Errorf("reading response body: %+v", err)
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted),
+		autorest.ByClosing())
+	if err != nil {
+		return result, err
+	}
+
+	// the batch itself is accepted even when the changeset fails, so the individual responses need checking
+	return result, parseBatchResponse(string(body))
+}
+
+func buildBatchBody(endpoint, tableName, batchBoundary, changeSetBoundary string, operations []Operation) ([]byte, error) {
+	buf := bytes.Buffer{}
+	fmt.Fprintf(&buf, "--%s\r\n", batchBoundary)
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%s\r\n\r\n", changeSetBoundary)
+
+	for i, operation := range operations {
+		uri := fmt.Sprintf("%s/%s(PartitionKey='%s',RowKey='%s')", endpoint, tableName, escapeKey(operation.PartitionKey), escapeKey(operation.RowKey))
+
+		fmt.Fprintf(&buf, "--%s\r\n", changeSetBoundary)
+		buf.WriteString("Content-Type: application/http\r\n")
+		buf.WriteString("Content-Transfer-Encoding: binary\r\n\r\n")
+
+		switch operation.Type {
+		case OperationTypeDelete:
+			fmt.Fprintf(&buf, "DELETE %s HTTP/1.1\r\n", uri)
+			buf.WriteString("Accept: application/json;odata=minimalmetadata\r\n")
+			buf.WriteString("DataServiceVersion: 3.0;\r\n")
+			buf.WriteString("If-Match: *\r\n")
+			fmt.Fprintf(&buf, "Content-ID: %d\r\n\r\n", i+1)
+
+		case OperationTypeInsertOrReplace:
+			entity := make(map[string]interface{}, len(operation.Entity)+2)
+			for k, v := range operation.Entity {
+				entity[k] = v
+			}
+			entity["PartitionKey"] = operation.PartitionKey
+			entity["RowKey"] = operation.RowKey
+
+			payload, err := json.Marshal(entity)
+			if err != nil {
+				return nil, fmt.Errorf("serializing Entity (Partition Key %q / Row Key %q): %+v", operation.PartitionKey, operation.RowKey, err)
+			}
+
+			fmt.Fprintf(&buf, "PUT %s HTTP/1.1\r\n", uri)
+			buf.WriteString("Accept: application/json;odata=minimalmetadata\r\n")
+			buf.WriteString("Content-Type: application/json\r\n")
+			buf.WriteString("Prefer: return-no-content\r\n")
+			buf.WriteString("DataServiceVersion: 3.0;\r\n")
+			fmt.Fprintf(&buf, "Content-ID: %d\r\n\r\n", i+1)
+			buf.Write(payload)
+			buf.WriteString("\r\n")
+
+		default:
+			return nil, fmt.Errorf("unsupported Operation Type %q", string(operation.Type))
+		}
+	}
+
+	fmt.Fprintf(&buf, "--%s--\r\n", changeSetBoundary)
+	fmt.Fprintf(&buf, "--%s--\r\n", batchBoundary)
+
+	return buf.Bytes(), nil
+}
+
+// escapeKey escapes a Partition/Row Key for use within a URI, single quotes are doubled per the OData spec
+func escapeKey(input string) string {
+	return url.PathEscape(strings.ReplaceAll(input, "'", "''"))
+}
+
+var batchResponseStatusRegex = regexp.MustCompile(`(?m)^HTTP/1\.1 (\d{3}) ([^\r\n]*)`)
+
+type batchErrorResponse struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message struct {
+			Value string `json:"value"`
+		} `json:"message"`
+	} `json:"odata.error"`
+}
+
+// parseBatchResponse returns an error describing the first failed operation within the batch response, if any
+func parseBatchResponse(body string) error {
+	matches := batchResponseStatusRegex.FindAllStringSubmatchIndex(body, -1)
+	for _, match := range matches {
+		statusCode := body[match[2]:match[3]]
+		if strings.HasPrefix(statusCode, "2") {
+			continue
+		}
+
+		status := body[match[2]:match[5]]
+		message := ""
+
+		// the error payload (if any) is the JSON document following the status line and headers
+		remaining := body[match[1]:]
+		if start := strings.Index(remaining, "{"); start >= 0 {
+			decoder := json.NewDecoder(strings.NewReader(remaining[start:]))
+			var payload batchErrorResponse
+			if err := decoder.Decode(&payload); err == nil {
+				message = payload.Error.Message.Value
+				if payload.Error.Code != "" {
+					message = fmt.Sprintf("%s: %s", payload.Error.Code, message)
+				}
+			}
+		}
+
+		if message == "" {
+			return fmt.Errorf("the batch failed with status %q", status)
+		}
+
+		// the message is prefixed with the (zero-based) index of the operation which failed
+		return fmt.Errorf("the batch failed with status %q: %s", status, strings.ReplaceAll(message, "\n", " "))
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package batches
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildBatchBody(t *testing.T) {
+	operations := []Operation{
+		{
+			Type:         OperationTypeInsertOrReplace,
+			PartitionKey: "pk",
+			RowKey:       "it's",
+			Entity: map[string]interface{}{
+				"Name": "example",
+			},
+		},
+		{
+			Type:         OperationTypeDelete,
+			PartitionKey: "pk",
+			RowKey:       "2",
+		},
+	}
+
+	body, err := buildBatchBody("https://account.table.core.windows.net", "table1", "batch_a", "changeset_b", operations)
+	if err != nil {
+		t.Fatalf("building batch body: %+v", err)
+	}
+
+	expected := strings.Join([]string{
+		"--batch_a",
+		"Content-Type: multipart/mixed; boundary=changeset_b",
+		"",
+		"--changeset_b",
+		"Content-Type: application/http",
+		"Content-Transfer-Encoding: binary",
+		"",
+		"PUT https://account.table.core.windows.net/table1(PartitionKey='pk',RowKey='it%27%27s') HTTP/1.1",
+		"Accept: application/json;odata=minimalmetadata",
+		"Content-Type: application/json",
+		"Prefer: return-no-content",
+		"DataServiceVersion: 3.0;",
+		"Content-ID: 1",
+		"",
+		`{"Name":"example","PartitionKey":"pk","RowKey":"it's"}`,
+		"--changeset_b",
+		"Content-Type: application/http",
+		"Content-Transfer-Encoding: binary",
+		"",
+		"DELETE https://account.table.core.windows.net/table1(PartitionKey='pk',RowKey='2') HTTP/1.1",
+		"Accept: application/json;odata=minimalmetadata",
+		"DataServiceVersion: 3.0;",
+		"If-Match: *",
+		"Content-ID: 2",
+		"",
+		"--changeset_b--",
+		"--batch_a--",
+		"",
+	}, "\r\n")
+
+	if string(body) != expected {
+		t.Fatalf("expected:\n%s\n\ngot:\n%s", expected, string(body))
+	}
+}
+
+func TestBuildBatchBodyUnsupportedOperation(t *testing.T) {
+	operations := []Operation{
+		{
+			Type:         OperationType("Merge"),
+			PartitionKey: "pk",
+			RowKey:       "1",
+		},
+	}
+
+	if _, err := buildBatchBody("https://account.table.core.windows.net", "table1", "batch_a", "changeset_b", operations); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestParseBatchResponse(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name: "All Succeeded",
+			Input: strings.Join([]string{
+				"--batchresponse_1",
+				"Content-Type: multipart/mixed; boundary=changesetresponse_2",
+				"",
+				"--changesetresponse_2",
+				"Content-Type: application/http",
+				"Content-Transfer-Encoding: binary",
+				"",
+				"HTTP/1.1 204 No Content",
+				"Content-ID: 1",
+				"",
+				"--changesetresponse_2",
+				"Content-Type: application/http",
+				"Content-Transfer-Encoding: binary",
+				"",
+				"HTTP/1.1 204 No Content",
+				"Content-ID: 2",
+				"",
+				"--changesetresponse_2--",
+				"--batchresponse_1--",
+			}, "\r\n"),
+		},
+		{
+			Name: "Failed With Message",
+			Input: strings.Join([]string{
+				"--batchresponse_1",
+				"Content-Type: multipart/mixed; boundary=changesetresponse_2",
+				"",
+				"--changesetresponse_2",
+				"Content-Type: application/http",
+				"Content-Transfer-Encoding: binary",
+				"",
+				"HTTP/1.1 404 Not Found",
+				"Content-ID: 2",
+				"Content-Type: application/json;odata=minimalmetadata;streaming=true;charset=utf-8",
+				"",
+				`{"odata.error":{"code":"ResourceNotFound","message":{"lang":"en-US","value":"1:The specified resource does not exist.\nRequestId:abc"}}}`,
+				"--changesetresponse_2--",
+				"--batchresponse_1--",
+			}, "\r\n"),
+			Expected: `the batch failed with status "404 Not Found": ResourceNotFound: 1:The specified resource does not exist. RequestId:abc`,
+		},
+		{
+			Name: "Failed Without Message",
+			Input: strings.Join([]string{
+				"--batchresponse_1",
+				"HTTP/1.1 400 Bad Request",
+				"",
+				"--batchresponse_1--",
+			}, "\r\n"),
+			Expected: `the batch failed with status "400 Bad Request"`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := parseBatchResponse(v.Input)
+		if v.Expected == "" {
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if err.Error() != v.Expected {
+			t.Fatalf("expected error %q but got %q", v.Expected, err.Error())
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package batches
+
+import "fmt"
+
+// APIVersion is the version of the API used for all Storage API Operations
+const APIVersion = "2019-12-12"
+
+func UserAgent() string {
+	return fmt.Sprintf("terraform-provider-azurerm storage/%s", APIVersion)
+}
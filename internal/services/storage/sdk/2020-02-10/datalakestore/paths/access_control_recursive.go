@@ -0,0 +1,156 @@
+package paths
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/validation"
+)
+
+type SetAccessControlRecursiveMode string
+
+const (
+	SetAccessControlRecursiveModeModify SetAccessControlRecursiveMode = "modify"
+	SetAccessControlRecursiveModeRemove SetAccessControlRecursiveMode = "remove"
+	SetAccessControlRecursiveModeSet    SetAccessControlRecursiveMode = "set"
+)
+
+type SetAccessControlRecursiveInput struct {
+	// Required - the mode used to apply the ACL to the Path and everything beneath it
+	Mode SetAccessControlRecursiveMode
+
+	// Required - the POSIX access control list to apply
+	ACL string
+
+	// Optional - the continuation token returned by a previous request, used to resume the operation
+	Continuation *string
+
+	// Optional - the maximum number of files or directories on which the ACL is applied in a single request
+	MaxRecords *int
+
+	// Optional - when true the operation continues past failures on individual entries rather than stopping
+	ForceFlag *bool
+}
+
+type SetAccessControlRecursiveFailedEntry struct {
+	ErrorMessage string `json:"errorMessage"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+}
+
+type SetAccessControlRecursiveResponse struct {
+	autorest.Response
+
+	// Continuation is the token to pass into the next request, this is empty once the operation has completed
+	Continuation string `json:"-"`
+
+	DirectoriesSuccessful int                                    `json:"directoriesSuccessful"`
+	FilesSuccessful       int                                    `json:"filesSuccessful"`
+	FailureCount          int                                    `json:"failureCount"`
+	FailedEntries         []SetAccessControlRecursiveFailedEntry `json:"failedEntries"`
+}
+
+// SetAccessControlRecursive applies the access control list to a Data Lake Store Gen2 Path and all of its children
+// within a Storage Account File System. Only a single batch is processed per request - callers should repeat
+// the request with the returned Continuation token until it's empty.
+func (client Client) SetAccessControlRecursive(ctx context.Context, accountName string, fileSystemName string, path string, input SetAccessControlRecursiveInput) (result SetAccessControlRecursiveResponse, err error) {
+	if accountName == "" {
+		return result, validation.NewError("paths.Client", "SetAccessControlRecursive", "`accountName` cannot be an empty string.")
+	}
+	if fileSystemName == "" {
+		return result, validation.NewError("paths.Client", "SetAccessControlRecursive", "`fileSystemName` cannot be an empty string.")
+	}
+	if input.Mode == "" {
+		return result, validation.NewError("paths.Client", "SetAccessControlRecursive", "`input.Mode` cannot be an empty string.")
+	}
+	if input.ACL == "" {
+		return result, validation.NewError("paths.Client", "SetAccessControlRecursive", "`input.ACL` cannot be an empty string.")
+	}
+	if input.MaxRecords != nil && *input.MaxRecords < 1 {
+		return result, validation.NewError("paths.Client", "SetAccessControlRecursive", "`input.MaxRecords` must be at least 1.")
+	}
+
+	req, err := client.SetAccessControlRecursivePreparer(ctx, accountName, fileSystemName, path, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "paths.Client", "SetAccessControlRecursive", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.SetAccessControlRecursiveSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "paths.Client", "SetAccessControlRecursive", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.SetAccessControlRecursiveResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "paths.Client", "SetAccessControlRecursive", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// SetAccessControlRecursivePreparer prepares the SetAccessControlRecursive request.
+func (client Client) SetAccessControlRecursivePreparer(ctx context.Context, accountName string, fileSystemName string, path string, input SetAccessControlRecursiveInput) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"fileSystemName": autorest.Encode("path", fileSystemName),
+		"path":           autorest.Encode("path", path),
+	}
+
+	queryParameters := map[string]interface{}{
+		"action": autorest.Encode("query", "setAccessControlRecursive"),
+		"mode":   autorest.Encode("query", string(input.Mode)),
+	}
+	if input.Continuation != nil && *input.Continuation != "" {
+		queryParameters["continuation"] = autorest.Encode("query", *input.Continuation)
+	}
+	if input.MaxRecords != nil {
+		queryParameters["maxRecords"] = autorest.Encode("query", strconv.Itoa(*input.MaxRecords))
+	}
+	if input.ForceFlag != nil {
+		queryParameters["forceFlag"] = autorest.Encode("query", strconv.FormatBool(*input.ForceFlag))
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version": APIVersion,
+		"x-ms-acl":     input.ACL,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPatch(),
+		autorest.WithBaseURL(dataLakeStoreEndpoint(client.BaseURI, accountName)),
+		autorest.WithPathParameters("/{fileSystemName}/{path}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// SetAccessControlRecursiveSender sends the SetAccessControlRecursive request. The method will close the
+// http.Response Body if it receives an error.
+func (client Client) SetAccessControlRecursiveSender(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req,
+		azure.DoRetryWithRegistration(client.Client))
+}
+
+// SetAccessControlRecursiveResponder handles the response to the SetAccessControlRecursive request. The method always
+// closes the http.Response Body.
+func (client Client) SetAccessControlRecursiveResponder(resp *http.Response) (result SetAccessControlRecursiveResponse, err error) {
+	if resp != nil && resp.Header != nil {
+		result.Continuation = resp.Header.Get("x-ms-continuation")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+
+	return
+}
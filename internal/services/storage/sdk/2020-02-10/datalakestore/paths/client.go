@@ -0,0 +1,27 @@
+package paths
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Client is the base client for the Data Lake Storage Path operations which aren't available in giovanni
+type Client struct {
+	autorest.Client
+	BaseURI string
+}
+
+// NewWithEnvironment creates an instance of the Data Lake Storage Path client.
+func NewWithEnvironment(environment azure.Environment) Client {
+	return Client{
+		Client:  autorest.NewClientWithUserAgent(UserAgent()),
+		BaseURI: environment.StorageEndpointSuffix,
+	}
+}
+
+// dataLakeStoreEndpoint returns the endpoint for Data Lake Store API Operations on this storage account
+func dataLakeStoreEndpoint(baseUri string, accountName string) string {
+	return fmt.Sprintf("https://%s.dfs.%s", accountName, baseUri)
+}
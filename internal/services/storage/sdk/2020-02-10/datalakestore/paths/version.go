@@ -0,0 +1,11 @@
+package paths
+
+import "fmt"
+
+// APIVersion is the version of the API used for all Storage API Operations
+// NOTE: `setAccessControlRecursive` is only available from API Version 2020-02-10 onwards
+const APIVersion = "2020-02-10"
+
+func UserAgent() string {
+	return fmt.Sprintf("terraform-provider-azurerm storage/%s", APIVersion)
+}
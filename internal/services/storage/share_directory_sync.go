@@ -0,0 +1,279 @@
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/directories"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/files"
+)
+
+// ShareDirectorySourceFile is a file within the local source directory which should be uploaded to the Share
+type ShareDirectorySourceFile struct {
+	// RelativePath is the path of the file relative to the source directory, using `/` as the separator
+	RelativePath string
+	LocalPath    string
+
+	// ContentMD5 is the hex-encoded MD5 of the file contents, to match `content_md5` on `azurerm_storage_blob`
+	ContentMD5 string
+	Size       int64
+}
+
+// ListShareDirectorySourceFiles walks the local source directory and returns the files within it, keyed by their relative path
+func ListShareDirectorySourceFiles(source string) (map[string]ShareDirectorySourceFile, error) {
+	output := make(map[string]ShareDirectorySourceFile)
+
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("'stat'-ing source directory %q: %+v", source, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("source %q must be a directory", source)
+	}
+
+	err = filepath.WalkDir(source, func(localPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !entry.Type().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(source, localPath)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+
+		contentMD5, size, err := shareDirectorySourceFileMD5(localPath)
+		if err != nil {
+			return fmt.Errorf("hashing %q: %+v", localPath, err)
+		}
+
+		output[relativePath] = ShareDirectorySourceFile{
+			RelativePath: relativePath,
+			LocalPath:    localPath,
+			ContentMD5:   contentMD5,
+			Size:         size,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking source directory %q: %+v", source, err)
+	}
+
+	return output, nil
+}
+
+func shareDirectorySourceFileMD5(localPath string) (string, int64, error) {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return "", 0, err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+type ShareDirectorySync struct {
+	DirectoriesClient *directories.Client
+	FilesClient       *files.Client
+
+	AccountName string
+	ShareName   string
+	// Path is the directory within the Share which the source directory is synced into, this can be empty for the root
+	Path        string
+	Parallelism int
+}
+
+// EnsureDirectories creates the Path and any directories required for the specified files, parents first
+func (sds ShareDirectorySync) EnsureDirectories(ctx context.Context, sourceFiles []ShareDirectorySourceFile) error {
+	required := make(map[string]struct{})
+	if sds.Path != "" {
+		addShareDirectoryWithParents(required, sds.Path)
+	}
+	for _, file := range sourceFiles {
+		if dir := path.Dir(sds.sharePath(file.RelativePath)); dir != "." {
+			addShareDirectoryWithParents(required, dir)
+		}
+	}
+
+	// sorting lexically guarantees that a parent is created before its children
+	dirs := make([]string, 0, len(required))
+	for dir := range required {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	for _, dir := range dirs {
+		input := directories.CreateDirectoryInput{
+			MetaData: map[string]string{},
+		}
+		resp, err := sds.DirectoriesClient.Create(ctx, sds.AccountName, sds.ShareName, dir, input)
+		if err != nil {
+			if utils.ResponseWasConflict(resp) {
+				continue
+			}
+
+			return fmt.Errorf("creating Directory %q (File Share %q / Account %q): %+v", dir, sds.ShareName, sds.AccountName, err)
+		}
+	}
+
+	return nil
+}
+
+// Upload uploads the specified files in parallel, returning the errors for all of the files which failed
+func (sds ShareDirectorySync) Upload(ctx context.Context, sourceFiles []ShareDirectorySourceFile) error {
+	if len(sourceFiles) == 0 {
+		return nil
+	}
+
+	queue := make(chan ShareDirectorySourceFile, len(sourceFiles))
+	for _, file := range sourceFiles {
+		queue <- file
+	}
+	close(queue)
+
+	workerCount := sds.Parallelism
+	if workerCount > len(sourceFiles) {
+		workerCount = len(sourceFiles)
+	}
+
+	var errors *multierror.Error
+	errorsLock := sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	wg.Add(workerCount)
+	for i := 0; i < workerCount; i++ {
+		go func() {
+			defer wg.Done()
+			for file := range queue {
+				if err := sds.uploadFile(ctx, file); err != nil {
+					errorsLock.Lock()
+					errors = multierror.Append(errors, err)
+					errorsLock.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.ErrorOrNil()
+}
+
+func (sds ShareDirectorySync) uploadFile(ctx context.Context, sourceFile ShareDirectorySourceFile) error {
+	directoryName, fileName := sds.splitSharePath(sourceFile.RelativePath)
+	log.Printf("[DEBUG] Uploading %q to File %q in Directory %q (File Share %q / Account %q)..", sourceFile.LocalPath, fileName, directoryName, sds.ShareName, sds.AccountName)
+
+	contentMD5, err := convertHexToBase64Encoding(sourceFile.ContentMD5)
+	if err != nil {
+		return err
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(fileName))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	input := files.CreateInput{
+		ContentLength: sourceFile.Size,
+		ContentMD5:    utils.String(contentMD5),
+		ContentType:   utils.String(contentType),
+		MetaData:      map[string]string{},
+	}
+	if _, err := sds.FilesClient.Create(ctx, sds.AccountName, sds.ShareName, directoryName, fileName, input); err != nil {
+		return fmt.Errorf("creating File %q in Directory %q (File Share %q / Account %q): %+v", fileName, directoryName, sds.ShareName, sds.AccountName, err)
+	}
+
+	// an empty file has no ranges to upload
+	if sourceFile.Size == 0 {
+		return nil
+	}
+
+	file, err := os.Open(sourceFile.LocalPath)
+	if err != nil {
+		return fmt.Errorf("opening %q: %+v", sourceFile.LocalPath, err)
+	}
+	defer file.Close()
+
+	// the files themselves are uploaded in parallel, so each file is uploaded a chunk at a time
+	if err := sds.FilesClient.PutFile(ctx, sds.AccountName, sds.ShareName, directoryName, fileName, file, 1); err != nil {
+		return fmt.Errorf("uploading File %q in Directory %q (File Share %q / Account %q): %+v", fileName, directoryName, sds.ShareName, sds.AccountName, err)
+	}
+
+	return nil
+}
+
+// Delete removes the specified files (using their path relative to the source directory) and any directories
+// which are empty as a result
+func (sds ShareDirectorySync) Delete(ctx context.Context, relativePaths []string) error {
+	parents := make(map[string]struct{})
+	for _, relativePath := range relativePaths {
+		directoryName, fileName := sds.splitSharePath(relativePath)
+		resp, err := sds.FilesClient.Delete(ctx, sds.AccountName, sds.ShareName, directoryName, fileName)
+		if err != nil && !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting File %q in Directory %q (File Share %q / Account %q): %+v", fileName, directoryName, sds.ShareName, sds.AccountName, err)
+		}
+
+		for dir := path.Dir(relativePath); dir != "."; dir = path.Dir(dir) {
+			parents[dir] = struct{}{}
+		}
+	}
+
+	// deleting the deepest directories first means that parents can be removed once their children have been
+	// the Path itself isn't removed, since it may be managed separately (e.g. by `azurerm_storage_share_directory`)
+	dirs := make([]string, 0, len(parents))
+	for dir := range parents {
+		dirs = append(dirs, dir)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(dirs)))
+
+	for _, dir := range dirs {
+		shareDir := sds.sharePath(dir)
+		if _, err := sds.DirectoriesClient.Delete(ctx, sds.AccountName, sds.ShareName, shareDir); err != nil {
+			// the directory can contain files which aren't managed by Terraform, in which case it's left as-is
+			log.Printf("[DEBUG] Unable to remove Directory %q (File Share %q / Account %q) - it may not be empty: %+v", shareDir, sds.ShareName, sds.AccountName, err)
+		}
+	}
+
+	return nil
+}
+
+// sharePath returns the path within the Share for a path relative to the source directory
+func (sds ShareDirectorySync) sharePath(relativePath string) string {
+	if sds.Path == "" {
+		return relativePath
+	}
+	return path.Join(sds.Path, relativePath)
+}
+
+// splitSharePath returns the directory and file name within the Share for a path relative to the source directory
+func (sds ShareDirectorySync) splitSharePath(relativePath string) (string, string) {
+	directoryName, fileName := path.Split(sds.sharePath(relativePath))
+	return strings.TrimSuffix(directoryName, "/"), fileName
+}
+
+func addShareDirectoryWithParents(input map[string]struct{}, dir string) {
+	for ; dir != "." && dir != "/" && dir != ""; dir = path.Dir(dir) {
+		input[dir] = struct{}{}
+	}
+}
@@ -0,0 +1,190 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestListShareDirectorySourceFiles(t *testing.T) {
+	source := t.TempDir()
+
+	files := map[string]string{
+		"root.txt":           "hello",
+		"nested/one.txt":     "one",
+		"nested/deep/two.md": "two",
+		"blank.txt":          "",
+	}
+	for name, content := range files {
+		localPath := filepath.Join(source, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(localPath), 0o755); err != nil {
+			t.Fatalf("creating directory for %q: %+v", name, err)
+		}
+		if err := os.WriteFile(localPath, []byte(content), 0o644); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(source, "empty", "child"), 0o755); err != nil {
+		t.Fatalf("creating empty directory: %+v", err)
+	}
+
+	actual, err := ListShareDirectorySourceFiles(source)
+	if err != nil {
+		t.Fatalf("listing source files: %+v", err)
+	}
+
+	if len(actual) != len(files) {
+		t.Fatalf("expected %d files but got %d: %+v", len(files), len(actual), actual)
+	}
+	for name, content := range files {
+		file, ok := actual[name]
+		if !ok {
+			t.Fatalf("expected %q to be listed", name)
+		}
+		if file.RelativePath != name {
+			t.Fatalf("expected RelativePath to be %q but got %q", name, file.RelativePath)
+		}
+		if file.LocalPath != filepath.Join(source, filepath.FromSlash(name)) {
+			t.Fatalf("unexpected LocalPath %q for %q", file.LocalPath, name)
+		}
+		if file.Size != int64(len(content)) {
+			t.Fatalf("expected Size for %q to be %d but got %d", name, len(content), file.Size)
+		}
+	}
+
+	if actual["blank.txt"].ContentMD5 != "d41d8cd98f00b204e9800998ecf8427e" {
+		t.Fatalf("unexpected ContentMD5 for an empty file: %q", actual["blank.txt"].ContentMD5)
+	}
+	if actual["root.txt"].ContentMD5 != "5d41402abc4b2a76b9719d911017c592" {
+		t.Fatalf("unexpected ContentMD5 for root.txt: %q", actual["root.txt"].ContentMD5)
+	}
+}
+
+func TestListShareDirectorySourceFilesInvalidSource(t *testing.T) {
+	source := t.TempDir()
+
+	if _, err := ListShareDirectorySourceFiles(filepath.Join(source, "missing")); err == nil {
+		t.Fatalf("expected an error for a missing source")
+	}
+
+	file := filepath.Join(source, "file.txt")
+	if err := os.WriteFile(file, []byte("content"), 0o644); err != nil {
+		t.Fatalf("writing file: %+v", err)
+	}
+	if _, err := ListShareDirectorySourceFiles(file); err == nil {
+		t.Fatalf("expected an error for a source which isn't a directory")
+	}
+}
+
+func TestShareDirectorySourceFileMD5(t *testing.T) {
+	localPath := filepath.Join(t.TempDir(), "file.txt")
+
+	if err := os.WriteFile(localPath, []byte("hello"), 0o644); err != nil {
+		t.Fatalf("writing file: %+v", err)
+	}
+	before, size, err := shareDirectorySourceFileMD5(localPath)
+	if err != nil {
+		t.Fatalf("hashing file: %+v", err)
+	}
+	if before != "5d41402abc4b2a76b9719d911017c592" || size != 5 {
+		t.Fatalf("unexpected hash %q / size %d", before, size)
+	}
+
+	if err := os.WriteFile(localPath, []byte("hello world"), 0o644); err != nil {
+		t.Fatalf("rewriting file: %+v", err)
+	}
+	after, size, err := shareDirectorySourceFileMD5(localPath)
+	if err != nil {
+		t.Fatalf("hashing file: %+v", err)
+	}
+	if after == before {
+		t.Fatalf("expected the hash to change after the content changed")
+	}
+	if after != "5eb63bbbe01eeed093cb22bb8f5acdc3" || size != 11 {
+		t.Fatalf("unexpected hash %q / size %d", after, size)
+	}
+}
+
+func TestShareDirectorySyncSplitSharePath(t *testing.T) {
+	testData := []struct {
+		Path         string
+		RelativePath string
+		Directory    string
+		FileName     string
+	}{
+		{
+			Path:         "",
+			RelativePath: "file.txt",
+			Directory:    "",
+			FileName:     "file.txt",
+		},
+		{
+			Path:         "",
+			RelativePath: "nested/deep/file.txt",
+			Directory:    "nested/deep",
+			FileName:     "file.txt",
+		},
+		{
+			Path:         "base",
+			RelativePath: "file.txt",
+			Directory:    "base",
+			FileName:     "file.txt",
+		},
+		{
+			Path:         "base/sub",
+			RelativePath: "nested/file.txt",
+			Directory:    "base/sub/nested",
+			FileName:     "file.txt",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q", v.Path, v.RelativePath)
+
+		sync := ShareDirectorySync{Path: v.Path}
+		directory, fileName := sync.splitSharePath(v.RelativePath)
+		if directory != v.Directory {
+			t.Fatalf("expected Directory to be %q but got %q", v.Directory, directory)
+		}
+		if fileName != v.FileName {
+			t.Fatalf("expected FileName to be %q but got %q", v.FileName, fileName)
+		}
+	}
+}
+
+func TestAddShareDirectoryWithParents(t *testing.T) {
+	testData := []struct {
+		Directory string
+		Expected  map[string]struct{}
+	}{
+		{
+			Directory: "",
+			Expected:  map[string]struct{}{},
+		},
+		{
+			Directory: "a",
+			Expected: map[string]struct{}{
+				"a": {},
+			},
+		},
+		{
+			Directory: "a/b/c",
+			Expected: map[string]struct{}{
+				"a":     {},
+				"a/b":   {},
+				"a/b/c": {},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Directory)
+
+		actual := make(map[string]struct{})
+		addShareDirectoryWithParents(actual, v.Directory)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -20,7 +20,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
 	vnetParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
@@ -1902,7 +1901,7 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	// the networking api's only allow a single change to be made to a network layout at once, so let's lock to handle that
-	virtualNetworkNames := make([]string, 0)
+	virtualNetworkIDs := make([]string, 0)
 	if props := read.AccountProperties; props != nil {
 		if rules := props.NetworkRuleSet; rules != nil {
 			if vnr := rules.VirtualNetworkRules; vnr != nil {
@@ -1916,20 +1915,17 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 						return err2
 					}
 
-					networkName := id.VirtualNetworkName
-					for _, virtualNetworkName := range virtualNetworkNames {
-						if networkName == virtualNetworkName {
-							continue
-						}
+					virtualNetworkId := vnetParse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName).ID()
+					if !utils.SliceContainsValue(virtualNetworkIDs, virtualNetworkId) {
+						virtualNetworkIDs = append(virtualNetworkIDs, virtualNetworkId)
 					}
-					virtualNetworkNames = append(virtualNetworkNames, networkName)
 				}
 			}
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	locks.MultipleByID(&virtualNetworkIDs)
+	defer locks.UnlockMultipleByID(&virtualNetworkIDs)
 
 	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -0,0 +1,351 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	recursivepaths "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2020-02-10/datalakestore/paths"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/datalakestore/paths"
+	"github.com/tombuildsstuff/giovanni/storage/accesscontrol"
+)
+
+// the maximum number of failed entries to include in an error message, the total is always reported
+const dataLakeGen2PathAclMaxReportedFailures = 10
+
+func resourceStorageDataLakeGen2PathAcl() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageDataLakeGen2PathAclCreate,
+		Read:   resourceStorageDataLakeGen2PathAclRead,
+		Update: resourceStorageDataLakeGen2PathAclUpdate,
+		Delete: resourceStorageDataLakeGen2PathAclDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := paths.ParseResourceID(id)
+			return err
+		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			storageClients := meta.(*clients.Client).Storage
+
+			id, err := paths.ParseResourceID(d.Id())
+			if err != nil {
+				return []*pluginsdk.ResourceData{d}, fmt.Errorf("parsing ID %q for import of Data Lake Gen2 Path ACL: %v", d.Id(), err)
+			}
+
+			// we then need to look up the Storage Account ID
+			account, err := storageClients.FindAccount(ctx, id.AccountName)
+			if err != nil {
+				return []*pluginsdk.ResourceData{d}, fmt.Errorf("retrieving Account %q for Data Lake Gen2 Path ACL %q in File System %q: %s", id.AccountName, id.Path, id.FileSystemName, err)
+			}
+			if account == nil {
+				return []*pluginsdk.ResourceData{d}, fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
+			}
+
+			d.Set("storage_account_id", account.ID)
+			d.Set("filesystem_name", id.FileSystemName)
+			d.Set("batch_size", 2000)
+			d.Set("continue_on_failure", false)
+
+			return []*pluginsdk.ResourceData{d}, nil
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"filesystem_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStorageDataLakeGen2FileSystemName,
+			},
+
+			"path": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"ace": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"scope": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"default", "access"}, false),
+							Default:      "access",
+						},
+						"type": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"user", "group", "mask", "other"}, false),
+						},
+						"id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"permissions": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ADLSAccessControlPermissions,
+						},
+					},
+				},
+			},
+
+			"batch_size": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      2000,
+				ValidateFunc: validation.IntBetween(1, 2000),
+			},
+
+			"continue_on_failure": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceStorageDataLakeGen2PathAclCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	accountsClient := meta.(*clients.Client).Storage.AccountsClient
+	pathsClient := meta.(*clients.Client).Storage.ADLSGen2PathsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	storageID, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// confirm the storage account exists, otherwise Data Plane API requests will fail
+	storageAccount, err := accountsClient.GetProperties(ctx, storageID.ResourceGroup, storageID.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(storageAccount.Response) {
+			return fmt.Errorf("Storage Account %q was not found in Resource Group %q!", storageID.Name, storageID.ResourceGroup)
+		}
+
+		return fmt.Errorf("checking for existence of Storage Account %q (Resource Group %q): %+v", storageID.Name, storageID.ResourceGroup, err)
+	}
+
+	fileSystemName := d.Get("filesystem_name").(string)
+	path := d.Get("path").(string)
+
+	// unlike the `azurerm_storage_data_lake_gen2_path` resource this doesn't create the Path - so it must exist
+	resp, err := pathsClient.GetProperties(ctx, storageID.Name, fileSystemName, path, paths.GetPropertiesActionGetStatus)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Path %q was not found in File System %q in Storage Account %q!", path, fileSystemName, storageID.Name)
+		}
+
+		return fmt.Errorf("checking for existence of Path %q in File System %q (Account %q): %+v", path, fileSystemName, storageID.Name, err)
+	}
+
+	acl, err := ExpandDataLakeGen2AceList(d.Get("ace").(*pluginsdk.Set).List())
+	if err != nil {
+		return fmt.Errorf("parsing ace list: %s", err)
+	}
+
+	log.Printf("[INFO] Applying ACL recursively to Path %q in File System %q in Storage Account %q..", path, fileSystemName, storageID.Name)
+	if err := setDataLakeGen2PathAclRecursive(ctx, d, meta, storageID.Name, fileSystemName, path, recursivepaths.SetAccessControlRecursiveModeModify, acl.String()); err != nil {
+		return err
+	}
+
+	d.SetId(pathsClient.GetResourceID(storageID.Name, fileSystemName, path))
+	return resourceStorageDataLakeGen2PathAclRead(d, meta)
+}
+
+func resourceStorageDataLakeGen2PathAclUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := paths.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("ace") {
+		oldRaw, newRaw := d.GetChange("ace")
+
+		oldAcl, err := ExpandDataLakeGen2AceList(oldRaw.(*pluginsdk.Set).List())
+		if err != nil {
+			return fmt.Errorf("parsing existing ace list: %s", err)
+		}
+		newAcl, err := ExpandDataLakeGen2AceList(newRaw.(*pluginsdk.Set).List())
+		if err != nil {
+			return fmt.Errorf("parsing ace list: %s", err)
+		}
+
+		// ACE's which are no longer defined need removing explicitly, since `modify` only adds/updates entries
+		if removed := dataLakeGen2AclRemovalString(dataLakeGen2AcesRemoved(oldAcl, newAcl)); removed != "" {
+			log.Printf("[INFO] Removing ACL entries recursively from Path %q in File System %q in Storage Account %q..", id.Path, id.FileSystemName, id.AccountName)
+			if err := setDataLakeGen2PathAclRecursive(ctx, d, meta, id.AccountName, id.FileSystemName, id.Path, recursivepaths.SetAccessControlRecursiveModeRemove, removed); err != nil {
+				return err
+			}
+		}
+
+		log.Printf("[INFO] Applying ACL recursively to Path %q in File System %q in Storage Account %q..", id.Path, id.FileSystemName, id.AccountName)
+		if err := setDataLakeGen2PathAclRecursive(ctx, d, meta, id.AccountName, id.FileSystemName, id.Path, recursivepaths.SetAccessControlRecursiveModeModify, newAcl.String()); err != nil {
+			return err
+		}
+	}
+
+	return resourceStorageDataLakeGen2PathAclRead(d, meta)
+}
+
+func resourceStorageDataLakeGen2PathAclRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ADLSGen2PathsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := paths.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetProperties(ctx, id.AccountName, id.FileSystemName, id.Path, paths.GetPropertiesActionGetAccessControl)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Path %q does not exist in File System %q in Storage Account %q - removing from state...", id.Path, id.FileSystemName, id.AccountName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving ACLs for Path %q in File System %q in Storage Account %q: %+v", id.Path, id.FileSystemName, id.AccountName, err)
+	}
+
+	acl, err := accesscontrol.ParseACL(resp.ACL)
+	if err != nil {
+		return fmt.Errorf("parsing response ACL %q: %s", resp.ACL, err)
+	}
+
+	// the ACL on the Path itself is used as the source of truth for the whole tree, since reading back the
+	// ACL for every child isn't feasible - and only the entries managed by this resource are tracked, since
+	// the Path will have other entries (e.g. the owning user/group) which are managed elsewhere
+	managed, err := ExpandDataLakeGen2AceList(d.Get("ace").(*pluginsdk.Set).List())
+	if err != nil {
+		return fmt.Errorf("parsing ace list: %s", err)
+	}
+	if managed != nil {
+		acl = dataLakeGen2AcesMatching(acl, *managed)
+	} else {
+		// when importing there's nothing to match against, so only the named entries are tracked - the base
+		// entries and mask exist on every Path, so would otherwise show a diff against most configurations
+		acl = dataLakeGen2AcesWithoutBase(acl)
+	}
+
+	d.Set("path", id.Path)
+	d.Set("filesystem_name", id.FileSystemName)
+	d.Set("ace", FlattenDataLakeGen2AceList(acl))
+
+	return nil
+}
+
+func resourceStorageDataLakeGen2PathAclDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := paths.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	acl, err := ExpandDataLakeGen2AceList(d.Get("ace").(*pluginsdk.Set).List())
+	if err != nil {
+		return fmt.Errorf("parsing ace list: %s", err)
+	}
+	if acl == nil {
+		return nil
+	}
+
+	removed := dataLakeGen2AclRemovalString(acl.Entries)
+	if removed == "" {
+		log.Printf("[DEBUG] Path %q in File System %q in Storage Account %q only has base ACL entries which can't be removed - skipping", id.Path, id.FileSystemName, id.AccountName)
+		return nil
+	}
+
+	log.Printf("[INFO] Removing ACL entries recursively from Path %q in File System %q in Storage Account %q..", id.Path, id.FileSystemName, id.AccountName)
+	return setDataLakeGen2PathAclRecursive(ctx, d, meta, id.AccountName, id.FileSystemName, id.Path, recursivepaths.SetAccessControlRecursiveModeRemove, removed)
+}
+
+// setDataLakeGen2PathAclRecursive applies the ACL to the Path and everything beneath it, following the
+// continuation token until the whole tree has been processed
+func setDataLakeGen2PathAclRecursive(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, accountName, fileSystemName, path string, mode recursivepaths.SetAccessControlRecursiveMode, acl string) error {
+	client := meta.(*clients.Client).Storage.ADLSGen2PathsRecursiveClient
+
+	input := recursivepaths.SetAccessControlRecursiveInput{
+		Mode:       mode,
+		ACL:        acl,
+		MaxRecords: utils.Int(d.Get("batch_size").(int)),
+		ForceFlag:  utils.Bool(d.Get("continue_on_failure").(bool)),
+	}
+
+	directories := 0
+	files := 0
+	failureCount := 0
+	failedEntries := make([]recursivepaths.SetAccessControlRecursiveFailedEntry, 0)
+	for {
+		resp, err := client.SetAccessControlRecursive(ctx, accountName, fileSystemName, path, input)
+		if err != nil {
+			return fmt.Errorf("applying ACL recursively (mode %q) to Path %q in File System %q in Storage Account %q: %+v", string(mode), path, fileSystemName, accountName, err)
+		}
+
+		directories += resp.DirectoriesSuccessful
+		files += resp.FilesSuccessful
+		failureCount += resp.FailureCount
+		failedEntries = append(failedEntries, resp.FailedEntries...)
+		log.Printf("[DEBUG] Applied ACL (mode %q) to %d directories and %d files beneath Path %q (%d failures so far)", string(mode), directories, files, path, failureCount)
+
+		// when `forceFlag` is false the operation stops on the first batch containing a failure, so there's no point continuing
+		if resp.Continuation == "" || (resp.FailureCount > 0 && !*input.ForceFlag) {
+			break
+		}
+		input.Continuation = utils.String(resp.Continuation)
+	}
+
+	if failureCount > 0 {
+		return fmt.Errorf("applying ACL recursively (mode %q) to Path %q in File System %q in Storage Account %q: %s", string(mode), path, fileSystemName, accountName, formatDataLakeGen2PathAclFailures(directories, files, failureCount, failedEntries))
+	}
+
+	return nil
+}
+
+func formatDataLakeGen2PathAclFailures(directories, files, failureCount int, failedEntries []recursivepaths.SetAccessControlRecursiveFailedEntry) string {
+	lines := []string{
+		fmt.Sprintf("%d entries failed (%d directories and %d files were updated successfully):", failureCount, directories, files),
+	}
+	for i, entry := range failedEntries {
+		if i == dataLakeGen2PathAclMaxReportedFailures {
+			lines = append(lines, fmt.Sprintf("  ... and %d more", failureCount-i))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("  - %s %q: %s", entry.Type, entry.Name, entry.ErrorMessage))
+	}
+
+	return strings.Join(lines, "\n")
+}
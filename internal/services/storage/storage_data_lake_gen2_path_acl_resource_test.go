@@ -0,0 +1,196 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/datalakestore/paths"
+)
+
+type StorageDataLakeGen2PathAclResource struct{}
+
+func TestAccStorageDataLakeGen2PathAcl_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_acl", "test")
+	r := StorageDataLakeGen2PathAclResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageDataLakeGen2PathAcl_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_acl", "test")
+	r := StorageDataLakeGen2PathAclResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ace.#").HasValue("3"),
+			),
+		},
+		// the `other` base entry isn't tracked when importing and `batch_size` / `continue_on_failure` are set to their defaults
+		data.ImportStep("ace", "batch_size", "continue_on_failure"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ace.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageDataLakeGen2PathAclResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := paths.ParseResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Storage.ADLSGen2PathsClient.GetProperties(ctx, id.AccountName, id.FileSystemName, id.Path, paths.GetPropertiesActionGetAccessControl)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving ACL for Path %q (File System %q / Account %q): %+v", id.Path, id.FileSystemName, id.AccountName, err)
+	}
+	return utils.Bool(true), nil
+}
+
+func (r StorageDataLakeGen2PathAclResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_acl" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = azurerm_storage_data_lake_gen2_path.parent.path
+
+  ace {
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "r-x"
+  }
+
+  depends_on = [azurerm_storage_data_lake_gen2_path.child]
+}
+`, template)
+}
+
+func (r StorageDataLakeGen2PathAclResource) complete(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_acl" "test" {
+  storage_account_id  = azurerm_storage_account.test.id
+  filesystem_name     = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path                = azurerm_storage_data_lake_gen2_path.parent.path
+  batch_size          = 1
+  continue_on_failure = true
+
+  ace {
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "rwx"
+  }
+  ace {
+    scope       = "default"
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "r-x"
+  }
+  ace {
+    type        = "other"
+    permissions = "--x"
+  }
+
+  depends_on = [azurerm_storage_data_lake_gen2_path.child]
+}
+`, template)
+}
+
+func (r StorageDataLakeGen2PathAclResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azuread" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_role_assignment" "storageAccountRoleAssignment" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Owner"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctestspa%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "fstest"
+  storage_account_id = azurerm_storage_account.test.id
+  depends_on = [
+    azurerm_role_assignment.storageAccountRoleAssignment
+  ]
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "parent" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "zone"
+  resource           = "directory"
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "child" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "${azurerm_storage_data_lake_gen2_path.parent.path}/child"
+  resource           = "directory"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
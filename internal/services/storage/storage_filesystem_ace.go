@@ -1,6 +1,9 @@
 package storage
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/google/uuid"
 	"github.com/tombuildsstuff/giovanni/storage/accesscontrol"
 )
@@ -69,3 +72,81 @@ func FlattenDataLakeGen2AceList(acl accesscontrol.ACL) []interface{} {
 	}
 	return output
 }
+
+// dataLakeGen2AceKey returns the key identifying an ACE regardless of its permissions
+func dataLakeGen2AceKey(ace accesscontrol.ACE) string {
+	qualifier := ""
+	if ace.TagQualifier != nil {
+		qualifier = ace.TagQualifier.String()
+	}
+	return fmt.Sprintf("%t:%s:%s", ace.IsDefault, ace.TagType, qualifier)
+}
+
+// dataLakeGen2AcesMatching returns the entries within the ACL which share a key with one of the expected entries
+func dataLakeGen2AcesMatching(acl accesscontrol.ACL, expected accesscontrol.ACL) accesscontrol.ACL {
+	keys := make(map[string]struct{}, len(expected.Entries))
+	for _, v := range expected.Entries {
+		keys[dataLakeGen2AceKey(v)] = struct{}{}
+	}
+
+	entries := make([]accesscontrol.ACE, 0)
+	for _, v := range acl.Entries {
+		if _, ok := keys[dataLakeGen2AceKey(v)]; ok {
+			entries = append(entries, v)
+		}
+	}
+	return accesscontrol.ACL{Entries: entries}
+}
+
+// dataLakeGen2AcesWithoutBase returns the entries within the ACL which aren't base entries (those without a
+// qualifier) or mask entries
+func dataLakeGen2AcesWithoutBase(acl accesscontrol.ACL) accesscontrol.ACL {
+	entries := make([]accesscontrol.ACE, 0)
+	for _, v := range acl.Entries {
+		if v.TagQualifier == nil || v.TagType == accesscontrol.TagTypeMask {
+			continue
+		}
+		entries = append(entries, v)
+	}
+	return accesscontrol.ACL{Entries: entries}
+}
+
+// dataLakeGen2AcesRemoved returns the entries within the old ACL which are no longer present in the new ACL
+func dataLakeGen2AcesRemoved(old *accesscontrol.ACL, new *accesscontrol.ACL) []accesscontrol.ACE {
+	if old == nil {
+		return nil
+	}
+
+	keys := make(map[string]struct{})
+	if new != nil {
+		for _, v := range new.Entries {
+			keys[dataLakeGen2AceKey(v)] = struct{}{}
+		}
+	}
+
+	removed := make([]accesscontrol.ACE, 0)
+	for _, v := range old.Entries {
+		if _, ok := keys[dataLakeGen2AceKey(v)]; !ok {
+			removed = append(removed, v)
+		}
+	}
+	return removed
+}
+
+// dataLakeGen2AclRemovalString returns the ACL string used to remove the specified entries, which omits the
+// permissions. The base entries (those without a qualifier) can't be removed, so these are skipped.
+func dataLakeGen2AclRemovalString(input []accesscontrol.ACE) string {
+	entries := make([]string, 0)
+	for _, v := range input {
+		if v.TagQualifier == nil {
+			continue
+		}
+
+		prefix := ""
+		if v.IsDefault {
+			prefix = "default:"
+		}
+		entries = append(entries, fmt.Sprintf("%s%s:%s", prefix, v.TagType, v.TagQualifier.String()))
+	}
+	return strings.Join(entries, ",")
+}
@@ -0,0 +1,389 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/directories"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/files"
+)
+
+func resourceStorageShareDirectorySync() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageShareDirectorySyncCreate,
+		Read:   resourceStorageShareDirectorySyncRead,
+		Update: resourceStorageShareDirectorySyncUpdate,
+		Delete: resourceStorageShareDirectorySyncDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_share_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageShareID,
+			},
+
+			"path": {
+				Type:         pluginsdk.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: storageValidate.StorageShareDirectoryName,
+			},
+
+			"source": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"parallelism": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+
+			// a map of the path (relative to `source`) to the hex-encoded MD5 of the file contents
+			"files": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			// the source directory may not exist until apply time, in which case the diff is computed then
+			if !d.NewValueKnown("source") {
+				return d.SetNewComputed("files")
+			}
+
+			sourceFiles, err := ListShareDirectorySourceFiles(d.Get("source").(string))
+			if err != nil {
+				return err
+			}
+
+			expected := flattenShareDirectorySourceFiles(sourceFiles)
+			existing := d.Get("files").(map[string]interface{})
+			if shareDirectoryFilesChanged(existing, expected) {
+				return d.SetNew("files", expected)
+			}
+
+			return nil
+		}),
+	}
+}
+
+func resourceStorageShareDirectorySyncCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	storageShareID, err := parse.StorageShareDataPlaneID(d.Get("storage_share_id").(string))
+	if err != nil {
+		return err
+	}
+
+	path := d.Get("path").(string)
+
+	account, err := storageClient.FindAccount(ctx, storageShareID.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Directory %q (Share %q): %s", storageShareID.AccountName, path, storageShareID.Name, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate Storage Account %q!", storageShareID.AccountName)
+	}
+
+	fileSharesClient, err := storageClient.FileSharesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Shares Client: %s", err)
+	}
+
+	share, err := fileSharesClient.Get(ctx, account.ResourceGroup, storageShareID.AccountName, storageShareID.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Share %q for Directory %q: %s", storageShareID.Name, path, err)
+	}
+	if share == nil {
+		return fmt.Errorf("unable to locate Storage Share %q", storageShareID.Name)
+	}
+
+	directoriesClient, err := storageClient.FileShareDirectoriesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Directories Client: %s", err)
+	}
+
+	filesClient, err := storageClient.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Files Client: %s", err)
+	}
+
+	shareSync := buildShareDirectorySync(d, directoriesClient, filesClient, storageShareID.AccountName, storageShareID.Name, path)
+
+	sourceFiles, err := ListShareDirectorySourceFiles(d.Get("source").(string))
+	if err != nil {
+		return err
+	}
+
+	uploads := make([]ShareDirectorySourceFile, 0, len(sourceFiles))
+	for _, v := range sourceFiles {
+		uploads = append(uploads, v)
+	}
+
+	log.Printf("[INFO] Syncing %d files into Directory %q (File Share %q / Account %q)..", len(uploads), path, storageShareID.Name, storageShareID.AccountName)
+	if err := shareSync.EnsureDirectories(ctx, uploads); err != nil {
+		return err
+	}
+	if err := shareSync.Upload(ctx, uploads); err != nil {
+		return fmt.Errorf("uploading files into Directory %q (File Share %q / Account %q): %+v", path, storageShareID.Name, storageShareID.AccountName, err)
+	}
+
+	d.SetId(shareSync.DirectoriesClient.GetResourceID(storageShareID.AccountName, storageShareID.Name, path))
+	d.Set("files", flattenShareDirectorySourceFiles(sourceFiles))
+
+	return resourceStorageShareDirectorySyncRead(d, meta)
+}
+
+func resourceStorageShareDirectorySyncUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	id, err := directories.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Directory %q (Share %q): %s", id.AccountName, id.DirectoryName, id.ShareName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate Storage Account %q!", id.AccountName)
+	}
+
+	directoriesClient, err := storageClient.FileShareDirectoriesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Directories Client: %s", err)
+	}
+
+	filesClient, err := storageClient.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Files Client: %s", err)
+	}
+
+	shareSync := buildShareDirectorySync(d, directoriesClient, filesClient, id.AccountName, id.ShareName, id.DirectoryName)
+
+	sourceFiles, err := ListShareDirectorySourceFiles(d.Get("source").(string))
+	if err != nil {
+		return err
+	}
+
+	// the state holds the files which were last uploaded, so we only need to upload those which have changed
+	oldRaw, _ := d.GetChange("files")
+	existing := oldRaw.(map[string]interface{})
+
+	uploads := make([]ShareDirectorySourceFile, 0)
+	for relativePath, v := range sourceFiles {
+		if existingMD5, ok := existing[relativePath]; ok && existingMD5.(string) == v.ContentMD5 {
+			continue
+		}
+		uploads = append(uploads, v)
+	}
+
+	removals := make([]string, 0)
+	for relativePath := range existing {
+		if _, ok := sourceFiles[relativePath]; !ok {
+			removals = append(removals, relativePath)
+		}
+	}
+	sort.Strings(removals)
+
+	log.Printf("[INFO] Syncing Directory %q (File Share %q / Account %q): uploading %d files and removing %d files..", id.DirectoryName, id.ShareName, id.AccountName, len(uploads), len(removals))
+	if err := shareSync.EnsureDirectories(ctx, uploads); err != nil {
+		return err
+	}
+	if err := shareSync.Upload(ctx, uploads); err != nil {
+		return fmt.Errorf("uploading files into Directory %q (File Share %q / Account %q): %+v", id.DirectoryName, id.ShareName, id.AccountName, err)
+	}
+	if err := shareSync.Delete(ctx, removals); err != nil {
+		return fmt.Errorf("removing files from Directory %q (File Share %q / Account %q): %+v", id.DirectoryName, id.ShareName, id.AccountName, err)
+	}
+
+	d.Set("files", flattenShareDirectorySourceFiles(sourceFiles))
+
+	return resourceStorageShareDirectorySyncRead(d, meta)
+}
+
+func resourceStorageShareDirectorySyncRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	id, err := directories.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Directory %q (Share %q): %s", id.AccountName, id.DirectoryName, id.ShareName, err)
+	}
+	if account == nil {
+		log.Printf("[WARN] Unable to determine Storage Account for Storage Share Directory Sync %q (Share %s, Account %s) - assuming removed & removing from state", id.DirectoryName, id.ShareName, id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	fileSharesClient, err := storageClient.FileSharesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Shares Client: %s", err)
+	}
+
+	share, err := fileSharesClient.Get(ctx, account.ResourceGroup, id.AccountName, id.ShareName)
+	if err != nil {
+		return fmt.Errorf("retrieving Share %q for Directory %q: %s", id.ShareName, id.DirectoryName, err)
+	}
+	if share == nil {
+		log.Printf("[WARN] Unable to determine Storage Share for Storage Share Directory Sync %q (Share %s, Account %s) - assuming removed & removing from state", id.DirectoryName, id.ShareName, id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	directoriesClient, err := storageClient.FileShareDirectoriesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Directories Client: %s", err)
+	}
+
+	filesClient, err := storageClient.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Files Client: %s", err)
+	}
+
+	shareSync := buildShareDirectorySync(d, directoriesClient, filesClient, id.AccountName, id.ShareName, id.DirectoryName)
+
+	// files which have been removed or modified outside of Terraform are removed from the map, or have their
+	// MD5 updated, so that they're uploaded again during the next apply
+	uploaded := make(map[string]interface{})
+	for relativePath := range d.Get("files").(map[string]interface{}) {
+		directoryName, fileName := shareSync.splitSharePath(relativePath)
+		props, err := shareSync.FilesClient.GetProperties(ctx, id.AccountName, id.ShareName, directoryName, fileName)
+		if err != nil {
+			if utils.ResponseWasNotFound(props.Response) {
+				log.Printf("[DEBUG] File %q was not found in Directory %q (File Share %q / Account %q)", fileName, directoryName, id.ShareName, id.AccountName)
+				continue
+			}
+
+			return fmt.Errorf("retrieving File %q in Directory %q (File Share %q / Account %q): %+v", fileName, directoryName, id.ShareName, id.AccountName, err)
+		}
+
+		contentMD5 := ""
+		if props.ContentMD5 != "" {
+			contentMD5, err = convertBase64ToHexEncoding(props.ContentMD5)
+			if err != nil {
+				return err
+			}
+		}
+		uploaded[relativePath] = contentMD5
+	}
+
+	d.Set("path", id.DirectoryName)
+	d.Set("storage_share_id", parse.NewStorageShareDataPlaneId(id.AccountName, storageClient.Environment.StorageEndpointSuffix, id.ShareName).ID())
+	d.Set("files", uploaded)
+
+	return nil
+}
+
+func resourceStorageShareDirectorySyncDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	id, err := directories.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Directory %q (Share %q): %s", id.AccountName, id.DirectoryName, id.ShareName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+	}
+
+	directoriesClient, err := storageClient.FileShareDirectoriesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Directories Client: %s", err)
+	}
+
+	filesClient, err := storageClient.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Files Client: %s", err)
+	}
+
+	shareSync := buildShareDirectorySync(d, directoriesClient, filesClient, id.AccountName, id.ShareName, id.DirectoryName)
+
+	removals := make([]string, 0)
+	for relativePath := range d.Get("files").(map[string]interface{}) {
+		removals = append(removals, relativePath)
+	}
+	sort.Strings(removals)
+
+	if err := shareSync.Delete(ctx, removals); err != nil {
+		return fmt.Errorf("removing files from Directory %q (File Share %q / Account %q): %+v", id.DirectoryName, id.ShareName, id.AccountName, err)
+	}
+
+	return nil
+}
+
+func buildShareDirectorySync(d *pluginsdk.ResourceData, directoriesClient *directories.Client, filesClient *files.Client, accountName, shareName, path string) ShareDirectorySync {
+	return ShareDirectorySync{
+		DirectoriesClient: directoriesClient,
+		FilesClient:       filesClient,
+		AccountName:       accountName,
+		ShareName:         shareName,
+		Path:              path,
+		Parallelism:       d.Get("parallelism").(int),
+	}
+}
+
+func flattenShareDirectorySourceFiles(input map[string]ShareDirectorySourceFile) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		output[k] = v.ContentMD5
+	}
+	return output
+}
+
+func shareDirectoryFilesChanged(existing map[string]interface{}, expected map[string]interface{}) bool {
+	if len(existing) != len(expected) {
+		return true
+	}
+
+	for k, v := range expected {
+		if existingValue, ok := existing[k]; !ok || existingValue != v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,172 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/directories"
+)
+
+type StorageShareDirectorySyncResource struct{}
+
+func TestAccStorageShareDirectorySync_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_directory_sync", "test")
+	r := StorageShareDirectorySyncResource{}
+	source := r.sourceDirectory(t, map[string]string{
+		"app.conf":            "hello = world",
+		"nested/deeper/a.txt": "a",
+		"empty":               "",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+			),
+		},
+	})
+}
+
+func TestAccStorageShareDirectorySync_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_directory_sync", "test")
+	r := StorageShareDirectorySyncResource{}
+	source := r.sourceDirectory(t, map[string]string{
+		"app.conf":            "hello = world",
+		"nested/deeper/a.txt": "a",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("2"),
+			),
+		},
+		{
+			PreConfig: func() {
+				if err := os.WriteFile(filepath.Join(source, "app.conf"), []byte("hello = terraform"), 0600); err != nil {
+					t.Fatalf("updating source file: %+v", err)
+				}
+				if err := os.RemoveAll(filepath.Join(source, "nested")); err != nil {
+					t.Fatalf("removing source directory: %+v", err)
+				}
+				if err := os.WriteFile(filepath.Join(source, "other.txt"), []byte("other"), 0600); err != nil {
+					t.Fatalf("writing source file: %+v", err)
+				}
+			},
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("2"),
+				check.That(data.ResourceName).Key("files.other.txt").HasValue("795f3202b17cb6bc3d4b771d8c6c9eaf"),
+			),
+		},
+	})
+}
+
+func (StorageShareDirectorySyncResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := directories.ParseResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := clients.Storage.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Directory %q (Share %q): %s", id.AccountName, id.DirectoryName, id.ShareName, err)
+	}
+	if account == nil {
+		return utils.Bool(false), nil
+	}
+
+	client, err := clients.Storage.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building File Share Files Client: %s", err)
+	}
+
+	// every file which was uploaded should exist
+	for key := range state.Attributes {
+		if key == "files.%" || !strings.HasPrefix(key, "files.") {
+			continue
+		}
+
+		directoryName, fileName := path.Split(path.Join(id.DirectoryName, strings.TrimPrefix(key, "files.")))
+		directoryName = strings.TrimSuffix(directoryName, "/")
+		resp, err := client.GetProperties(ctx, id.AccountName, id.ShareName, directoryName, fileName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving File %q in Directory %q (File Share %q / Storage Account %q): %s", fileName, directoryName, id.ShareName, id.AccountName, err)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (StorageShareDirectorySyncResource) sourceDirectory(t *testing.T, files map[string]string) string {
+	source, err := os.MkdirTemp("", "acctestsharesync")
+	if err != nil {
+		t.Fatalf("creating source directory: %+v", err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(source)
+	})
+
+	for name, contents := range files {
+		localPath := filepath.Join(source, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(localPath), 0700); err != nil {
+			t.Fatalf("creating source directory %q: %+v", filepath.Dir(localPath), err)
+		}
+		if err := os.WriteFile(localPath, []byte(contents), 0600); err != nil {
+			t.Fatalf("writing source file %q: %+v", localPath, err)
+		}
+	}
+
+	return source
+}
+
+func (StorageShareDirectorySyncResource) basic(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "fileshare"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 50
+}
+
+resource "azurerm_storage_share_directory_sync" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+  path             = "config/app"
+  source           = %q
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, source)
+}
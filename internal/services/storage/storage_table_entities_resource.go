@@ -0,0 +1,393 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2019-12-12/table/batches"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/table/entities"
+)
+
+func resourceStorageTableEntities() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageTableEntitiesCreateUpdate,
+		Read:   resourceStorageTableEntitiesRead,
+		Update: resourceStorageTableEntitiesCreateUpdate,
+		Delete: resourceStorageTableEntitiesDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"table_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageTableName,
+			},
+
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountName,
+			},
+
+			"format": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					tableEntitiesFormatCSV,
+					tableEntitiesFormatJSON,
+				}, false),
+			},
+
+			// only a hash of the content is stored in the state, since the changes to individual
+			// entities are surfaced in the diff through `entities`
+			"content": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				StateFunc:    tableEntitiesContentStateFunc,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// a map of `{partitionKey}/{rowKey}` to a hash of the entity's properties
+			"entities": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			format, content, known := tableEntitiesConfig(d)
+			if !known {
+				return d.SetNewComputed("entities")
+			}
+
+			rows, err := ParseTableEntities(format, content)
+			if err != nil {
+				return fmt.Errorf("parsing `content`: %+v", err)
+			}
+
+			expected := flattenTableEntityRowHashes(rows)
+			existing := d.Get("entities").(map[string]interface{})
+			if tableEntityHashesChanged(existing, expected) {
+				return d.SetNew("entities", expected)
+			}
+
+			return nil
+		}),
+	}
+}
+
+func resourceStorageTableEntitiesCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	accountName := d.Get("storage_account_name").(string)
+	tableName := d.Get("table_name").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Table %q: %s", accountName, tableName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Account %q for Storage Table %q", accountName, tableName)
+	}
+
+	client, err := storageClient.TableEntityBatchesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Entity Batches Client: %s", err)
+	}
+
+	format, content, known := tableEntitiesConfig(d)
+	if !known {
+		return fmt.Errorf("`format` and `content` must be known to update the Entities in Table %q (Storage Account %q)", tableName, accountName)
+	}
+	rows, err := ParseTableEntities(format, content)
+	if err != nil {
+		return fmt.Errorf("parsing `content`: %+v", err)
+	}
+
+	// the state contains the hash of each entity as it was last read, so only entities which
+	// have been added or changed need upserting - and those which have been removed need deleting
+	existing := make(map[string]interface{})
+	if !d.IsNewResource() {
+		oldRaw, _ := d.GetChange("entities")
+		existing = oldRaw.(map[string]interface{})
+	}
+
+	operations := make([]batches.Operation, 0)
+	for key, row := range rows {
+		if hash, ok := existing[key]; ok && hash.(string) == row.Hash() {
+			continue
+		}
+
+		entity := make(map[string]interface{}, len(row.Properties))
+		for k, v := range row.Properties {
+			entity[k] = v
+		}
+		operations = append(operations, batches.Operation{
+			Type:         batches.OperationTypeInsertOrReplace,
+			PartitionKey: row.PartitionKey,
+			RowKey:       row.RowKey,
+			Entity:       entity,
+		})
+	}
+	for key := range existing {
+		if _, ok := rows[key]; ok {
+			continue
+		}
+
+		partitionKey, rowKey, err := splitTableEntityKey(key)
+		if err != nil {
+			return err
+		}
+		operations = append(operations, batches.Operation{
+			Type:         batches.OperationTypeDelete,
+			PartitionKey: partitionKey,
+			RowKey:       rowKey,
+		})
+	}
+
+	log.Printf("[DEBUG] Applying %d changes to the Entities in Table %q (Storage Account %q)..", len(operations), tableName, accountName)
+	if err := executeTableEntityOperations(ctx, client, accountName, tableName, operations); err != nil {
+		return fmt.Errorf("updating Entities (Table %q / Storage Account %q / Resource Group %q): %+v", tableName, accountName, account.ResourceGroup, err)
+	}
+
+	d.SetId(parse.NewStorageTableDataPlaneId(accountName, storageClient.Environment.StorageEndpointSuffix, tableName).ID())
+	d.Set("entities", flattenTableEntityRowHashes(rows))
+
+	return resourceStorageTableEntitiesRead(d, meta)
+}
+
+func resourceStorageTableEntitiesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	id, err := parse.StorageTableDataPlaneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Table %q: %s", id.AccountName, id.Name, err)
+	}
+	if account == nil {
+		log.Printf("[WARN] Unable to determine Resource Group for Storage Table %q (Account %s) - assuming removed & removing from state", id.Name, id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	client, err := storageClient.TableEntityClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Table Entity Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
+	}
+
+	// only the entities managed by this resource are tracked, other entities in the table are ignored
+	managed := d.Get("entities").(map[string]interface{})
+	actual := make(map[string]interface{}, len(managed))
+
+	input := entities.QueryEntitiesInput{
+		MetaDataLevel: entities.NoMetaData,
+	}
+	for {
+		result, err := client.Query(ctx, id.AccountName, id.Name, input)
+		if err != nil {
+			if utils.ResponseWasNotFound(result.Response) {
+				log.Printf("[DEBUG] Table %q was not found in Storage Account %q - removing from state", id.Name, id.AccountName)
+				d.SetId("")
+				return nil
+			}
+
+			return fmt.Errorf("querying Entities (Table %q / Storage Account %q / Resource Group %q): %+v", id.Name, id.AccountName, account.ResourceGroup, err)
+		}
+
+		for _, entity := range result.Entities {
+			partitionKey, _ := entity["PartitionKey"].(string)
+			rowKey, _ := entity["RowKey"].(string)
+			row := TableEntityRow{
+				PartitionKey: partitionKey,
+				RowKey:       rowKey,
+				Properties:   map[string]string{},
+			}
+			if _, ok := managed[row.Key()]; !ok {
+				continue
+			}
+
+			for k, v := range flattenEntity(entity) {
+				row.Properties[k] = fmt.Sprintf("%v", v)
+			}
+			actual[row.Key()] = row.Hash()
+		}
+
+		if result.NextPartitionKey == "" && result.NextRowKey == "" {
+			break
+		}
+		input.NextPartitionKey = utils.String(result.NextPartitionKey)
+		input.NextRowKey = utils.String(result.NextRowKey)
+	}
+
+	d.Set("storage_account_name", id.AccountName)
+	d.Set("table_name", id.Name)
+	if err := d.Set("entities", actual); err != nil {
+		return fmt.Errorf("setting `entities`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceStorageTableEntitiesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	id, err := parse.StorageTableDataPlaneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Table %q: %s", id.AccountName, id.Name, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Storage Account %q was not found!", id.AccountName)
+	}
+
+	client, err := storageClient.TableEntityBatchesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Entity Batches Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
+	}
+
+	operations := make([]batches.Operation, 0)
+	for key := range d.Get("entities").(map[string]interface{}) {
+		partitionKey, rowKey, err := splitTableEntityKey(key)
+		if err != nil {
+			return err
+		}
+		operations = append(operations, batches.Operation{
+			Type:         batches.OperationTypeDelete,
+			PartitionKey: partitionKey,
+			RowKey:       rowKey,
+		})
+	}
+
+	if err := executeTableEntityOperations(ctx, client, id.AccountName, id.Name, operations); err != nil {
+		return fmt.Errorf("deleting Entities (Table %q / Storage Account %q / Resource Group %q): %+v", id.Name, id.AccountName, account.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+// executeTableEntityOperations groups the operations by Partition Key (since an Entity Group Transaction can only
+// contain a single Partition Key) and submits them in batches of up to 100 operations
+func executeTableEntityOperations(ctx context.Context, client *batches.Client, accountName, tableName string, operations []batches.Operation) error {
+	partitions := make(map[string][]batches.Operation)
+	for _, operation := range operations {
+		partitions[operation.PartitionKey] = append(partitions[operation.PartitionKey], operation)
+	}
+
+	partitionKeys := make([]string, 0, len(partitions))
+	for k := range partitions {
+		partitionKeys = append(partitionKeys, k)
+	}
+	sort.Strings(partitionKeys)
+
+	for _, partitionKey := range partitionKeys {
+		partition := partitions[partitionKey]
+		sort.Slice(partition, func(i, j int) bool {
+			return partition[i].RowKey < partition[j].RowKey
+		})
+
+		for start := 0; start < len(partition); start += batches.MaxOperationsPerBatch {
+			end := start + batches.MaxOperationsPerBatch
+			if end > len(partition) {
+				end = len(partition)
+			}
+
+			input := batches.ExecuteInput{
+				Operations: partition[start:end],
+			}
+			if _, err := client.Execute(ctx, accountName, tableName, input); err != nil {
+				return fmt.Errorf("executing batch of %d operations for Partition Key %q (Row Keys %q to %q): %+v", end-start, partitionKey, partition[start].RowKey, partition[end-1].RowKey, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// tableEntitiesConfig returns the `format` and `content` from the configuration, since only a hash of the
+// `content` is available from the state. The last value returns false when these aren't known yet.
+func tableEntitiesConfig(d interface {
+	GetRawConfig() cty.Value
+}) (string, string, bool) {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return "", "", false
+	}
+
+	format := config.GetAttr("format")
+	content := config.GetAttr("content")
+	if !format.IsKnown() || format.IsNull() || !content.IsKnown() || content.IsNull() {
+		return "", "", false
+	}
+
+	return format.AsString(), content.AsString(), true
+}
+
+func tableEntitiesContentStateFunc(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		hash := sha1.Sum([]byte(s))
+		return hex.EncodeToString(hash[:])
+	default:
+		return ""
+	}
+}
+
+func flattenTableEntityRowHashes(input map[string]TableEntityRow) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		output[k] = v.Hash()
+	}
+	return output
+}
+
+func tableEntityHashesChanged(existing map[string]interface{}, expected map[string]interface{}) bool {
+	if len(existing) != len(expected) {
+		return true
+	}
+
+	for k, v := range expected {
+		if existingValue, ok := existing[k]; !ok || existingValue != v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,208 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/table/entities"
+)
+
+type StorageTableEntitiesResource struct{}
+
+func TestAccTableEntities_csv(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.csv(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entities.%").HasValue("3"),
+			),
+		},
+	})
+}
+
+func TestAccTableEntities_json(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.json(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entities.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccTableEntities_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.csv(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entities.%").HasValue("3"),
+			),
+		},
+		{
+			Config: r.csvUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entities.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (r StorageTableEntitiesResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageTableDataPlaneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Table %q: %+v", id.AccountName, id.Name, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("storage Account %q was not found", id.AccountName)
+	}
+
+	entitiesClient, err := client.Storage.TableEntityClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Table Entity Client: %+v", err)
+	}
+
+	for key := range state.Attributes {
+		if !strings.HasPrefix(key, "entities.") || key == "entities.%" {
+			continue
+		}
+		segments := strings.SplitN(strings.TrimPrefix(key, "entities."), "/", 2)
+		if len(segments) != 2 {
+			return nil, fmt.Errorf("expected the key %q to be in the format `entities.{partitionKey}/{rowKey}`", key)
+		}
+		partitionKey, rowKey := segments[0], segments[1]
+
+		input := entities.GetEntityInput{
+			PartitionKey:  partitionKey,
+			RowKey:        rowKey,
+			MetaDataLevel: entities.NoMetaData,
+		}
+		resp, err := entitiesClient.Get(ctx, id.AccountName, id.Name, input)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %+v", partitionKey, rowKey, id.Name, id.AccountName, account.ResourceGroup, err)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StorageTableEntitiesResource) csv(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  format  = "csv"
+  content = <<CSV
+PartitionKey,RowKey,Name,Population
+uk,london,London,8982000
+uk,leeds,Leeds,793139
+fr,paris,Paris,2161000
+CSV
+}
+`, template)
+}
+
+func (r StorageTableEntitiesResource) csvUpdated(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  format  = "csv"
+  content = <<CSV
+PartitionKey,RowKey,Name,Population
+uk,london,London,9002488
+fr,paris,Paris,2161000
+CSV
+}
+`, template)
+}
+
+func (r StorageTableEntitiesResource) json(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  format = "json"
+  content = jsonencode([
+    {
+      PartitionKey = "uk"
+      RowKey       = "london"
+      Name         = "London"
+      Capital      = true
+    },
+    {
+      PartitionKey = "uk"
+      RowKey       = "leeds"
+      Name         = "Leeds"
+      Capital      = false
+    },
+  ])
+}
+`, template)
+}
+
+func (r StorageTableEntitiesResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%d"
+  storage_account_name = azurerm_storage_account.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger)
+}
@@ -0,0 +1,215 @@
+package storage
+
+import (
+	"crypto/sha1"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	tableEntitiesFormatCSV  = "csv"
+	tableEntitiesFormatJSON = "json"
+)
+
+// TableEntityRow is a single Entity parsed from the `content` of the `azurerm_storage_table_entities` resource
+type TableEntityRow struct {
+	PartitionKey string
+	RowKey       string
+	Properties   map[string]string
+}
+
+// Key returns the key used to identify this Entity in the `entities` map - since a Partition Key
+// cannot contain a `/` this is unambiguous
+func (r TableEntityRow) Key() string {
+	return fmt.Sprintf("%s/%s", r.PartitionKey, r.RowKey)
+}
+
+// Hash returns a hash of the properties of this Entity, which is used to determine whether it's changed
+func (r TableEntityRow) Hash() string {
+	return hashTableEntityProperties(r.Properties)
+}
+
+func hashTableEntityProperties(input map[string]string) string {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	hash := sha1.New()
+	for _, k := range keys {
+		// the lengths are included so that values containing the separator can't collide
+		fmt.Fprintf(hash, "%d:%s=%d:%s;", len(k), k, len(input[k]), input[k])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// splitTableEntityKey splits a key from the `entities` map into the Partition Key and Row Key
+func splitTableEntityKey(input string) (string, string, error) {
+	segments := strings.SplitN(input, "/", 2)
+	if len(segments) != 2 {
+		return "", "", fmt.Errorf("expected the key %q to be in the format `{partitionKey}/{rowKey}`", input)
+	}
+	return segments[0], segments[1], nil
+}
+
+// ParseTableEntities parses the JSON or CSV document into the Entities it contains, keyed by `{partitionKey}/{rowKey}`
+func ParseTableEntities(format string, content string) (map[string]TableEntityRow, error) {
+	var rows []TableEntityRow
+	var err error
+	switch format {
+	case tableEntitiesFormatCSV:
+		rows, err = parseTableEntitiesCSV(content)
+	case tableEntitiesFormatJSON:
+		rows, err = parseTableEntitiesJSON(content)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]TableEntityRow, len(rows))
+	for i, row := range rows {
+		if row.PartitionKey == "" {
+			return nil, fmt.Errorf("entity %d: `PartitionKey` must be specified", i+1)
+		}
+		if row.RowKey == "" {
+			return nil, fmt.Errorf("entity %d: `RowKey` must be specified", i+1)
+		}
+		if err := validateTableEntityKey(row.PartitionKey); err != nil {
+			return nil, fmt.Errorf("entity %d: `PartitionKey` %s", i+1, err)
+		}
+		if err := validateTableEntityKey(row.RowKey); err != nil {
+			return nil, fmt.Errorf("entity %d: `RowKey` %s", i+1, err)
+		}
+
+		key := row.Key()
+		if _, exists := output[key]; exists {
+			return nil, fmt.Errorf("entity %d: duplicate entity with Partition Key %q and Row Key %q", i+1, row.PartitionKey, row.RowKey)
+		}
+		output[key] = row
+	}
+
+	return output, nil
+}
+
+func parseTableEntitiesCSV(content string) ([]TableEntityRow, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return []TableEntityRow{}, nil
+		}
+		return nil, fmt.Errorf("reading the CSV header: %+v", err)
+	}
+
+	partitionKeyIndex, rowKeyIndex := -1, -1
+	for i, column := range header {
+		switch strings.TrimSpace(column) {
+		case "PartitionKey":
+			partitionKeyIndex = i
+		case "RowKey":
+			rowKeyIndex = i
+		case "":
+			return nil, fmt.Errorf("column %d in the CSV header has no name", i+1)
+		}
+	}
+	if partitionKeyIndex == -1 || rowKeyIndex == -1 {
+		return nil, fmt.Errorf("the CSV header must contain both a `PartitionKey` and a `RowKey` column")
+	}
+
+	rows := make([]TableEntityRow, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading the CSV: %+v", err)
+		}
+
+		row := TableEntityRow{
+			PartitionKey: record[partitionKeyIndex],
+			RowKey:       record[rowKeyIndex],
+			Properties:   map[string]string{},
+		}
+		for i, value := range record {
+			if i == partitionKeyIndex || i == rowKeyIndex || value == "" {
+				continue
+			}
+			row.Properties[strings.TrimSpace(header[i])] = value
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func parseTableEntitiesJSON(content string) ([]TableEntityRow, error) {
+	decoder := json.NewDecoder(strings.NewReader(content))
+	decoder.UseNumber()
+
+	var entities []map[string]interface{}
+	if err := decoder.Decode(&entities); err != nil {
+		return nil, fmt.Errorf("parsing the JSON - expected an array of objects: %+v", err)
+	}
+
+	rows := make([]TableEntityRow, 0, len(entities))
+	for i, entity := range entities {
+		row := TableEntityRow{
+			Properties: map[string]string{},
+		}
+		for k, v := range entity {
+			value, err := tableEntityPropertyValue(v)
+			if err != nil {
+				return nil, fmt.Errorf("entity %d: property %q %+v", i+1, k, err)
+			}
+
+			switch k {
+			case "PartitionKey":
+				row.PartitionKey = value
+			case "RowKey":
+				row.RowKey = value
+			default:
+				row.Properties[k] = value
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// tableEntityPropertyValue converts a JSON value into a string, which matches how properties are stored by
+// the `azurerm_storage_table_entity` resource
+func tableEntityPropertyValue(input interface{}) (string, error) {
+	switch v := input.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return fmt.Sprintf("%t", v), nil
+	default:
+		return "", fmt.Errorf("must be a string, number or boolean")
+	}
+}
+
+func validateTableEntityKey(input string) error {
+	if strings.ContainsAny(input, "/\\#?") {
+		return fmt.Errorf("cannot contain the characters `/`, `\\`, `#` or `?`")
+	}
+	for _, c := range input {
+		if c < 0x20 || (c >= 0x7f && c <= 0x9f) {
+			return fmt.Errorf("cannot contain control characters")
+		}
+	}
+	return nil
+}
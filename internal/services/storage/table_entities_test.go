@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTableEntities(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Format   string
+		Content  string
+		Expected map[string]TableEntityRow
+		Error    bool
+	}{
+		{
+			Name:     "Empty JSON",
+			Format:   "json",
+			Content:  "[]",
+			Expected: map[string]TableEntityRow{},
+		},
+		{
+			Name:   "JSON",
+			Format: "json",
+			Content: `[
+  {"PartitionKey": "uk", "RowKey": "london", "Population": 8982000, "Capital": true},
+  {"PartitionKey": "uk", "RowKey": "leeds", "Name": "Leeds"}
+]`,
+			Expected: map[string]TableEntityRow{
+				"uk/london": {
+					PartitionKey: "uk",
+					RowKey:       "london",
+					Properties: map[string]string{
+						"Population": "8982000",
+						"Capital":    "true",
+					},
+				},
+				"uk/leeds": {
+					PartitionKey: "uk",
+					RowKey:       "leeds",
+					Properties: map[string]string{
+						"Name": "Leeds",
+					},
+				},
+			},
+		},
+		{
+			Name:    "JSON Not An Array",
+			Format:  "json",
+			Content: `{"PartitionKey": "uk", "RowKey": "london"}`,
+			Error:   true,
+		},
+		{
+			Name:    "JSON Nested Object",
+			Format:  "json",
+			Content: `[{"PartitionKey": "uk", "RowKey": "london", "Nested": {"a": "b"}}]`,
+			Error:   true,
+		},
+		{
+			Name:    "JSON Missing Row Key",
+			Format:  "json",
+			Content: `[{"PartitionKey": "uk"}]`,
+			Error:   true,
+		},
+		{
+			Name:    "JSON Duplicate",
+			Format:  "json",
+			Content: `[{"PartitionKey": "uk", "RowKey": "london"}, {"PartitionKey": "uk", "RowKey": "london"}]`,
+			Error:   true,
+		},
+		{
+			Name:    "JSON Invalid Partition Key",
+			Format:  "json",
+			Content: `[{"PartitionKey": "uk/gb", "RowKey": "london"}]`,
+			Error:   true,
+		},
+		{
+			Name:     "Empty CSV",
+			Format:   "csv",
+			Content:  "",
+			Expected: map[string]TableEntityRow{},
+		},
+		{
+			Name:    "CSV",
+			Format:  "csv",
+			Content: "RowKey,PartitionKey,Name,Population\nlondon,uk,\"London, England\",8982000\nleeds,uk,,\n",
+			Expected: map[string]TableEntityRow{
+				"uk/london": {
+					PartitionKey: "uk",
+					RowKey:       "london",
+					Properties: map[string]string{
+						"Name":       "London, England",
+						"Population": "8982000",
+					},
+				},
+				"uk/leeds": {
+					PartitionKey: "uk",
+					RowKey:       "leeds",
+					Properties:   map[string]string{},
+				},
+			},
+		},
+		{
+			Name:    "CSV Missing Partition Key Column",
+			Format:  "csv",
+			Content: "RowKey,Name\nlondon,London\n",
+			Error:   true,
+		},
+		{
+			Name:    "CSV Mismatched Columns",
+			Format:  "csv",
+			Content: "PartitionKey,RowKey,Name\nuk,london\n",
+			Error:   true,
+		},
+		{
+			Name:    "Unsupported Format",
+			Format:  "xml",
+			Content: "<entities />",
+			Error:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ParseTableEntities(v.Format, v.Content)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestTableEntityRowHash(t *testing.T) {
+	first := TableEntityRow{
+		PartitionKey: "uk",
+		RowKey:       "london",
+		Properties: map[string]string{
+			"a": "b=c",
+		},
+	}
+	second := TableEntityRow{
+		PartitionKey: "uk",
+		RowKey:       "london",
+		Properties: map[string]string{
+			"a=b": "c",
+		},
+	}
+
+	if first.Hash() == second.Hash() {
+		t.Fatalf("Expected the hashes for different properties to differ but both were %q", first.Hash())
+	}
+
+	if first.Hash() != hashTableEntityProperties(map[string]string{"a": "b=c"}) {
+		t.Fatalf("Expected the hash to only depend on the properties")
+	}
+}
@@ -75,7 +75,6 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsd
 
 	resourceGroup := appID.ResourceGroup
 	name := appID.SiteName
-	subnetName := subnetID.Name
 	virtualNetworkName := subnetID.VirtualNetworkName
 	slotName := d.Get("slot_name").(string)
 
@@ -92,11 +91,11 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsd
 		}
 	}
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
+	locks.ByID(networkParse.NewVirtualNetworkID(subnetID.SubscriptionId, subnetID.ResourceGroup, subnetID.VirtualNetworkName).ID())
+	defer locks.UnlockByID(networkParse.NewVirtualNetworkID(subnetID.SubscriptionId, subnetID.ResourceGroup, subnetID.VirtualNetworkName).ID())
 
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	locks.ByID(subnetID.ID())
+	defer locks.UnlockByID(subnetID.ID())
 
 	appServiceExists, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -221,14 +220,12 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionDelete(d *pluginsdk.Reso
 	if err != nil {
 		return fmt.Errorf("parsing Subnet Resource ID %q", subnetID)
 	}
-	subnetName := subnetID.Name
-	virtualNetworkName := subnetID.VirtualNetworkName
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
+	locks.ByID(networkParse.NewVirtualNetworkID(subnetID.SubscriptionId, subnetID.ResourceGroup, subnetID.VirtualNetworkName).ID())
+	defer locks.UnlockByID(networkParse.NewVirtualNetworkID(subnetID.SubscriptionId, subnetID.ResourceGroup, subnetID.VirtualNetworkName).ID())
 
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	locks.ByID(subnetID.ID())
+	defer locks.UnlockByID(subnetID.ID())
 
 	read, err := client.GetSwiftVirtualNetworkConnectionSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
 	if err != nil {
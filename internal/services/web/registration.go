@@ -53,7 +53,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_function_app":                                      resourceFunctionApp(),
 		"azurerm_function_app_slot":                                 resourceFunctionAppSlot(),
 		"azurerm_static_site":                                       resourceStaticSite(),
+		"azurerm_static_site_container_app_registration":            resourceStaticSiteContainerAppRegistration(),
 		"azurerm_static_site_custom_domain":                         resourceStaticSiteCustomDomain(),
+		"azurerm_static_site_function_app_registration":             resourceStaticSiteFunctionAppRegistration(),
 	}
 }
 
@@ -0,0 +1,223 @@
+package staticsites
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// ResourceId is the ID of a Static Site or one of its Linked Backends, such as the IDs within the `parse` package
+type ResourceId interface {
+	ID() string
+}
+
+type StaticSitesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStaticSitesClientWithBaseURI(endpoint string) StaticSitesClient {
+	return StaticSitesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
+
+type GetBasicAuthResponse struct {
+	HttpResponse *http.Response
+	Model        *StaticSiteBasicAuthPropertiesARMResource
+}
+
+type GetLinkedBackendResponse struct {
+	HttpResponse *http.Response
+	Model        *StaticSiteLinkedBackendARMResource
+}
+
+type DeleteLinkedBackendResponse struct {
+	HttpResponse *http.Response
+}
+
+// basicAuthPath returns the path to the (singular) Basic Auth settings of the specified Static Site
+func basicAuthPath(staticSiteId ResourceId) string {
+	return fmt.Sprintf("%s/basicAuth/default", staticSiteId.ID())
+}
+
+// CreateOrUpdateBasicAuth configures the Basic Auth settings for the Static Site with the specified ID
+func (c StaticSitesClient) CreateOrUpdateBasicAuth(ctx context.Context, staticSiteId ResourceId, input StaticSiteBasicAuthPropertiesARMResource) (result GetBasicAuthResponse, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(basicAuthPath(staticSiteId)),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "CreateOrUpdateBasicAuth", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "CreateOrUpdateBasicAuth", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "CreateOrUpdateBasicAuth", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetBasicAuth retrieves the Basic Auth settings for the Static Site with the specified ID
+func (c StaticSitesClient) GetBasicAuth(ctx context.Context, staticSiteId ResourceId) (result GetBasicAuthResponse, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(basicAuthPath(staticSiteId)),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetBasicAuth", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetBasicAuth", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetBasicAuth", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// LinkBackendThenPoll links the backend in `input` to the Static Site using the specified Linked Backend ID, then
+// polls until the operation has completed
+func (c StaticSitesClient) LinkBackendThenPoll(ctx context.Context, id ResourceId, input StaticSiteLinkedBackendARMResource) error {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "LinkBackend", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "LinkBackend", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "LinkBackend", resp, "Failure sending request")
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after LinkBackend: %+v", err)
+	}
+
+	return nil
+}
+
+// GetLinkedBackend retrieves the Linked Backend with the specified ID
+func (c StaticSitesClient) GetLinkedBackend(ctx context.Context, id ResourceId) (result GetLinkedBackendResponse, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetLinkedBackend", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetLinkedBackend", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetLinkedBackend", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// UnlinkBackend removes the Linked Backend with the specified ID from the Static Site, optionally removing the
+// authentication configuration which was added to the backend when it was linked
+func (c StaticSitesClient) UnlinkBackend(ctx context.Context, id ResourceId, cleanUpAuthentication bool) (result DeleteLinkedBackendResponse, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version":           defaultApiVersion,
+			"cleanUpAuthentication": cleanUpAuthentication,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "UnlinkBackend", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "UnlinkBackend", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "UnlinkBackend", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
@@ -0,0 +1,46 @@
+package staticsites
+
+type ApplicableEnvironmentsMode string
+
+const (
+	ApplicableEnvironmentsModeAllEnvironments       ApplicableEnvironmentsMode = "AllEnvironments"
+	ApplicableEnvironmentsModeSpecifiedEnvironments ApplicableEnvironmentsMode = "SpecifiedEnvironments"
+	ApplicableEnvironmentsModeStagingEnvironments   ApplicableEnvironmentsMode = "StagingEnvironments"
+)
+
+func PossibleValuesForApplicableEnvironmentsMode() []string {
+	return []string{
+		string(ApplicableEnvironmentsModeAllEnvironments),
+		string(ApplicableEnvironmentsModeSpecifiedEnvironments),
+		string(ApplicableEnvironmentsModeStagingEnvironments),
+	}
+}
+
+type StaticSiteBasicAuthPropertiesARMResource struct {
+	Id         *string                                             `json:"id,omitempty"`
+	Name       *string                                             `json:"name,omitempty"`
+	Properties *StaticSiteBasicAuthPropertiesARMResourceProperties `json:"properties,omitempty"`
+	Type       *string                                             `json:"type,omitempty"`
+}
+
+type StaticSiteBasicAuthPropertiesARMResourceProperties struct {
+	ApplicableEnvironmentsMode ApplicableEnvironmentsMode `json:"applicableEnvironmentsMode"`
+	Environments               *[]string                  `json:"environments,omitempty"`
+	Password                   *string                    `json:"password,omitempty"`
+	SecretState                *string                    `json:"secretState,omitempty"`
+	SecretUrl                  *string                    `json:"secretUrl,omitempty"`
+}
+
+type StaticSiteLinkedBackendARMResource struct {
+	Id         *string                                       `json:"id,omitempty"`
+	Name       *string                                       `json:"name,omitempty"`
+	Properties *StaticSiteLinkedBackendARMResourceProperties `json:"properties,omitempty"`
+	Type       *string                                       `json:"type,omitempty"`
+}
+
+type StaticSiteLinkedBackendARMResourceProperties struct {
+	BackendResourceId *string `json:"backendResourceId,omitempty"`
+	CreatedOn         *string `json:"createdOn,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	Region            *string `json:"region,omitempty"`
+}
@@ -0,0 +1,26 @@
+package staticsites
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStaticSiteBasicAuthMarshal(t *testing.T) {
+	input := StaticSiteBasicAuthPropertiesARMResource{
+		Properties: &StaticSiteBasicAuthPropertiesARMResourceProperties{
+			ApplicableEnvironmentsMode: ApplicableEnvironmentsModeSpecifiedEnvironments,
+			Environments:               &[]string{},
+		},
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+
+	// disabling Basic Auth requires an empty list of environments to be sent, rather than omitting it
+	expected := `{"properties":{"applicableEnvironmentsMode":"SpecifiedEnvironments","environments":[]}}`
+	if string(body) != expected {
+		t.Fatalf("expected %s but got %s", expected, string(body))
+	}
+}
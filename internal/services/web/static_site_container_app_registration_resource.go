@@ -0,0 +1,173 @@
+package web
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/sdk/2022-03-01/staticsites"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// containerAppApiVersion is the API version used to look up the region of the Container App being linked
+const containerAppApiVersion = "2022-03-01"
+
+func resourceStaticSiteContainerAppRegistration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStaticSiteContainerAppRegistrationCreate,
+		Read:   resourceStaticSiteContainerAppRegistrationRead,
+		Delete: resourceStaticSiteContainerAppRegistrationDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StaticSiteContainerAppRegistrationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"static_site_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StaticSiteID,
+			},
+
+			"container_app_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ContainerAppID,
+			},
+		},
+	}
+}
+
+func resourceStaticSiteContainerAppRegistrationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesConfigClient
+	resourcesClient := meta.(*clients.Client).Resource.ResourcesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	staticSiteId, err := parse.StaticSiteID(d.Get("static_site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	containerAppId, err := parse.ContainerAppID(d.Get("container_app_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStaticSiteContainerAppRegistrationID(staticSiteId.SubscriptionId, staticSiteId.ResourceGroup, staticSiteId.Name, containerAppId.Name)
+
+	locks.ByName(id.StaticSiteName, staticSiteResourceName)
+	defer locks.UnlockByName(id.StaticSiteName, staticSiteResourceName)
+
+	existing, err := client.GetLinkedBackend(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_static_site_container_app_registration", id.ID())
+	}
+
+	// the region of the Container App has to be specified when linking it, so we look this up rather than asking the user for it
+	containerApp, err := resourcesClient.GetByID(ctx, containerAppId.ID(), containerAppApiVersion)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *containerAppId, err)
+	}
+	if containerApp.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", *containerAppId)
+	}
+
+	envelope := staticsites.StaticSiteLinkedBackendARMResource{
+		Properties: &staticsites.StaticSiteLinkedBackendARMResourceProperties{
+			BackendResourceId: utils.String(containerAppId.ID()),
+			Region:            utils.String(location.Normalize(*containerApp.Location)),
+		},
+	}
+
+	if err := client.LinkBackendThenPoll(ctx, id, envelope); err != nil {
+		return fmt.Errorf("registering %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStaticSiteContainerAppRegistrationRead(d, meta)
+}
+
+func resourceStaticSiteContainerAppRegistrationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesConfigClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteContainerAppRegistrationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetLinkedBackend(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("static_site_id", parse.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.StaticSiteName).ID())
+
+	containerAppId := ""
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.BackendResourceId != nil {
+		parsed, err := parse.ContainerAppID(*model.Properties.BackendResourceId)
+		if err != nil {
+			return fmt.Errorf("parsing Container App ID %q: %+v", *model.Properties.BackendResourceId, err)
+		}
+		containerAppId = parsed.ID()
+	}
+	d.Set("container_app_id", containerAppId)
+
+	return nil
+}
+
+func resourceStaticSiteContainerAppRegistrationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesConfigClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteContainerAppRegistrationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.StaticSiteName, staticSiteResourceName)
+	defer locks.UnlockByName(id.StaticSiteName, staticSiteResourceName)
+
+	log.Printf("[DEBUG] Unlinking %s", *id)
+
+	// the authentication configured on the Container App when it was linked is removed along with the link
+	if resp, err := client.UnlinkBackend(ctx, *id, true); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("unlinking %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package web_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StaticSiteContainerAppRegistrationResource struct{}
+
+func TestAccAzureStaticSiteContainerAppRegistration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_site_container_app_registration", "test")
+	r := StaticSiteContainerAppRegistrationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureStaticSiteContainerAppRegistration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_site_container_app_registration", "test")
+	r := StaticSiteContainerAppRegistrationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StaticSiteContainerAppRegistrationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StaticSiteContainerAppRegistrationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Web.StaticSitesConfigClient.GetLinkedBackend(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StaticSiteContainerAppRegistrationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_static_site" "test" {
+  name                = "acctestSS-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_size            = "Standard"
+  sku_tier            = "Standard"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-containerapp-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  parameters_content = jsonencode({
+    "customerId" = {
+      value = azurerm_log_analytics_workspace.test.workspace_id
+    }
+    "sharedKey" = {
+      value = azurerm_log_analytics_workspace.test.primary_shared_key
+    }
+  })
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "customerId": {
+      "type": "string"
+    },
+    "sharedKey": {
+      "type": "securestring"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.App/managedEnvironments",
+      "apiVersion": "2022-03-01",
+      "name": "acctest-cae-%[1]d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "appLogsConfiguration": {
+          "destination": "log-analytics",
+          "logAnalyticsConfiguration": {
+            "customerId": "[parameters('customerId')]",
+            "sharedKey": "[parameters('sharedKey')]"
+          }
+        }
+      }
+    },
+    {
+      "type": "Microsoft.App/containerApps",
+      "apiVersion": "2022-03-01",
+      "name": "acctest-ca-%[1]d",
+      "location": "[resourceGroup().location]",
+      "dependsOn": [
+        "[resourceId('Microsoft.App/managedEnvironments', 'acctest-cae-%[1]d')]"
+      ],
+      "properties": {
+        "managedEnvironmentId": "[resourceId('Microsoft.App/managedEnvironments', 'acctest-cae-%[1]d')]",
+        "configuration": {
+          "ingress": {
+            "external": true,
+            "targetPort": 80
+          }
+        },
+        "template": {
+          "containers": [
+            {
+              "name": "hello-world",
+              "image": "mcr.microsoft.com/azuredocs/containerapps-helloworld:latest"
+            }
+          ]
+        }
+      }
+    }
+  ],
+  "outputs": {
+    "containerAppId": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.App/containerApps', 'acctest-ca-%[1]d')]"
+    }
+  }
+}
+TEMPLATE
+}
+
+resource "azurerm_static_site_container_app_registration" "test" {
+  static_site_id   = azurerm_static_site.test.id
+  container_app_id = jsondecode(azurerm_resource_group_template_deployment.test.output_content).containerAppId.value
+}
+`, data.RandomInteger, data.Locations.Secondary) // TODO - Put back to primary when support ticket is resolved
+}
+
+func (r StaticSiteContainerAppRegistrationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_static_site_container_app_registration" "import" {
+  static_site_id   = azurerm_static_site_container_app_registration.test.static_site_id
+  container_app_id = azurerm_static_site_container_app_registration.test.container_app_id
+}
+`, r.basic(data))
+}
@@ -100,7 +100,7 @@ func resourceStaticSiteCustomDomainCreateOrUpdate(d *pluginsdk.ResourceData, met
 	}
 
 	if !utils.ResponseWasNotFound(existing.Response) {
-		return tf.ImportAsExistsError("azurerm_static_site_custom_domain", staticSiteId.ID())
+		return tf.ImportAsExistsError("azurerm_static_site_custom_domain", id.ID())
 	}
 
 	validationMethod := d.Get("validation_type").(string)
@@ -185,7 +185,11 @@ func resourceStaticSiteCustomDomainRead(d *pluginsdk.ResourceData, meta interfac
 	d.Set("static_site_id", parse.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.StaticSiteName).ID())
 
 	if props := resp.StaticSiteCustomDomainOverviewARMResourceProperties; props != nil {
-		d.Set("validation_token", resp.ValidationToken)
+		// the validation token is only returned until the domain has been validated, at which point it's cleared - so we
+		// retain the last known value to avoid a diff on any `azurerm_dns_txt_record` which references it
+		if props.ValidationToken != nil && *props.ValidationToken != "" {
+			d.Set("validation_token", props.ValidationToken)
+		}
 	}
 
 	return nil
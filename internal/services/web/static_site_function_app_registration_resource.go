@@ -0,0 +1,175 @@
+package web
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const staticSiteResourceName = "azurerm_static_site"
+
+func resourceStaticSiteFunctionAppRegistration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStaticSiteFunctionAppRegistrationCreate,
+		Read:   resourceStaticSiteFunctionAppRegistrationRead,
+		Delete: resourceStaticSiteFunctionAppRegistrationDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StaticSiteFunctionAppRegistrationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"static_site_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StaticSiteID,
+			},
+
+			"function_app_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FunctionAppID,
+			},
+		},
+	}
+}
+
+func resourceStaticSiteFunctionAppRegistrationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	appServicesClient := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	staticSiteId, err := parse.StaticSiteID(d.Get("static_site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	functionAppId, err := parse.FunctionAppID(d.Get("function_app_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStaticSiteFunctionAppRegistrationID(staticSiteId.SubscriptionId, staticSiteId.ResourceGroup, staticSiteId.Name, functionAppId.SiteName)
+
+	locks.ByName(id.StaticSiteName, staticSiteResourceName)
+	defer locks.UnlockByName(id.StaticSiteName, staticSiteResourceName)
+
+	existing, err := client.GetUserProvidedFunctionAppForStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_static_site_function_app_registration", id.ID())
+	}
+
+	// the region of the Function App has to be specified when registering it, so we look this up rather than asking the user for it
+	functionApp, err := appServicesClient.Get(ctx, functionAppId.ResourceGroup, functionAppId.SiteName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *functionAppId, err)
+	}
+	if functionApp.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", *functionAppId)
+	}
+
+	envelope := web.StaticSiteUserProvidedFunctionAppARMResource{
+		StaticSiteUserProvidedFunctionAppARMResourceProperties: &web.StaticSiteUserProvidedFunctionAppARMResourceProperties{
+			FunctionAppResourceID: utils.String(functionAppId.ID()),
+			FunctionAppRegion:     utils.String(location.Normalize(*functionApp.Location)),
+		},
+	}
+
+	future, err := client.RegisterUserProvidedFunctionAppWithStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName, envelope, utils.Bool(false))
+	if err != nil {
+		return fmt.Errorf("registering %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for registration of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStaticSiteFunctionAppRegistrationRead(d, meta)
+}
+
+func resourceStaticSiteFunctionAppRegistrationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteFunctionAppRegistrationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetUserProvidedFunctionAppForStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("static_site_id", parse.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.StaticSiteName).ID())
+
+	functionAppId := ""
+	if props := resp.StaticSiteUserProvidedFunctionAppARMResourceProperties; props != nil && props.FunctionAppResourceID != nil {
+		parsed, err := parse.FunctionAppID(*props.FunctionAppResourceID)
+		if err != nil {
+			return fmt.Errorf("parsing Function App ID %q: %+v", *props.FunctionAppResourceID, err)
+		}
+		functionAppId = parsed.ID()
+	}
+	d.Set("function_app_id", functionAppId)
+
+	return nil
+}
+
+func resourceStaticSiteFunctionAppRegistrationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteFunctionAppRegistrationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.StaticSiteName, staticSiteResourceName)
+	defer locks.UnlockByName(id.StaticSiteName, staticSiteResourceName)
+
+	log.Printf("[DEBUG] Detaching %s", *id)
+
+	if resp, err := client.DetachUserProvidedFunctionAppFromStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("detaching %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
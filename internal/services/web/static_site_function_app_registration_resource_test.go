@@ -0,0 +1,129 @@
+package web_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StaticSiteFunctionAppRegistrationResource struct{}
+
+func TestAccAzureStaticSiteFunctionAppRegistration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_site_function_app_registration", "test")
+	r := StaticSiteFunctionAppRegistrationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureStaticSiteFunctionAppRegistration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_site_function_app_registration", "test")
+	r := StaticSiteFunctionAppRegistrationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StaticSiteFunctionAppRegistrationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StaticSiteFunctionAppRegistrationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Web.StaticSitesClient.GetUserProvidedFunctionAppForStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StaticSiteFunctionAppRegistrationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_static_site" "test" {
+  name                = "acctestSS-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_size            = "Standard"
+  sku_tier            = "Standard"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_function_app" "test" {
+  name                       = "acctest-%[1]d-func"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  app_service_plan_id        = azurerm_app_service_plan.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+  version                    = "~3"
+}
+
+resource "azurerm_static_site_function_app_registration" "test" {
+  static_site_id  = azurerm_static_site.test.id
+  function_app_id = azurerm_function_app.test.id
+}
+`, data.RandomInteger, data.Locations.Secondary, data.RandomString) // TODO - Put back to primary when support ticket is resolved
+}
+
+func (r StaticSiteFunctionAppRegistrationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_static_site_function_app_registration" "import" {
+  static_site_id  = azurerm_static_site_function_app_registration.test.static_site_id
+  function_app_id = azurerm_static_site_function_app_registration.test.function_app_id
+}
+`, r.basic(data))
+}
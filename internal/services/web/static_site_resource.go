@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/sdk/2022-03-01/staticsites"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -80,6 +81,41 @@ func resourceStaticSite() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"app_settings": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"identity": schemaAppServiceIdentity(),
+
+			"basic_auth": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"password": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"environments": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(staticsites.ApplicableEnvironmentsModeAllEnvironments),
+								string(staticsites.ApplicableEnvironmentsModeStagingEnvironments),
+							}, false),
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -108,6 +144,11 @@ func resourceStaticSiteCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	basicAuth := d.Get("basic_auth").([]interface{})
+	if len(basicAuth) > 0 && d.Get("sku_tier").(string) != "Standard" {
+		return fmt.Errorf("`basic_auth` can only be specified when `sku_tier` is `Standard`")
+	}
+
 	loc := location.Normalize(d.Get("location").(string))
 
 	siteEnvelope := web.StaticSiteARMResource{
@@ -116,16 +157,39 @@ func resourceStaticSiteCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{
 			Tier: utils.String(d.Get("sku_tier").(string)),
 		},
 		StaticSite: &web.StaticSite{},
+		Identity:   expandAppServiceIdentity(d.Get("identity").([]interface{})),
 		Location:   &loc,
 		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	if _, err := client.CreateOrUpdateStaticSite(ctx, id.ResourceGroup, id.Name, siteEnvelope); err != nil {
+	future, err := client.CreateOrUpdateStaticSite(ctx, id.ResourceGroup, id.Name, siteEnvelope)
+	if err != nil {
 		return fmt.Errorf("failed creating %s: %+v", id, err)
 	}
 
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
 	d.SetId(id.ID())
 
+	if d.HasChange("app_settings") {
+		// the App Settings are replaced in full, so an empty dictionary removes any which were previously set
+		appSettings := web.StringDictionary{
+			Properties: expandStaticSiteAppSettings(d.Get("app_settings").(map[string]interface{})),
+		}
+		if _, err := client.CreateOrUpdateStaticSiteAppSettings(ctx, id.ResourceGroup, id.Name, appSettings); err != nil {
+			return fmt.Errorf("updating App Settings for %s: %+v", id, err)
+		}
+	}
+
+	if d.HasChange("basic_auth") {
+		configClient := meta.(*clients.Client).Web.StaticSitesConfigClient
+		if _, err := configClient.CreateOrUpdateBasicAuth(ctx, id, expandStaticSiteBasicAuth(basicAuth)); err != nil {
+			return fmt.Errorf("updating Basic Auth for %s: %+v", id, err)
+		}
+	}
+
 	return resourceStaticSiteRead(d, meta)
 }
 
@@ -175,6 +239,35 @@ func resourceStaticSiteRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("sku_size", skuName)
 	d.Set("sku_tier", skuTier)
 
+	identity, err := flattenAppServiceIdentity(resp.Identity)
+	if err != nil {
+		return fmt.Errorf("flattening `identity`: %+v", err)
+	}
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	appSettingsResp, err := client.ListStaticSiteAppSettings(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing App Settings for %s: %+v", id, err)
+	}
+	if err := d.Set("app_settings", flattenStaticSiteAppSettings(appSettingsResp.Properties)); err != nil {
+		return fmt.Errorf("setting `app_settings`: %+v", err)
+	}
+
+	basicAuthResp, err := meta.(*clients.Client).Web.StaticSitesConfigClient.GetBasicAuth(ctx, *id)
+	if err != nil && !response.WasNotFound(basicAuthResp.HttpResponse) {
+		return fmt.Errorf("retrieving Basic Auth for %s: %+v", id, err)
+	}
+	// the password isn't returned by the API, so this is retained from the state
+	password := ""
+	if v, ok := d.GetOk("basic_auth.0.password"); ok {
+		password = v.(string)
+	}
+	if err := d.Set("basic_auth", flattenStaticSiteBasicAuth(basicAuthResp.Model, password)); err != nil {
+		return fmt.Errorf("setting `basic_auth`: %+v", err)
+	}
+
 	secretResp, err := client.ListStaticSiteSecrets(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("listing secretes for %s: %v", id, err)
@@ -210,3 +303,60 @@ func resourceStaticSiteDelete(d *pluginsdk.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+func expandStaticSiteAppSettings(input map[string]interface{}) map[string]*string {
+	output := make(map[string]*string, len(input))
+	for k, v := range input {
+		output[k] = utils.String(v.(string))
+	}
+	return output
+}
+
+func flattenStaticSiteAppSettings(input map[string]*string) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		if v != nil {
+			output[k] = *v
+		}
+	}
+	return output
+}
+
+func expandStaticSiteBasicAuth(input []interface{}) staticsites.StaticSiteBasicAuthPropertiesARMResource {
+	// Basic Auth can't be deleted, instead it's disabled by limiting it to no environments
+	props := staticsites.StaticSiteBasicAuthPropertiesARMResourceProperties{
+		ApplicableEnvironmentsMode: staticsites.ApplicableEnvironmentsModeSpecifiedEnvironments,
+		Environments:               &[]string{},
+	}
+
+	if len(input) > 0 && input[0] != nil {
+		v := input[0].(map[string]interface{})
+		props = staticsites.StaticSiteBasicAuthPropertiesARMResourceProperties{
+			ApplicableEnvironmentsMode: staticsites.ApplicableEnvironmentsMode(v["environments"].(string)),
+			Password:                   utils.String(v["password"].(string)),
+		}
+	}
+
+	return staticsites.StaticSiteBasicAuthPropertiesARMResource{
+		Properties: &props,
+	}
+}
+
+func flattenStaticSiteBasicAuth(input *staticsites.StaticSiteBasicAuthPropertiesARMResource, password string) []interface{} {
+	if input == nil || input.Properties == nil {
+		return []interface{}{}
+	}
+
+	mode := input.Properties.ApplicableEnvironmentsMode
+	if mode == "" || mode == staticsites.ApplicableEnvironmentsModeSpecifiedEnvironments {
+		// Basic Auth is disabled, or has been configured for specific environments outside of Terraform
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"password":     password,
+			"environments": string(mode),
+		},
+	}
+}
@@ -0,0 +1,11 @@
+package utils
+
+// NormaliseNilableFloat64 takes a pointer to a float64 and returns a zero value or
+// the real value if present
+func NormaliseNilableFloat64(input *float64) float64 {
+	if input == nil {
+		return 0
+	}
+
+	return *input
+}